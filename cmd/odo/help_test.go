@@ -31,9 +31,12 @@ Examples:
   build-images Build images
   deploy       Run your application on the cluster in the Deploy mode
   dev          Run your application on the cluster in the Dev mode
+  exec         Execute a command or open a shell in a container of the component running in Dev mode
   init         Init bootstraps a new project
   logs         Show logs of all containers of the component
+  port-forward Forward the ports of a component running on the cluster
   registry     List all components from the Devfile registry
+  run          Run a specific command of the Devfile in the component running in Dev mode
 
 `
 
@@ -1,10 +1,14 @@
 package api
 
+import "time"
+
 // Component describes the state of a devfile component
 type Component struct {
 	DevfilePath       string          `json:"devfilePath,omitempty"`
 	DevfileData       *DevfileData    `json:"devfileData,omitempty"`
 	DevForwardedPorts []ForwardedPort `json:"devForwardedPorts,omitempty"`
+	// DevSessions are the odo dev sessions running from the directory of the component
+	DevSessions []DevSession `json:"devSessions,omitempty"`
 	// RunningIn is the overall running mode map of the component;
 	// this is computing as a merge of RunningOn (all the different running modes
 	// for each platform the component is running on).
@@ -28,6 +32,15 @@ type ForwardedPort struct {
 	Exposure      string `json:"exposure,omitempty"`
 }
 
+// DevSession describes an odo dev session running from the directory of the component
+type DevSession struct {
+	PID              int       `json:"pid"`
+	Platform         string    `json:"platform"`
+	Namespace        string    `json:"namespace,omitempty"`
+	StartTime        time.Time `json:"startTime"`
+	APIServerAddress string    `json:"apiServerAddress,omitempty"`
+}
+
 type ConnectionData struct {
 	Name  string  `json:"name"`
 	Rules []Rules `json:"rules,omitempty"`
@@ -0,0 +1,39 @@
+package apiserver
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+const (
+	// DefaultAddress listens on a random free port of the loopback interface
+	DefaultAddress = "127.0.0.1:0"
+
+	unixPrefix = "unix:"
+)
+
+// ParseAddress returns the network and the address to listen on for the given address,
+// either the path of a Unix socket prefixed with "unix:", or a loopback address in the form host:port.
+// Non-loopback addresses are refused, as the API is only meant to be used by local tools.
+func ParseAddress(address string) (network string, addr string, err error) {
+	if strings.HasPrefix(address, unixPrefix) {
+		path := strings.TrimPrefix(strings.TrimPrefix(address, unixPrefix), "//")
+		if path == "" {
+			return "", "", fmt.Errorf("invalid address %q: the path of the Unix socket is missing", address)
+		}
+		return "unix", path, nil
+	}
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid address %q: %w", address, err)
+	}
+	if host != "localhost" {
+		ip := net.ParseIP(host)
+		if ip == nil || !ip.IsLoopback() {
+			return "", "", fmt.Errorf("invalid address %q: only loopback addresses are supported", address)
+		}
+	}
+	return "tcp", address, nil
+}
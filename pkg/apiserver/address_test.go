@@ -0,0 +1,78 @@
+package apiserver
+
+import "testing"
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		address     string
+		wantNetwork string
+		wantAddr    string
+		wantErr     bool
+	}{
+		{
+			name:        "default address",
+			address:     DefaultAddress,
+			wantNetwork: "tcp",
+			wantAddr:    "127.0.0.1:0",
+		},
+		{
+			name:        "localhost",
+			address:     "localhost:20000",
+			wantNetwork: "tcp",
+			wantAddr:    "localhost:20000",
+		},
+		{
+			name:        "IPv6 loopback",
+			address:     "[::1]:20000",
+			wantNetwork: "tcp",
+			wantAddr:    "[::1]:20000",
+		},
+		{
+			name:        "Unix socket",
+			address:     "unix:/tmp/odo.sock",
+			wantNetwork: "unix",
+			wantAddr:    "/tmp/odo.sock",
+		},
+		{
+			name:        "Unix socket in URL form",
+			address:     "unix:///tmp/odo.sock",
+			wantNetwork: "unix",
+			wantAddr:    "/tmp/odo.sock",
+		},
+		{
+			name:    "Unix socket without path",
+			address: "unix:",
+			wantErr: true,
+		},
+		{
+			name:    "non loopback address",
+			address: "0.0.0.0:20000",
+			wantErr: true,
+		},
+		{
+			name:    "host name",
+			address: "example.com:20000",
+			wantErr: true,
+		},
+		{
+			name:    "missing port",
+			address: "127.0.0.1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotNetwork, gotAddr, err := ParseAddress(tt.address)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ParseAddress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotNetwork != tt.wantNetwork {
+				t.Errorf("ParseAddress() network = %q, want %q", gotNetwork, tt.wantNetwork)
+			}
+			if gotAddr != tt.wantAddr {
+				t.Errorf("ParseAddress() addr = %q, want %q", gotAddr, tt.wantAddr)
+			}
+		})
+	}
+}
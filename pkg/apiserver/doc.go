@@ -0,0 +1,3 @@
+// Package apiserver provides the control API of an odo dev session,
+// allowing external tools to get the status of the session and to trigger actions on it
+package apiserver
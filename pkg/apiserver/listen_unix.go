@@ -0,0 +1,24 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris zos
+
+package apiserver
+
+import (
+	"net"
+	"os"
+	"syscall"
+)
+
+// listenUnix listens on the unix socket at path, created with permissions allowing only the user to connect to it
+func listenUnix(path string) (net.Listener, error) {
+	// The umask is changed while the socket is created, instead of changing its permissions after,
+	// so that other users cannot connect to it in between
+	mask := syscall.Umask(0077)
+	defer syscall.Umask(mask)
+	return net.Listen("unix", path)
+}
+
+// isSocket returns true if the file is a unix socket
+func isSocket(info os.FileInfo) bool {
+	return info.Mode()&os.ModeSocket != 0
+}
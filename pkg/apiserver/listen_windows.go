@@ -0,0 +1,17 @@
+package apiserver
+
+import (
+	"net"
+	"os"
+)
+
+// listenUnix listens on the unix socket at path. The socket is created in the directory of the path,
+// whose access control list applies to it.
+func listenUnix(path string) (net.Listener, error) {
+	return net.Listen("unix", path)
+}
+
+// isSocket returns true if the file is a unix socket, reported as an irregular file by some versions of Go
+func isSocket(info os.FileInfo) bool {
+	return info.Mode()&(os.ModeSocket|os.ModeIrregular) != 0
+}
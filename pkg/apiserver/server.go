@@ -0,0 +1,272 @@
+package apiserver
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// requestTimeout is the maximum duration to wait for the Dev session to handle a request
+const requestTimeout = 5 * time.Minute
+
+// tokenLength is the number of random bytes of the tokens authenticating the requests
+const tokenLength = 32
+
+// Status is the status of the Dev session returned by the API
+type Status struct {
+	// State is the state of the component, Ready when the component is running
+	State watch.State `json:"state"`
+	// Debug is true when the debug command is used instead of the run command
+	Debug bool `json:"debug"`
+}
+
+// Error is returned by the API when a request fails
+type Error struct {
+	Message string `json:"message"`
+}
+
+// Server serves the control API of a Dev session.
+// The requests are sent to the watch loop of the session,
+// to be executed the same way as the actions requested with the keyboard.
+type Server struct {
+	stateClient state.Client
+	// token must be passed as a Bearer token by the clients
+	token    string
+	requests chan<- watch.ControlRequest
+	stop     func()
+}
+
+// NewToken returns a random token, to be used to authenticate the requests to the API
+func NewToken() (string, error) {
+	b := make([]byte, tokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("unable to generate a token for the control API: %w", err)
+	}
+	return hex.EncodeToString(b), nil
+}
+
+// NewServer returns a server sending the requests to the requests channel, read by the watch loop of the session.
+// The requests must be authenticated with the token. The stop function is called to stop the session.
+func NewServer(stateClient state.Client, token string, requests chan<- watch.ControlRequest, stop func()) *Server {
+	return &Server{
+		stateClient: stateClient,
+		token:       token,
+		requests:    requests,
+		stop:        stop,
+	}
+}
+
+// Start listens on the address and serves the API in the background, until ctx is done.
+// It returns the address the server is listening on, with the port chosen by the system if the requested port is 0.
+func (o *Server) Start(ctx context.Context, address string) (string, error) {
+	network, addr, err := ParseAddress(address)
+	if err != nil {
+		return "", err
+	}
+	var listener net.Listener
+	if network == "unix" {
+		if err = removeStaleSocket(addr); err != nil {
+			return "", err
+		}
+		listener, err = listenUnix(addr)
+	} else {
+		listener, err = net.Listen(network, addr)
+	}
+	if err != nil {
+		return "", fmt.Errorf("unable to listen on %q: %w", address, err)
+	}
+
+	server := &http.Server{
+		Handler:           o.Handler(),
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+	go func() {
+		if errServe := server.Serve(listener); errServe != nil && !errors.Is(errServe, http.ErrServerClosed) {
+			klog.V(2).Infof("control API server stopped: %v", errServe)
+		}
+	}()
+	go func() {
+		<-ctx.Done()
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		if errShutdown := server.Shutdown(shutdownCtx); errShutdown != nil {
+			klog.V(2).Infof("unable to shutdown the control API server: %v", errShutdown)
+		}
+	}()
+
+	if network == "unix" {
+		return unixPrefix + addr, nil
+	}
+	return listener.Addr().String(), nil
+}
+
+// removeStaleSocket removes the unix socket left at path by a previous session.
+// An error is returned if path exists and is not a socket, or if a server still accepts connections on it.
+func removeStaleSocket(path string) error {
+	info, err := os.Lstat(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if !isSocket(info) {
+		return fmt.Errorf("unable to listen on %q: the file exists and is not a socket", path)
+	}
+	conn, err := net.DialTimeout("unix", path, time.Second)
+	if err == nil {
+		_ = conn.Close()
+		return fmt.Errorf("unable to listen on %q: the socket is used by another process", path)
+	}
+	if err = os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("unable to remove the existing socket %q: %w", path, err)
+	}
+	return nil
+}
+
+// Handler returns the handler of the API
+func (o *Server) Handler() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v1/status", o.method(http.MethodGet, o.controlHandler(watch.ControlActionStatus)))
+	mux.HandleFunc("/api/v1/ports", o.method(http.MethodGet, o.portsHandler))
+	mux.HandleFunc("/api/v1/sync", o.method(http.MethodPost, o.controlHandler(watch.ControlActionSync)))
+	mux.HandleFunc("/api/v1/rerun", o.method(http.MethodPost, o.controlHandler(watch.ControlActionRerun)))
+	mux.HandleFunc("/api/v1/debug", func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodPost:
+			o.controlHandler(watch.ControlActionDebug)(w, r)
+		case http.MethodDelete:
+			o.controlHandler(watch.ControlActionRun)(w, r)
+		default:
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+		}
+	})
+	mux.HandleFunc("/api/v1/stop", o.method(http.MethodPost, o.stopHandler))
+	return o.authenticate(mux)
+}
+
+// authenticate rejects the requests which do not pass the token of the server as a Bearer token,
+// and the requests coming from web browsers: requests with an Origin header, and requests for a host
+// other than a loopback address, sent to the server through DNS rebinding
+func (o *Server) authenticate(handler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Origin") != "" {
+			writeError(w, http.StatusForbidden, errors.New("cross-origin requests are not allowed"))
+			return
+		}
+		if !isLoopbackHost(r.Host) {
+			writeError(w, http.StatusForbidden, fmt.Errorf("host %q is not allowed", r.Host))
+			return
+		}
+		authorization := r.Header.Get("Authorization")
+		token := strings.TrimPrefix(authorization, "Bearer ")
+		if token == authorization || subtle.ConstantTimeCompare([]byte(token), []byte(o.token)) != 1 {
+			w.Header().Set("WWW-Authenticate", "Bearer")
+			writeError(w, http.StatusUnauthorized, errors.New("a valid token is required"))
+			return
+		}
+		handler.ServeHTTP(w, r)
+	})
+}
+
+// isLoopbackHost returns true if host, with an optional port, is localhost or a loopback IP address
+func isLoopbackHost(host string) bool {
+	if h, _, err := net.SplitHostPort(host); err == nil {
+		host = h
+	}
+	if host == "localhost" {
+		return true
+	}
+	ip := net.ParseIP(strings.TrimSuffix(strings.TrimPrefix(host, "["), "]"))
+	return ip != nil && ip.IsLoopback()
+}
+
+// method restricts the handler to the given HTTP method
+func (o *Server) method(method string, handler http.HandlerFunc) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			writeError(w, http.StatusMethodNotAllowed, fmt.Errorf("method %s not allowed", r.Method))
+			return
+		}
+		handler(w, r)
+	}
+}
+
+// controlHandler sends the action to the watch loop, and returns the status of the session once the action is executed
+func (o *Server) controlHandler(action watch.ControlAction) http.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request) {
+		responses := make(chan watch.ControlResponse, 1)
+		request := watch.ControlRequest{
+			Action:   action,
+			Response: responses,
+		}
+
+		ctx, cancel := context.WithTimeout(r.Context(), requestTimeout)
+		defer cancel()
+		select {
+		case o.requests <- request:
+		case <-ctx.Done():
+			writeError(w, http.StatusServiceUnavailable, errors.New("the session is not able to handle the request"))
+			return
+		}
+
+		select {
+		case response := <-responses:
+			if response.Err != nil {
+				writeError(w, http.StatusInternalServerError, response.Err)
+				return
+			}
+			writeJSON(w, http.StatusOK, Status{
+				State: response.Status.State,
+				Debug: response.Debug,
+			})
+		case <-ctx.Done():
+			writeError(w, http.StatusGatewayTimeout, errors.New("timeout waiting for the session to handle the request"))
+		}
+	}
+}
+
+func (o *Server) portsHandler(w http.ResponseWriter, r *http.Request) {
+	fwPorts, err := o.stateClient.GetForwardedPorts()
+	if err != nil {
+		writeError(w, http.StatusInternalServerError, err)
+		return
+	}
+	if fwPorts == nil {
+		fwPorts = []api.ForwardedPort{}
+	}
+	writeJSON(w, http.StatusOK, fwPorts)
+}
+
+func (o *Server) stopHandler(w http.ResponseWriter, r *http.Request) {
+	w.WriteHeader(http.StatusAccepted)
+	// The session is stopped once the response is sent, the same way as when the user presses Ctrl+c
+	go o.stop()
+}
+
+func writeJSON(w http.ResponseWriter, status int, content interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(content); err != nil {
+		klog.V(4).Infof("unable to write the response: %v", err)
+	}
+}
+
+func writeError(w http.ResponseWriter, status int, err error) {
+	writeJSON(w, status, Error{Message: err.Error()})
+}
@@ -0,0 +1,299 @@
+package apiserver
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/state"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+func TestServer_Handler(t *testing.T) {
+	fwPort := api.ForwardedPort{
+		ContainerName: "runtime",
+		LocalAddress:  "127.0.0.1",
+		LocalPort:     20001,
+		ContainerPort: 8080,
+	}
+
+	const token = "0123456789abcdef"
+
+	tests := []struct {
+		name   string
+		method string
+		path   string
+		// header replaces the headers of the request, which is authenticated with the token by default
+		header      http.Header
+		host        string
+		responseErr error
+		wantStatus  int
+		wantAction  watch.ControlAction
+		wantStopped bool
+		wantBody    interface{}
+	}{
+		{
+			name:       "get the status",
+			method:     http.MethodGet,
+			path:       "/api/v1/status",
+			wantStatus: http.StatusOK,
+			wantAction: watch.ControlActionStatus,
+			wantBody:   map[string]interface{}{"state": string(watch.StateReady), "debug": false},
+		},
+		{
+			name:       "get the forwarded ports",
+			method:     http.MethodGet,
+			path:       "/api/v1/ports",
+			wantStatus: http.StatusOK,
+			wantBody: []interface{}{
+				map[string]interface{}{
+					"containerName": "runtime",
+					"localAddress":  "127.0.0.1",
+					"localPort":     float64(20001),
+					"containerPort": float64(8080),
+					"portName":      "",
+					"isDebug":       false,
+				},
+			},
+		},
+		{
+			name:       "sync the files",
+			method:     http.MethodPost,
+			path:       "/api/v1/sync",
+			wantStatus: http.StatusOK,
+			wantAction: watch.ControlActionSync,
+		},
+		{
+			name:       "rerun the command",
+			method:     http.MethodPost,
+			path:       "/api/v1/rerun",
+			wantStatus: http.StatusOK,
+			wantAction: watch.ControlActionRerun,
+		},
+		{
+			name:       "switch to debug",
+			method:     http.MethodPost,
+			path:       "/api/v1/debug",
+			wantStatus: http.StatusOK,
+			wantAction: watch.ControlActionDebug,
+		},
+		{
+			name:       "switch back to run",
+			method:     http.MethodDelete,
+			path:       "/api/v1/debug",
+			wantStatus: http.StatusOK,
+			wantAction: watch.ControlActionRun,
+		},
+		{
+			name:        "error executing the action",
+			method:      http.MethodPost,
+			path:        "/api/v1/rerun",
+			responseErr: errors.New("an error"),
+			wantStatus:  http.StatusInternalServerError,
+			wantAction:  watch.ControlActionRerun,
+			wantBody:    map[string]interface{}{"message": "an error"},
+		},
+		{
+			name:        "stop the session",
+			method:      http.MethodPost,
+			path:        "/api/v1/stop",
+			wantStatus:  http.StatusAccepted,
+			wantStopped: true,
+		},
+		{
+			name:       "method not allowed",
+			method:     http.MethodPost,
+			path:       "/api/v1/status",
+			wantStatus: http.StatusMethodNotAllowed,
+		},
+		{
+			name:       "unknown path",
+			method:     http.MethodGet,
+			path:       "/api/v1/unknown",
+			wantStatus: http.StatusNotFound,
+		},
+		{
+			name:       "missing token",
+			method:     http.MethodPost,
+			path:       "/api/v1/stop",
+			header:     http.Header{},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:       "invalid token",
+			method:     http.MethodPost,
+			path:       "/api/v1/stop",
+			header:     http.Header{"Authorization": []string{"Bearer invalid"}},
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name:   "request from a web page",
+			method: http.MethodPost,
+			path:   "/api/v1/stop",
+			header: http.Header{
+				"Authorization": []string{"Bearer " + token},
+				"Origin":        []string{"http://example.com"},
+			},
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "non-loopback host",
+			method:     http.MethodPost,
+			path:       "/api/v1/stop",
+			host:       "example.com:20000",
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name:       "localhost",
+			method:     http.MethodGet,
+			path:       "/api/v1/status",
+			host:       "localhost",
+			wantStatus: http.StatusOK,
+			wantAction: watch.ControlActionStatus,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stateClient := state.NewStateClient(filesystem.NewFakeFs())
+			if err := stateClient.SetForwardedPorts([]api.ForwardedPort{fwPort}); err != nil {
+				t.Fatal(err)
+			}
+
+			requests := make(chan watch.ControlRequest, 1)
+			stopped := make(chan struct{})
+			server := NewServer(stateClient, token, requests, func() { close(stopped) })
+
+			var gotAction watch.ControlAction
+			quit := make(chan struct{})
+			defer close(quit)
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				select {
+				case request := <-requests:
+					gotAction = request.Action
+					request.Response <- watch.ControlResponse{
+						Status: watch.ComponentStatus{State: watch.StateReady},
+						Err:    tt.responseErr,
+					}
+				case <-stopped:
+				case <-quit:
+				}
+			}()
+
+			req := httptest.NewRequest(tt.method, tt.path, nil)
+			req.Host = "127.0.0.1:20000"
+			if tt.host != "" {
+				req.Host = tt.host
+			}
+			req.Header.Set("Authorization", "Bearer "+token)
+			if tt.header != nil {
+				req.Header = tt.header
+			}
+			rec := httptest.NewRecorder()
+			server.Handler().ServeHTTP(rec, req)
+
+			if tt.wantAction != "" || tt.wantStopped {
+				<-done
+			}
+			if rec.Code != tt.wantStatus {
+				t.Errorf("status = %d, want %d", rec.Code, tt.wantStatus)
+			}
+			if gotAction != tt.wantAction {
+				t.Errorf("action = %q, want %q", gotAction, tt.wantAction)
+			}
+			if tt.wantBody != nil {
+				var got interface{}
+				if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+					t.Fatalf("unable to parse response %q: %v", rec.Body.String(), err)
+				}
+				if diff := cmp.Diff(tt.wantBody, got); diff != "" {
+					t.Errorf("body mismatch (-want +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+func TestServer_Start_unixSocket(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	socket := filepath.Join(t.TempDir(), "odo.sock")
+	server := NewServer(state.NewStateClient(filesystem.NewFakeFs()), "token", nil, func() {})
+	address, err := server.Start(ctx, unixPrefix+socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if address != unixPrefix+socket {
+		t.Errorf("address = %q, want %q", address, unixPrefix+socket)
+	}
+	info, err := os.Stat(socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); runtime.GOOS != "windows" && perm&0077 != 0 {
+		t.Errorf("permissions of the socket = %o, other users should not be able to connect", perm)
+	}
+
+	// the socket of a running session is not replaced
+	_, err = NewServer(state.NewStateClient(filesystem.NewFakeFs()), "token", nil, func() {}).Start(ctx, unixPrefix+socket)
+	if err == nil {
+		t.Error("expected an error when the socket is used by another session")
+	}
+}
+
+func TestServer_Start_existingFile(t *testing.T) {
+	dir := t.TempDir()
+
+	// the socket left by a previous session is replaced
+	staleSocket := filepath.Join(dir, "stale.sock")
+	listener, err := net.Listen("unix", staleSocket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	listener.(*net.UnixListener).SetUnlinkOnClose(false)
+	_ = listener.Close()
+
+	// a file which is not a socket is not removed
+	regularFile := filepath.Join(dir, "file")
+	if err = os.WriteFile(regularFile, []byte("content"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name    string
+		path    string
+		wantErr bool
+	}{
+		{name: "stale socket", path: staleSocket},
+		{name: "regular file", path: regularFile, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+			server := NewServer(state.NewStateClient(filesystem.NewFakeFs()), "token", nil, func() {})
+			_, err := server.Start(ctx, unixPrefix+tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Start() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr {
+				return
+			}
+			if content, err := os.ReadFile(tt.path); err != nil || string(content) != "content" {
+				t.Errorf("file %q should be kept, got %q, %v", tt.path, string(content), err)
+			}
+		})
+	}
+}
@@ -17,8 +17,10 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/alizer"
 	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/platform"
@@ -107,6 +109,27 @@ func Log(platformClient platform.Client, componentName string, appName string, f
 	return platformClient.GetPodLogs(pod.Name, containerName, follow)
 }
 
+// DisplayContainersLogs displays the last numberOfLines lines of the logs of each container of the pod
+func DisplayContainersLogs(platformClient platform.Client, pod *corev1.Pod, numberOfLines int, out io.Writer) error {
+	for _, container := range pod.Spec.Containers {
+		err := DisplayContainerLogs(platformClient, pod.Name, container.Name, numberOfLines, out)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DisplayContainerLogs displays the last numberOfLines lines of the logs of a container of the pod
+func DisplayContainerLogs(platformClient platform.Client, podName string, containerName string, numberOfLines int, out io.Writer) error {
+	rd, err := platformClient.GetPodLogs(podName, containerName, false)
+	if err != nil {
+		return fmt.Errorf("unable to get logs of container %q: %w", containerName, err)
+	}
+	fmt.Fprintf(out, "\n%s\n", log.Sbold(fmt.Sprintf("Last %d lines of log of container %s:", numberOfLines, containerName)))
+	return util.DisplayLog(false, rd, out, containerName, numberOfLines)
+}
+
 // ListAllClusterComponents returns a list of all "components" on a cluster
 // that are both odo and non-odo components.
 //
@@ -209,7 +232,7 @@ func ListAllClusterComponents(client kclient.ClientInterface, namespace string)
 	return components, nil
 }
 
-func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Client, namespace string, devObj *parser.DevfileObj, componentName string) ([]api.ComponentAbstract, string, error) {
+func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Client, dockerClient docker.Client, namespace string, devObj *parser.DevfileObj, componentName string) ([]api.ComponentAbstract, string, error) {
 	var (
 		allComponents []api.ComponentAbstract
 	)
@@ -231,6 +254,15 @@ func ListAllComponents(client kclient.ClientInterface, podmanClient podman.Clien
 		allComponents = append(allComponents, podmanComponents...)
 	}
 
+	// DockerClient can be nil if experimental mode is not active
+	if dockerClient != nil {
+		dockerComponents, err := dockerClient.ListAllComponents()
+		if err != nil {
+			return nil, "", err
+		}
+		allComponents = append(allComponents, dockerComponents...)
+	}
+
 	localComponent := api.ComponentAbstract{
 		Name:      componentName,
 		ManagedBy: "",
@@ -216,18 +216,33 @@ func (do *DeleteComponentClient) ExecutePreStopEvents(devfileObj parser.DevfileO
 }
 
 func (do *DeleteComponentClient) ListPodmanResourcesToDelete(appName string, componentName string, mode string) (isInnerLoopDeployed bool, pods []*corev1.Pod, err error) {
-	if mode == odolabels.ComponentDeployMode {
-		return false, nil, nil
+	if mode != odolabels.ComponentDeployMode {
+		isInnerLoopDeployed, pods, err = do.listPodmanDevPods(appName, componentName)
+		if err != nil {
+			return false, nil, err
+		}
+	}
+
+	if mode != odolabels.ComponentDevMode {
+		var deployPods []*corev1.Pod
+		deployPods, err = do.listPodmanDeployPods(appName, componentName)
+		if err != nil {
+			return false, nil, err
+		}
+		pods = append(pods, deployPods...)
 	}
+	return isInnerLoopDeployed, pods, nil
+}
 
-	// Inner Loop
+// listPodmanDevPods returns the pod created on podman by odo dev for the given component/app, if it exists
+func (do *DeleteComponentClient) listPodmanDevPods(appName string, componentName string) (isInnerLoopDeployed bool, pods []*corev1.Pod, err error) {
 	var podName string
 	podName, err = util.NamespaceKubernetesObject(componentName, appName)
 	if err != nil {
 		return false, nil, fmt.Errorf("failed to get the resource %q name for component %q; cause: %w", kclient.DeploymentKind, componentName, err)
 	}
 
-	allPods, err := do.podmanClient.PodLs()
+	allPods, err := do.podmanClient.PodLs(odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, true))
 	if err != nil {
 		err = clierrors.NewWarning("failed to get pods on podman", err)
 		return false, nil, err
@@ -242,3 +257,21 @@ func (do *DeleteComponentClient) ListPodmanResourcesToDelete(appName string, com
 	}
 	return isInnerLoopDeployed, pods, nil
 }
+
+// listPodmanDeployPods returns the pods created on podman by odo deploy for the given component/app
+func (do *DeleteComponentClient) listPodmanDeployPods(appName string, componentName string) ([]*corev1.Pod, error) {
+	selector := odolabels.GetSelector(componentName, appName, odolabels.ComponentDeployMode, false)
+	list, err := do.podmanClient.GetAllResourcesFromSelector(selector, "")
+	if err != nil {
+		return nil, clierrors.NewWarning("failed to get pods on podman", err)
+	}
+	var pods []*corev1.Pod
+	for _, resource := range list {
+		podDef, err := do.podmanClient.KubeGenerate(resource.GetName())
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, podDef)
+	}
+	return pods, nil
+}
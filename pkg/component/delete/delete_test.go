@@ -786,6 +786,7 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 	}
 
 	podName := "a-component-an-app"
+	devSelector := "app.kubernetes.io/instance=a-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=an-app,component=a-component,odo.dev/mode=Dev"
 	podDef := corev1.Pod{}
 	podDef.SetName(podName)
 
@@ -811,7 +812,7 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 			fields: fields{
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					podmanCli := podman.NewMockClient(ctrl)
-					podmanCli.EXPECT().PodLs().Return(nil, errors.New("error running PodLs"))
+					podmanCli.EXPECT().PodLs(devSelector).Return(nil, errors.New("error running PodLs"))
 					return podmanCli
 				},
 			},
@@ -826,7 +827,8 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 			fields: fields{
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					podmanCli := podman.NewMockClient(ctrl)
-					podmanCli.EXPECT().PodLs().Return(map[string]bool{}, nil)
+					podmanCli.EXPECT().PodLs(devSelector).Return(map[string]bool{}, nil)
+					podmanCli.EXPECT().GetAllResourcesFromSelector("app.kubernetes.io/instance=a-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=an-app,odo.dev/mode=Deploy", "").Return(nil, nil)
 					return podmanCli
 				},
 			},
@@ -843,7 +845,8 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 			fields: fields{
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					podmanCli := podman.NewMockClient(ctrl)
-					podmanCli.EXPECT().PodLs().Return(map[string]bool{"another-pod": true}, nil)
+					podmanCli.EXPECT().PodLs(devSelector).Return(map[string]bool{"another-pod": true}, nil)
+					podmanCli.EXPECT().GetAllResourcesFromSelector("app.kubernetes.io/instance=a-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=an-app,odo.dev/mode=Deploy", "").Return(nil, nil)
 					return podmanCli
 				},
 			},
@@ -860,9 +863,10 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 			fields: fields{
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					podmanCli := podman.NewMockClient(ctrl)
-					podmanCli.EXPECT().PodLs().Return(map[string]bool{podName: true}, nil)
+					podmanCli.EXPECT().PodLs(devSelector).Return(map[string]bool{podName: true}, nil)
 
 					podmanCli.EXPECT().KubeGenerate(podName).Return(&podDef, nil)
+					podmanCli.EXPECT().GetAllResourcesFromSelector("app.kubernetes.io/instance=a-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=an-app,odo.dev/mode=Deploy", "").Return(nil, nil)
 					return podmanCli
 				},
 			},
@@ -879,7 +883,7 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 			fields: fields{
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					podmanCli := podman.NewMockClient(ctrl)
-					podmanCli.EXPECT().PodLs().Return(map[string]bool{podName: true}, nil)
+					podmanCli.EXPECT().PodLs(devSelector).Return(map[string]bool{podName: true}, nil)
 
 					podmanCli.EXPECT().KubeGenerate(podName).Return(&podDef, nil)
 					return podmanCli
@@ -899,9 +903,10 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 			fields: fields{
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					podmanCli := podman.NewMockClient(ctrl)
-					podmanCli.EXPECT().PodLs().Return(map[string]bool{podName: true}, nil).Times(0)
+					podmanCli.EXPECT().PodLs(devSelector).Return(map[string]bool{podName: true}, nil).Times(0)
 
 					podmanCli.EXPECT().KubeGenerate(podName).Return(&podDef, nil).Times(0)
+					podmanCli.EXPECT().GetAllResourcesFromSelector("app.kubernetes.io/instance=a-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=an-app,odo.dev/mode=Deploy", "").Return(nil, nil)
 					return podmanCli
 				},
 			},
@@ -914,12 +919,34 @@ func TestDeleteComponentClient_ListPodmanResourcesToDeleteFromDevfile(t *testing
 			wantIsInnerLoopDeployed: false,
 			wantPods:                nil,
 		},
+		{
+			name: "component's deployed pod running on podman - deploy mode requested",
+			fields: fields{
+				podmanClient: func(ctrl *gomock.Controller) podman.Client {
+					podmanCli := podman.NewMockClient(ctrl)
+					deployedPod := unstructured.Unstructured{}
+					deployedPod.SetName("my-deployment-pod")
+					podmanCli.EXPECT().GetAllResourcesFromSelector("app.kubernetes.io/instance=a-component,app.kubernetes.io/managed-by=odo,app.kubernetes.io/part-of=an-app,odo.dev/mode=Deploy", "").
+						Return([]unstructured.Unstructured{deployedPod}, nil)
+					podmanCli.EXPECT().KubeGenerate("my-deployment-pod").Return(&podDef, nil)
+					return podmanCli
+				},
+			},
+			args: args{
+				appName:       "an-app",
+				componentName: "a-component",
+				mode:          odolabels.ComponentDeployMode,
+			},
+			wantErr:                 false,
+			wantIsInnerLoopDeployed: false,
+			wantPods:                []*corev1.Pod{&podDef},
+		},
 		{
 			name: "kube generate fails",
 			fields: fields{
 				podmanClient: func(ctrl *gomock.Controller) podman.Client {
 					podmanCli := podman.NewMockClient(ctrl)
-					podmanCli.EXPECT().PodLs().Return(map[string]bool{podName: true}, nil)
+					podmanCli.EXPECT().PodLs(devSelector).Return(map[string]bool{podName: true}, nil)
 
 					podmanCli.EXPECT().KubeGenerate(podName).Return(nil, errors.New("error executing KubeGenerate"))
 					return podmanCli
@@ -21,7 +21,7 @@ type Client interface {
 	// and a bool that indicates if the devfile component has been pushed to the innerloop.
 	// The mode indicates which component to list, either Dev, Deploy or Any (using constant labels.Component*Mode).
 	ListClusterResourcesToDeleteFromDevfile(devfileObj parser.DevfileObj, appName string, componentName string, mode string) (bool, []unstructured.Unstructured, error)
-	// ListPodmanResourcesToDelete returns a list of resources that are present on podman in Dev mode that can be deleted for the given component/app,
+	// ListPodmanResourcesToDelete returns a list of resources that are present on podman in Dev or Deploy mode that can be deleted for the given component/app,
 	// and a bool that indicates if the devfile component has been pushed to the innerloop.
 	// The mode indicates which component to list, either Dev, Deploy or Any (using constant labels.Component*Mode).
 	ListPodmanResourcesToDelete(appName string, componentName string, mode string) (isInnerLoopDeployed bool, pods []*corev1.Pod, err error)
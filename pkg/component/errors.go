@@ -22,3 +22,25 @@ func (e NoComponentFoundError) Error() string {
 	}
 	return fmt.Sprintf("no component found with name %q", e.name)
 }
+
+// ExecCommandError is returned when an exec command of the Devfile terminates with a non-zero exit code
+type ExecCommandError struct {
+	commandId string
+	exitCode  int
+}
+
+func NewExecCommandError(commandId string, exitCode int) ExecCommandError {
+	return ExecCommandError{
+		commandId: commandId,
+		exitCode:  exitCode,
+	}
+}
+
+func (e ExecCommandError) Error() string {
+	return fmt.Sprintf("command %q exited with code %d", e.commandId, e.exitCode)
+}
+
+// ExitCode returns the exit code of the command
+func (e ExecCommandError) ExitCode() int {
+	return e.exitCode
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
 
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
@@ -61,11 +62,16 @@ func (o *execHandler) Execute(command v1alpha2.Command) error {
 	logger := machineoutput.NewMachineEventLoggingClient()
 	stdoutWriter, stdoutChannel, stderrWriter, stderrChannel := logger.CreateContainerOutputWriter()
 
+	groupKind := getGroupKind(command)
+	logger.DevFileCommandExecutionBegin(command.Id, command.Exec.Component, command.Exec.CommandLine, groupKind, machineoutput.TimestampNow())
+
 	cmdline := getCmdline(command)
 	_, _, err := o.execClient.ExecuteCommand(cmdline, o.podName, command.Exec.Component, o.show, stdoutWriter, stderrWriter)
 
 	closeWriterAndWaitForAck(stdoutWriter, stdoutChannel, stderrWriter, stderrChannel)
 
+	logger.DevFileCommandExecutionComplete(command.Id, command.Exec.Component, command.Exec.CommandLine, groupKind, machineoutput.TimestampNow(), getExitCode(err), err)
+
 	spinner.End(err == nil)
 	if err != nil {
 		rd, errLog := Log(o.platformClient, o.componentName, o.appName, false, command)
@@ -109,6 +115,27 @@ func getCmdline(command v1alpha2.Command) []string {
 	return cmd
 }
 
+// getGroupKind returns the kind of the group of the command, or an empty string if the command is not part of a group
+func getGroupKind(command v1alpha2.Command) string {
+	group := common.GetGroup(command)
+	if group == nil {
+		return ""
+	}
+	return string(group.Kind)
+}
+
+// getExitCode returns the exit code of a command executed in a container and terminated with err,
+// or -1 if it cannot be determined
+func getExitCode(err error) int {
+	if err == nil {
+		return 0
+	}
+	if exitCode, ok := platform.GetExitCode(err); ok {
+		return exitCode
+	}
+	return -1
+}
+
 func closeWriterAndWaitForAck(stdoutWriter *io.PipeWriter, stdoutChannel chan interface{}, stderrWriter *io.PipeWriter, stderrChannel chan interface{}) {
 	if stdoutWriter != nil {
 		_ = stdoutWriter.Close()
@@ -10,16 +10,23 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	"github.com/redhat-developer/odo/pkg/platform"
 	"github.com/redhat-developer/odo/pkg/remotecmd"
 	"github.com/redhat-developer/odo/pkg/task"
 	"github.com/redhat-developer/odo/pkg/util"
 )
 
-const numberOfLinesToOutputLog = 100
+const (
+	numberOfLinesToOutputLog = 100
+
+	// numberOfLinesToOutputOnRestart is the number of lines of log displayed when a crashed command is restarted
+	numberOfLinesToOutputOnRestart = 20
+)
 
 // ExecuteRunCommand executes a Devfile command in the specified pod
 // If componentExists, the previous instance of the command will be stopped before (if hotReloadCapable is not set)
+// If supervisor is not nil, the command is restarted by the supervisor when its process terminates with an error.
 func ExecuteRunCommand(
 	execClient exec.Client,
 	platformClient platform.Client,
@@ -28,20 +35,39 @@ func ExecuteRunCommand(
 	podName string,
 	appName string,
 	componentName string,
+	supervisor *RunSupervisor,
 ) error {
 	remoteProcessHandler := remotecmd.NewKubeExecProcessHandler(execClient)
+	logger := machineoutput.NewMachineEventLoggingClient()
+	groupKind := getGroupKind(devfileCmd)
 
-	statusHandlerFunc := func(s *log.Status) remotecmd.CommandOutputHandler {
+	var statusHandlerFunc func(s *log.Status, generation int) remotecmd.CommandOutputHandler
+	statusHandlerFunc = func(s *log.Status, generation int) remotecmd.CommandOutputHandler {
 		return func(status remotecmd.RemoteProcessStatus, stdout []string, stderr []string, err error) {
 			switch status {
 			case remotecmd.Starting:
 				// Creating with no spin because the command could be long-running, and we cannot determine when it will end.
 				s.Start(fmt.Sprintf("Executing the application (command: %s)", devfileCmd.Id), true)
+				logger.DevFileCommandExecutionBegin(devfileCmd.Id, devfileCmd.Exec.Component, devfileCmd.Exec.CommandLine, groupKind, machineoutput.TimestampNow())
 			case remotecmd.Stopped, remotecmd.Errored:
 				s.EndWithStatus(fmt.Sprintf("Finished executing the application (command: %s)", devfileCmd.Id), status == remotecmd.Stopped)
 				if err != nil {
 					klog.V(2).Infof("error while running background command: %v", err)
 				}
+				exitCode := getExitCode(err)
+				if status == remotecmd.Errored && err == nil {
+					exitCode = getRemoteProcessExitCode(remoteProcessHandler, devfileCmd, podName)
+				}
+				logger.DevFileCommandExecutionComplete(devfileCmd.Id, devfileCmd.Exec.Component, devfileCmd.Exec.CommandLine, groupKind, machineoutput.TimestampNow(), exitCode, err)
+
+				// An error returned by the exec means that the container is not reachable anymore (e.g. the pod is deleted),
+				// in which case the command will be executed again in the new container
+				if status == remotecmd.Errored && err == nil {
+					restartCrashedCommand(remoteProcessHandler, platformClient, devfileCmd, podName, appName, componentName, exitCode, supervisor, generation,
+						func(generation int) remotecmd.CommandOutputHandler {
+							return statusHandlerFunc(s, generation)
+						})
+				}
 			}
 		}
 	}
@@ -61,12 +87,14 @@ func ExecuteRunCommand(
 				return err
 			}
 
+			supervisor.stopping(devfileCmd.Id)
 			err = remoteProcessHandler.StopProcessForCommand(cmdDef, podName, devfileCmd.Exec.Component)
 			if err != nil {
 				return err
 			}
 
-			if err = remoteProcessHandler.StartProcessForCommand(cmdDef, podName, devfileCmd.Exec.Component, statusHandlerFunc(spinner)); err != nil {
+			generation := supervisor.started(devfileCmd.Id)
+			if err = remoteProcessHandler.StartProcessForCommand(cmdDef, podName, devfileCmd.Exec.Component, statusHandlerFunc(spinner, generation)); err != nil {
 				return err
 			}
 		} else {
@@ -78,7 +106,8 @@ func ExecuteRunCommand(
 			return err
 		}
 
-		if err := remoteProcessHandler.StartProcessForCommand(cmdDef, podName, devfileCmd.Exec.Component, statusHandlerFunc(spinner)); err != nil {
+		generation := supervisor.started(devfileCmd.Id)
+		if err := remoteProcessHandler.StartProcessForCommand(cmdDef, podName, devfileCmd.Exec.Component, statusHandlerFunc(spinner, generation)); err != nil {
 			return err
 		}
 	}
@@ -113,6 +142,71 @@ func ExecuteRunCommand(
 		fmt.Sprintf("Devfile command %q exited with an error status in %.0f second(s)", devfileCmd.Id, totalWaitTime))
 }
 
+// restartCrashedCommand displays the exit code and the last lines of log of the crashed command,
+// and restarts the command after the backoff delay of the supervisor, unless the command has been stopped or started again by odo since.
+// outputHandler returns the handler of the output of the restarted process, given its generation.
+func restartCrashedCommand(
+	remoteProcessHandler remotecmd.RemoteProcessHandler,
+	platformClient platform.Client,
+	devfileCmd devfilev1.Command,
+	podName string,
+	appName string,
+	componentName string,
+	exitCode int,
+	supervisor *RunSupervisor,
+	generation int,
+	outputHandler func(generation int) remotecmd.CommandOutputHandler,
+) {
+	if !supervisor.isCurrent(devfileCmd.Id, generation) {
+		return
+	}
+
+	log.Warningf("Devfile command %q exited with code %d", devfileCmd.Id, exitCode)
+	log.Warningf("Last %d lines of log:", numberOfLinesToOutputOnRestart)
+	rd, err := Log(platformClient, componentName, appName, false, devfileCmd)
+	if err == nil {
+		// Use GetStderr in order to make sure that colour output is correct
+		// on non-TTY terminals
+		err = util.DisplayLog(false, rd, log.GetStderr(), componentName, numberOfLinesToOutputOnRestart)
+	}
+	if err != nil {
+		klog.V(2).Infof("unable to display the logs of command %q: %v", devfileCmd.Id, err)
+	}
+
+	delay, restarts, ok := supervisor.nextRestart(devfileCmd.Id, generation)
+	if !ok {
+		if supervisor.isCurrent(devfileCmd.Id, generation) {
+			log.Warningf("Devfile command %q crashed %d times, it will not be restarted until the next change of the source files", devfileCmd.Id, MaxRunCommandRestarts+1)
+		}
+		return
+	}
+	log.Infof("Restarting Devfile command %q in %s (restart %d/%d)", devfileCmd.Id, delay, restarts, MaxRunCommandRestarts)
+	if !supervisor.wait(delay) || !supervisor.isCurrent(devfileCmd.Id, generation) {
+		return
+	}
+
+	cmdDef, err := devfileCommandToRemoteCmdDefinition(devfileCmd)
+	if err != nil {
+		log.Warningf("Unable to restart Devfile command %q: %v", devfileCmd.Id, err)
+		return
+	}
+	generation = supervisor.started(devfileCmd.Id)
+	err = remoteProcessHandler.StartProcessForCommand(cmdDef, podName, devfileCmd.Exec.Component, outputHandler(generation))
+	if err != nil {
+		log.Warningf("Unable to restart Devfile command %q: %v", devfileCmd.Id, err)
+	}
+}
+
+// getRemoteProcessExitCode returns the exit code of the terminated process of the command, or -1 if it cannot be determined
+func getRemoteProcessExitCode(remoteProcessHandler remotecmd.RemoteProcessHandler, devfileCmd devfilev1.Command, podName string) int {
+	remoteProcess, err := remoteProcessHandler.GetProcessInfoForCommand(remotecmd.CommandDefinition{Id: devfileCmd.Id}, podName, devfileCmd.Exec.Component)
+	if err != nil {
+		klog.V(4).Infof("unable to get the status of the process for command %q: %v", devfileCmd.Id, err)
+		return -1
+	}
+	return remoteProcess.ExitCode
+}
+
 // devfileCommandToRemoteCmdDefinition builds and returns a new remotecmd.CommandDefinition object from the specified devfileCmd.
 // An error is returned for non-exec Devfile commands.
 func devfileCommandToRemoteCmdDefinition(devfileCmd devfilev1.Command) (remotecmd.CommandDefinition, error) {
@@ -0,0 +1,109 @@
+package component
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/devfile/image"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// runCommandHandler executes the commands of a Devfile in the pod of a running Dev session,
+// streaming the output of the exec commands
+type runCommandHandler struct {
+	ctx            context.Context
+	fs             filesystem.Filesystem
+	platformClient platform.Client
+	// kubeClient is used to apply Kubernetes components, it is nil if the Dev session is not running on a cluster
+	kubeClient    kclient.ClientInterface
+	devfileObj    parser.DevfileObj
+	path          string
+	appName       string
+	componentName string
+	podName       string
+	// platformName is the name of the platform, to be displayed to the user
+	platformName string
+	stdout       io.Writer
+	stderr       io.Writer
+}
+
+var _ libdevfile.Handler = (*runCommandHandler)(nil)
+
+func NewRunCommandHandler(
+	ctx context.Context,
+	fs filesystem.Filesystem,
+	platformClient platform.Client,
+	kubeClient kclient.ClientInterface,
+	devfileObj parser.DevfileObj,
+	path string,
+	appName string,
+	componentName string,
+	podName string,
+	platformName string,
+	stdout io.Writer,
+	stderr io.Writer,
+) *runCommandHandler {
+	return &runCommandHandler{
+		ctx:            ctx,
+		fs:             fs,
+		platformClient: platformClient,
+		kubeClient:     kubeClient,
+		devfileObj:     devfileObj,
+		path:           path,
+		appName:        appName,
+		componentName:  componentName,
+		podName:        podName,
+		platformName:   platformName,
+		stdout:         stdout,
+		stderr:         stderr,
+	}
+}
+
+// ApplyImage builds the image, and pushes it to its registry when running on a cluster
+func (o *runCommandHandler) ApplyImage(img v1alpha2.Component) error {
+	return image.BuildPushSpecificImage(o.ctx, o.fs, img, o.kubeClient != nil)
+}
+
+// ApplyKubernetes creates the resources of the Kubernetes component in Dev mode when running on a cluster
+func (o *runCommandHandler) ApplyKubernetes(kubernetes v1alpha2.Component) error {
+	if o.kubeClient == nil {
+		klog.V(4).Infof("apply kubernetes commands are not implemented on %s", o.platformName)
+		log.Warningf("Apply Kubernetes components are not supported on %s. Skipping: %v.", o.platformName, kubernetes.Name)
+		return nil
+	}
+	return ApplyKubernetes(odolabels.ComponentDevMode, o.appName, o.componentName, o.devfileObj, kubernetes, o.kubeClient, o.path)
+}
+
+// Execute runs the command line of the exec command in its container, and waits for the command to terminate.
+// An ExecCommandError is returned if the command terminates with a non-zero exit code.
+func (o *runCommandHandler) Execute(command v1alpha2.Command) error {
+	cmdLine := command.Exec.CommandLine
+	if setEnvVariable := util.GetCommandStringFromEnvs(command.Exec.Env); setEnvVariable != "" {
+		cmdLine = setEnvVariable + " && " + cmdLine
+	}
+	if command.Exec.WorkingDir != "" {
+		cmdLine = "cd " + command.Exec.WorkingDir + " && (" + cmdLine + ")"
+	}
+	cmd := []string{ShellExecutable, "-c", cmdLine}
+
+	klog.V(2).Infof("executing command %q in container %q of pod %q", command.Id, command.Exec.Component, o.podName)
+	err := o.platformClient.ExecCMDInContainer(command.Exec.Component, o.podName, cmd, o.stdout, o.stderr, nil, false)
+	if err != nil {
+		if exitCode, ok := platform.GetExitCode(err); ok {
+			return NewExecCommandError(command.Id, exitCode)
+		}
+		return fmt.Errorf("unable to execute command %q in container %q: %w", command.Id, command.Exec.Component, err)
+	}
+	return nil
+}
@@ -0,0 +1,87 @@
+package component
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/golang/mock/gomock"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+func TestRunCommandHandler_Execute(t *testing.T) {
+	command := v1alpha2.Command{
+		Id: "test",
+		CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{
+				CommandLine: "npm test",
+				Component:   "runtime",
+				WorkingDir:  "${PROJECT_SOURCE}",
+				Env: []v1alpha2.EnvVar{
+					{Name: "CI", Value: "true"},
+				},
+			},
+		},
+	}
+	wantCmd := []string{"/bin/sh", "-c", `cd ${PROJECT_SOURCE} && (export CI="true" && npm test)`}
+
+	tests := []struct {
+		name         string
+		execErr      error
+		wantErr      bool
+		wantExitCode int
+	}{
+		{
+			name: "command terminating successfully",
+		},
+		{
+			name:         "command exiting with a non-zero exit code",
+			execErr:      &platform.ExecExitError{Command: wantCmd, ExitCode: 2},
+			wantErr:      true,
+			wantExitCode: 2,
+		},
+		{
+			name:    "command not executed",
+			execErr: errors.New("container not found"),
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			platformClient := podman.NewMockClient(ctrl)
+			platformClient.EXPECT().ExecCMDInContainer("runtime", "mycmp-app", wantCmd, gomock.Any(), gomock.Any(), nil, false).
+				DoAndReturn(func(_, _ string, _ []string, stdout io.Writer, _ io.Writer, _ io.Reader, _ bool) error {
+					_, _ = stdout.Write([]byte("output of the command"))
+					return tt.execErr
+				})
+
+			var stdout, stderr bytes.Buffer
+			handler := NewRunCommandHandler(context.Background(), filesystem.NewFakeFs(), platformClient, nil, parser.DevfileObj{},
+				"", "app", "mycmp", "mycmp-app", "podman", &stdout, &stderr)
+			err := handler.Execute(command)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Execute() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			var execErr ExecCommandError
+			isExecErr := errors.As(err, &execErr)
+			if tt.wantExitCode != 0 {
+				if !isExecErr || execErr.ExitCode() != tt.wantExitCode {
+					t.Errorf("Execute() expected ExecCommandError with exit code %d, got %v", tt.wantExitCode, err)
+				}
+			} else if isExecErr {
+				t.Errorf("Execute() unexpected ExecCommandError: %v", err)
+			}
+			if stdout.String() != "output of the command" {
+				t.Errorf("Execute() expected output of the command to be written, got %q", stdout.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,61 @@
+package component
+
+import (
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/remotecmd"
+)
+
+// stopHandler stops the processes started in the pod for the exec commands
+type stopHandler struct {
+	execClient exec.Client
+	podName    string
+	supervisor *RunSupervisor
+}
+
+var _ libdevfile.Handler = (*stopHandler)(nil)
+
+func (a stopHandler) ApplyImage(devfilev1.Component) error {
+	return nil
+}
+
+func (a stopHandler) ApplyKubernetes(devfilev1.Component) error {
+	return nil
+}
+
+func (a stopHandler) Execute(devfileCmd devfilev1.Command) error {
+	klog.V(2).Infof("stopping the process of command %s", devfileCmd.Id)
+	a.supervisor.stopping(devfileCmd.Id)
+	return remotecmd.NewKubeExecProcessHandler(a.execClient).StopProcessForCommand(
+		remotecmd.CommandDefinition{Id: devfileCmd.Id}, a.podName, devfileCmd.Exec.Component)
+}
+
+// StopRunCommands stops the processes started in the pod for the run command and for the debug command, if any.
+// If runCommand or debugCommand are empty, the default commands of their groups are used.
+// The stopped commands are not restarted by supervisor, if not nil.
+func StopRunCommands(
+	execClient exec.Client,
+	devfileObj parser.DevfileObj,
+	podName string,
+	runCommand string,
+	debugCommand string,
+	supervisor *RunSupervisor,
+) error {
+	handler := stopHandler{
+		execClient: execClient,
+		podName:    podName,
+		supervisor: supervisor,
+	}
+	err := libdevfile.ExecuteCommandByNameAndKind(devfileObj, runCommand, devfilev1.RunCommandGroupKind, handler, true)
+	if err != nil {
+		return err
+	}
+	if !libdevfile.HasDebugCommand(devfileObj.Data) {
+		return nil
+	}
+	return libdevfile.ExecuteCommandByNameAndKind(devfileObj, debugCommand, devfilev1.DebugCommandGroupKind, handler, true)
+}
@@ -0,0 +1,130 @@
+package component
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// MaxRunCommandRestarts is the maximum number of times a crashed run command is restarted,
+// until the next successful sync of the source files
+const MaxRunCommandRestarts = 10
+
+// RunSupervisor restarts the run commands of a Dev session when their process terminates with an error,
+// waiting for an exponential backoff delay between the restarts.
+//
+// The commands stopped on purpose by odo (to restart them after a sync, or when the user requests it)
+// are not restarted: each start and each stop of a command increments its generation,
+// and a command is restarted only if its generation did not change since it was started.
+//
+// The methods of a nil RunSupervisor are no-ops, and never restart the commands.
+type RunSupervisor struct {
+	ctx context.Context
+
+	mu       sync.Mutex
+	commands map[string]*supervisedCommand
+}
+
+type supervisedCommand struct {
+	generation int
+	restarts   int
+	backoff    *watch.ExpBackoff
+}
+
+// NewRunSupervisor returns a RunSupervisor. No command is restarted anymore once ctx is done.
+func NewRunSupervisor(ctx context.Context) *RunSupervisor {
+	return &RunSupervisor{
+		ctx:      ctx,
+		commands: map[string]*supervisedCommand{},
+	}
+}
+
+// Reset resets the number of restarts and the backoff delay of all the commands.
+// It is called after the source files are synced, as the sources may fix the cause of the crash.
+func (o *RunSupervisor) Reset() {
+	if o == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for _, cmd := range o.commands {
+		cmd.restarts = 0
+		cmd.backoff.Reset()
+	}
+}
+
+// started is called when the process of a command is started, and returns the generation of the process
+func (o *RunSupervisor) started(cmdID string) int {
+	if o == nil {
+		return 0
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	cmd := o.getCommand(cmdID)
+	cmd.generation++
+	return cmd.generation
+}
+
+// stopping is called before odo stops the process of a command on purpose, so that it is not restarted
+func (o *RunSupervisor) stopping(cmdID string) {
+	if o == nil {
+		return
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	o.getCommand(cmdID).generation++
+}
+
+// nextRestart returns the delay to wait before restarting the process of the command with the given generation,
+// and the number of the restart.
+// It returns false if the process has been stopped or started again since, or if the maximum number of restarts is reached.
+func (o *RunSupervisor) nextRestart(cmdID string, generation int) (time.Duration, int, bool) {
+	if o == nil || o.ctx.Err() != nil {
+		return 0, 0, false
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	cmd := o.getCommand(cmdID)
+	if cmd.generation != generation || cmd.restarts >= MaxRunCommandRestarts {
+		return 0, 0, false
+	}
+	cmd.restarts++
+	return cmd.backoff.Delay(), cmd.restarts, true
+}
+
+// isCurrent returns true if the process with the given generation has not been stopped or started again since,
+// and the Dev session is still running
+func (o *RunSupervisor) isCurrent(cmdID string, generation int) bool {
+	if o == nil || o.ctx.Err() != nil {
+		return false
+	}
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	return o.getCommand(cmdID).generation == generation
+}
+
+// wait waits for the given delay, and returns false if the Dev session is stopped before
+func (o *RunSupervisor) wait(delay time.Duration) bool {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+	select {
+	case <-o.ctx.Done():
+		return false
+	case <-timer.C:
+		return true
+	}
+}
+
+// getCommand returns the state of the command, creating it if necessary. o.mu must be locked.
+func (o *RunSupervisor) getCommand(cmdID string) *supervisedCommand {
+	cmd, ok := o.commands[cmdID]
+	if !ok {
+		cmd = &supervisedCommand{
+			backoff: watch.NewExpBackoff(),
+		}
+		o.commands[cmdID] = cmd
+	}
+	return cmd
+}
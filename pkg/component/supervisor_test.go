@@ -0,0 +1,93 @@
+package component
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRunSupervisor(t *testing.T) {
+	t.Run("crashed command is restarted until the maximum number of restarts", func(t *testing.T) {
+		supervisor := NewRunSupervisor(context.Background())
+		generation := supervisor.started("run")
+		for i := 1; i <= MaxRunCommandRestarts; i++ {
+			_, restarts, ok := supervisor.nextRestart("run", generation)
+			if !ok {
+				t.Fatalf("restart %d: expected the command to be restarted", i)
+			}
+			if restarts != i {
+				t.Errorf("restart %d: got restart number %d", i, restarts)
+			}
+			generation = supervisor.started("run")
+		}
+		if _, _, ok := supervisor.nextRestart("run", generation); ok {
+			t.Errorf("expected the command not to be restarted after %d restarts", MaxRunCommandRestarts)
+		}
+
+		supervisor.Reset()
+		delay, restarts, ok := supervisor.nextRestart("run", generation)
+		if !ok || restarts != 1 {
+			t.Errorf("expected the command to be restarted after a reset, got ok=%v, restarts=%d", ok, restarts)
+		}
+		if want := NewRunSupervisor(context.Background()).getCommand("run").backoff.Delay(); delay != want {
+			t.Errorf("expected the backoff delay to be reset to %s, got %s", want, delay)
+		}
+	})
+
+	t.Run("command stopped by odo is not restarted", func(t *testing.T) {
+		supervisor := NewRunSupervisor(context.Background())
+		generation := supervisor.started("run")
+		supervisor.stopping("run")
+		if supervisor.isCurrent("run", generation) {
+			t.Errorf("expected the stopped process not to be current")
+		}
+		if _, _, ok := supervisor.nextRestart("run", generation); ok {
+			t.Errorf("expected the stopped command not to be restarted")
+		}
+	})
+
+	t.Run("command started again by odo is not restarted", func(t *testing.T) {
+		supervisor := NewRunSupervisor(context.Background())
+		generation := supervisor.started("run")
+		supervisor.started("run")
+		if _, _, ok := supervisor.nextRestart("run", generation); ok {
+			t.Errorf("expected the previous process not to be restarted")
+		}
+	})
+
+	t.Run("commands are restarted independently", func(t *testing.T) {
+		supervisor := NewRunSupervisor(context.Background())
+		runGeneration := supervisor.started("run")
+		supervisor.started("debug")
+		supervisor.stopping("debug")
+		if _, _, ok := supervisor.nextRestart("run", runGeneration); !ok {
+			t.Errorf("expected the run command to be restarted")
+		}
+	})
+
+	t.Run("command is not restarted once the session is stopped", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		supervisor := NewRunSupervisor(ctx)
+		generation := supervisor.started("run")
+		cancel()
+		if _, _, ok := supervisor.nextRestart("run", generation); ok {
+			t.Errorf("expected the command not to be restarted")
+		}
+		if supervisor.wait(time.Hour) {
+			t.Errorf("expected wait to return once the session is stopped")
+		}
+	})
+
+	t.Run("nil supervisor never restarts the commands", func(t *testing.T) {
+		var supervisor *RunSupervisor
+		generation := supervisor.started("run")
+		supervisor.stopping("run")
+		supervisor.Reset()
+		if supervisor.isCurrent("run", generation) {
+			t.Errorf("expected the process not to be supervised")
+		}
+		if _, _, ok := supervisor.nextRestart("run", generation); ok {
+			t.Errorf("expected the command not to be restarted")
+		}
+	})
+}
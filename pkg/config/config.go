@@ -13,10 +13,12 @@ type Configuration struct {
 	OdoDebugTelemetryFile *string `env:"ODO_DEBUG_TELEMETRY_FILE,noinit"`
 	OdoDisableTelemetry   *bool   `env:"ODO_DISABLE_TELEMETRY,noinit"`
 	OdoLogLevel           *int    `env:"ODO_LOG_LEVEL,noinit"`
+	OdoPodmanUseAPI       bool    `env:"ODO_PODMAN_USE_API,default=false"`
 	OdoTrackingConsent    *string `env:"ODO_TRACKING_CONSENT,noinit"`
 	PodmanCmd             string  `env:"PODMAN_CMD,default=podman"`
 	TelemetryCaller       string  `env:"TELEMETRY_CALLER,default="`
 	OdoExperimentalMode   bool    `env:"ODO_EXPERIMENTAL_MODE,default=false"`
+	XdgRuntimeDir         *string `env:"XDG_RUNTIME_DIR,noinit"`
 }
 
 // GetConfiguration initializes a Configuration for odo by using the system environment.
@@ -16,6 +16,7 @@ func TestDefaultValues(t *testing.T) {
 	checkDefaultStringValue(t, "PodmanCmd", cfg.PodmanCmd, "podman")
 	checkDefaultStringValue(t, "TelemetryCaller", cfg.TelemetryCaller, "")
 	checkDefaultBoolValue(t, "OdoExperimentalMode", cfg.OdoExperimentalMode, false)
+	checkDefaultBoolValue(t, "OdoPodmanUseAPI", cfg.OdoPodmanUseAPI, false)
 
 	// Use noinit to set non initialized value as nil instead of zero-value
 	checkNilString(t, "DevfileProxy", cfg.DevfileProxy)
@@ -24,6 +25,7 @@ func TestDefaultValues(t *testing.T) {
 	checkNilString(t, "OdoDebugTelemetryFile", cfg.OdoDebugTelemetryFile)
 	checkNilBool(t, "OdoDisableTelemetry", cfg.OdoDisableTelemetry)
 	checkNilString(t, "OdoTrackingConsent", cfg.OdoTrackingConsent)
+	checkNilString(t, "XdgRuntimeDir", cfg.XdgRuntimeDir)
 
 }
 
@@ -2,7 +2,6 @@ package deploy
 
 import (
 	"context"
-	"errors"
 	"path/filepath"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -14,19 +13,22 @@ import (
 	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
 type DeployClient struct {
 	kubeClient kclient.ClientInterface
+	prefClient preference.Client
 	fs         filesystem.Filesystem
 }
 
 var _ Client = (*DeployClient)(nil)
 
-func NewDeployClient(kubeClient kclient.ClientInterface, fs filesystem.Filesystem) *DeployClient {
+func NewDeployClient(kubeClient kclient.ClientInterface, prefClient preference.Client, fs filesystem.Filesystem) *DeployClient {
 	return &DeployClient{
 		kubeClient: kubeClient,
+		prefClient: prefClient,
 		fs:         fs,
 	}
 }
@@ -39,7 +41,7 @@ func (o *DeployClient) Deploy(ctx context.Context) error {
 		componentName = odocontext.GetComponentName(ctx)
 		appName       = odocontext.GetApplication(ctx)
 	)
-	deployHandler := newDeployHandler(ctx, o.fs, *devfileObj, path, o.kubeClient, appName, componentName)
+	deployHandler := newDeployHandler(ctx, o.fs, *devfileObj, path, o.kubeClient, o.prefClient, appName, componentName)
 	return libdevfile.Deploy(*devfileObj, deployHandler)
 }
 
@@ -49,19 +51,21 @@ type deployHandler struct {
 	devfileObj    parser.DevfileObj
 	path          string
 	kubeClient    kclient.ClientInterface
+	prefClient    preference.Client
 	appName       string
 	componentName string
 }
 
 var _ libdevfile.Handler = (*deployHandler)(nil)
 
-func newDeployHandler(ctx context.Context, fs filesystem.Filesystem, devfileObj parser.DevfileObj, path string, kubeClient kclient.ClientInterface, appName string, componentName string) *deployHandler {
+func newDeployHandler(ctx context.Context, fs filesystem.Filesystem, devfileObj parser.DevfileObj, path string, kubeClient kclient.ClientInterface, prefClient preference.Client, appName string, componentName string) *deployHandler {
 	return &deployHandler{
 		ctx:           ctx,
 		fs:            fs,
 		devfileObj:    devfileObj,
 		path:          path,
 		kubeClient:    kubeClient,
+		prefClient:    prefClient,
 		appName:       appName,
 		componentName: componentName,
 	}
@@ -77,11 +81,7 @@ func (o *deployHandler) ApplyKubernetes(kubernetes v1alpha2.Component) error {
 	return component.ApplyKubernetes(odolabels.ComponentDeployMode, o.appName, o.componentName, o.devfileObj, kubernetes, o.kubeClient, o.path)
 }
 
-// Execute will deploy the listed information in the `exec` section of devfile.yaml
-// We currently do NOT support this in `odo deploy`.
+// Execute runs the exec command in a Kubernetes Job, using the container component referenced by the command
 func (o *deployHandler) Execute(command v1alpha2.Command) error {
-	// TODO:
-	// * Make sure we inject the "deploy" mode label once we implement exec in `odo deploy`
-	// * Make sure you inject the "component type" label once we implement exec.
-	return errors.New("exec command is not implemented for Deploy")
+	return o.executeInJob(command)
 }
@@ -0,0 +1,192 @@
+package deploy
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/storage"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// maxJobNameLength is the maximum length of the name of a Job, so that the name of the pods created for the Job
+// and the value of their job-name label are valid
+const maxJobNameLength = 63
+
+// executeInJob runs the exec command in a Kubernetes Job, using the container component referenced by the command.
+// The logs of the command are displayed while the Job is running,
+// and an error is returned if the command does not terminate successfully.
+func (o *deployHandler) executeInJob(command v1alpha2.Command) error {
+	job, err := getJob(o.devfileObj, command, o.componentName, o.appName)
+	if err != nil {
+		return err
+	}
+
+	// A Job created by a previous deployment cannot be updated, its pod template being immutable
+	err = o.kubeClient.DeleteJob(job.Name)
+	if err != nil {
+		return err
+	}
+
+	spinner := log.Spinnerf("Executing command %q in container %q (command: %s)", command.Exec.CommandLine, command.Exec.Component, command.Id)
+	defer spinner.End(false)
+
+	createdJob, err := o.kubeClient.CreateJob(*job, "")
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if errDelete := o.kubeClient.DeleteJob(createdJob.Name); errDelete != nil {
+			klog.V(2).Infof("unable to delete Job %q: %v", createdJob.Name, errDelete)
+		}
+	}()
+
+	// The logs are not displayed anymore once the function returns
+	logsCtx, cancelLogs := context.WithCancel(o.ctx)
+	logsDone := make(chan struct{})
+	defer func() {
+		cancelLogs()
+		<-logsDone
+	}()
+	go func() {
+		defer close(logsDone)
+		rd, errLogs := o.kubeClient.GetJobLogs(logsCtx, createdJob, command.Exec.Component)
+		if errLogs != nil {
+			klog.V(2).Infof("unable to get logs of Job %q: %v", createdJob.Name, errLogs)
+			return
+		}
+		defer rd.Close()
+		if _, errLogs = io.Copy(log.GetStdout(), rd); errLogs != nil && logsCtx.Err() == nil {
+			klog.V(2).Infof("unable to display logs of Job %q: %v", createdJob.Name, errLogs)
+		}
+	}()
+
+	finishedJob, err := o.kubeClient.WaitForJobToComplete(o.ctx, createdJob, o.prefClient.GetPushTimeout())
+	if finishedJob != nil {
+		// The pod of the Job is terminated, wait for its logs to be completely displayed
+		<-logsDone
+	}
+	if err != nil {
+		return fmt.Errorf("command %q failed: %w", command.Id, err)
+	}
+	spinner.End(true)
+	return nil
+}
+
+// getJob returns the Job executing the command line of the exec command,
+// in a container with the image, environment and volumes of the container component referenced by the command
+func getJob(devfileObj parser.DevfileObj, command v1alpha2.Command, componentName string, appName string) (*batchv1.Job, error) {
+	containers, err := generator.GetContainers(devfileObj, common.DevfileOptions{
+		FilterByName: command.Exec.Component,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) != 1 {
+		return nil, fmt.Errorf("could not find the container component %q referenced by command %q", command.Exec.Component, command.Id)
+	}
+	container := containers[0]
+
+	cmdLine := command.Exec.CommandLine
+	if command.Exec.WorkingDir != "" {
+		cmdLine = "cd " + command.Exec.WorkingDir + " && (" + cmdLine + ")"
+	}
+	container.Command = []string{component.ShellExecutable, "-c"}
+	container.Args = []string{cmdLine}
+	// Ports are not needed to execute the command
+	container.Ports = nil
+	for _, env := range command.Exec.Env {
+		container.Env = append(container.Env, corev1.EnvVar{Name: env.Name, Value: env.Value})
+	}
+
+	volumes, err := addVolumes(devfileObj, &container)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime := component.GetComponentRuntimeFromDevfileMetadata(devfileObj.Data.GetMetadata())
+	labels := odolabels.GetLabels(componentName, appName, runtime, odolabels.ComponentDeployMode, false)
+	annotations := make(map[string]string)
+	odolabels.AddCommonAnnotations(annotations)
+	odolabels.SetProjectType(annotations, component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
+
+	name := util.GetDNS1123Name(util.TruncateString(fmt.Sprintf("%s-%s-%s", componentName, appName, command.Id), maxJobNameLength))
+	return &batchv1.Job{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Job",
+			APIVersion: batchv1.SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      labels,
+			Annotations: annotations,
+		},
+		Spec: batchv1.JobSpec{
+			// The command is executed once, its failure being the failure of the deployment
+			BackoffLimit: pointer.Int32(0),
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{
+					Labels:      labels,
+					Annotations: annotations,
+				},
+				Spec: corev1.PodSpec{
+					RestartPolicy: corev1.RestartPolicyNever,
+					Containers:    []corev1.Container{container},
+					Volumes:       volumes,
+				},
+			},
+		},
+	}, nil
+}
+
+// addVolumes mounts the volumes defined in the Devfile for the container,
+// and returns the volumes to define in the pod.
+// The volumes only live for the duration of the Job, as no data is shared with other resources of the component.
+func addVolumes(devfileObj parser.DevfileObj, container *corev1.Container) ([]corev1.Volume, error) {
+	storages, err := storage.ListStorage(devfileObj)
+	if err != nil {
+		return nil, err
+	}
+	var volumes []corev1.Volume
+	added := map[string]bool{}
+	for _, st := range storages {
+		if st.Container != container.Name {
+			continue
+		}
+		container.VolumeMounts = append(container.VolumeMounts, corev1.VolumeMount{
+			Name:      st.Name,
+			MountPath: st.Path,
+		})
+		if added[st.Name] {
+			continue
+		}
+		added[st.Name] = true
+		size, err := resource.ParseQuantity(st.Size)
+		if err != nil {
+			return nil, fmt.Errorf("invalid size %q for volume %q: %w", st.Size, st.Name, err)
+		}
+		volumes = append(volumes, corev1.Volume{
+			Name: st.Name,
+			VolumeSource: corev1.VolumeSource{
+				EmptyDir: &corev1.EmptyDirVolumeSource{
+					SizeLimit: &size,
+				},
+			},
+		})
+	}
+	return volumes, nil
+}
@@ -0,0 +1,144 @@
+package deploy
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+func Test_getJob(t *testing.T) {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{
+		testingutil.GetFakeContainerComponent("runtime", 8080),
+		testingutil.GetFakeVolumeComponent("myvolume1", "2Gi"),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{Data: devfileData}
+
+	command := v1alpha2.Command{
+		Id: "deploy-db-schema",
+		CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{
+				CommandLine: "./migrate.sh",
+				Component:   "runtime",
+				WorkingDir:  "/projects",
+				Env: []v1alpha2.EnvVar{
+					{Name: "MODE", Value: "production"},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name          string
+		command       v1alpha2.Command
+		componentName string
+		wantName      string
+		wantErr       bool
+	}{
+		{
+			name:          "exec command on a container component",
+			command:       command,
+			componentName: "mycmp",
+			wantName:      "mycmp-app-deploy-db-schema",
+		},
+		{
+			name:          "long component name is truncated",
+			command:       command,
+			componentName: "a-very-long-component-name-exceeding-the-maximum-length",
+			wantName:      "a-very-long-component-name-exceeding-the-maximum-length-app-dep",
+		},
+		{
+			name: "unknown container component",
+			command: v1alpha2.Command{
+				Id: "unknown",
+				CommandUnion: v1alpha2.CommandUnion{
+					Exec: &v1alpha2.ExecCommand{
+						CommandLine: "./migrate.sh",
+						Component:   "unknown",
+					},
+				},
+			},
+			componentName: "mycmp",
+			wantErr:       true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getJob(devfileObj, tt.command, tt.componentName, "app")
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getJob() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			if got.Name != tt.wantName {
+				t.Errorf("getJob() name = %q, want %q", got.Name, tt.wantName)
+			}
+			if mode := odolabels.GetMode(got.Labels); mode != odolabels.ComponentDeployMode {
+				t.Errorf("getJob() mode label = %q, want %q", mode, odolabels.ComponentDeployMode)
+			}
+			if *got.Spec.BackoffLimit != 0 {
+				t.Errorf("getJob() backoffLimit = %d, want 0", *got.Spec.BackoffLimit)
+			}
+			podSpec := got.Spec.Template.Spec
+			if podSpec.RestartPolicy != corev1.RestartPolicyNever {
+				t.Errorf("getJob() restartPolicy = %q, want %q", podSpec.RestartPolicy, corev1.RestartPolicyNever)
+			}
+			if len(podSpec.Containers) != 1 {
+				t.Fatalf("getJob() expected 1 container, got %d", len(podSpec.Containers))
+			}
+			container := podSpec.Containers[0]
+			if diff := cmp.Diff([]string{"/bin/sh", "-c"}, container.Command); diff != "" {
+				t.Errorf("getJob() command mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff([]string{"cd /projects && (./migrate.sh)"}, container.Args); diff != "" {
+				t.Errorf("getJob() args mismatch (-want +got):\n%s", diff)
+			}
+			if container.Image != "docker.io/maven:latest" {
+				t.Errorf("getJob() image = %q", container.Image)
+			}
+			if len(container.Ports) != 0 {
+				t.Errorf("getJob() expected no ports, got %v", container.Ports)
+			}
+			found := false
+			for _, env := range container.Env {
+				if env.Name == "MODE" && env.Value == "production" {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("getJob() env of the command not found in %v", container.Env)
+			}
+			if diff := cmp.Diff([]corev1.VolumeMount{{Name: "myvolume1", MountPath: "/my/volume/mount/path1"}}, container.VolumeMounts); diff != "" {
+				t.Errorf("getJob() volumeMounts mismatch (-want +got):\n%s", diff)
+			}
+			size := resource.MustParse("2Gi")
+			wantVolumes := []corev1.Volume{
+				{
+					Name: "myvolume1",
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{SizeLimit: &size},
+					},
+				},
+			}
+			if diff := cmp.Diff(wantVolumes, podSpec.Volumes); diff != "" {
+				t.Errorf("getJob() volumes mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
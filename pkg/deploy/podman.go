@@ -0,0 +1,194 @@
+package deploy
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile/image"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+// PodmanDeployClient deploys the components of a Devfile on Podman
+type PodmanDeployClient struct {
+	podmanClient podman.Client
+	fs           filesystem.Filesystem
+}
+
+var _ Client = (*PodmanDeployClient)(nil)
+
+func NewPodmanDeployClient(podmanClient podman.Client, fs filesystem.Filesystem) *PodmanDeployClient {
+	return &PodmanDeployClient{
+		podmanClient: podmanClient,
+		fs:           fs,
+	}
+}
+
+func (o *PodmanDeployClient) Deploy(ctx context.Context) error {
+	var (
+		devfileObj    = odocontext.GetDevfileObj(ctx)
+		devfilePath   = odocontext.GetDevfilePath(ctx)
+		path          = filepath.Dir(devfilePath)
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+	)
+	handler := newPodmanDeployHandler(ctx, o.fs, *devfileObj, path, appName, componentName)
+	err := libdevfile.Deploy(*devfileObj, handler)
+	if err != nil {
+		return err
+	}
+
+	if len(handler.resources) == 0 {
+		return nil
+	}
+
+	for i := range handler.resources {
+		useLocalImages(&handler.resources[i], handler.builtImages)
+	}
+
+	spinner := log.Spinner("Deploying resources on Podman")
+	defer spinner.End(false)
+	err = o.podmanClient.PlayKubeResources(handler.resources)
+	if err != nil {
+		return err
+	}
+	spinner.End(true)
+	return nil
+}
+
+// podmanDeployHandler builds the images and collects the Kubernetes resources to deploy on Podman,
+// as all resources need to be played together by Podman
+type podmanDeployHandler struct {
+	ctx           context.Context
+	fs            filesystem.Filesystem
+	devfileObj    parser.DevfileObj
+	path          string
+	appName       string
+	componentName string
+
+	resources   []unstructured.Unstructured
+	builtImages map[string]bool
+}
+
+var _ libdevfile.Handler = (*podmanDeployHandler)(nil)
+
+func newPodmanDeployHandler(ctx context.Context, fs filesystem.Filesystem, devfileObj parser.DevfileObj, path string, appName string, componentName string) *podmanDeployHandler {
+	return &podmanDeployHandler{
+		ctx:           ctx,
+		fs:            fs,
+		devfileObj:    devfileObj,
+		path:          path,
+		appName:       appName,
+		componentName: componentName,
+		builtImages:   map[string]bool{},
+	}
+}
+
+// ApplyImage builds the OCI image locally, to be used by the resources deployed on Podman
+func (o *podmanDeployHandler) ApplyImage(img v1alpha2.Component) error {
+	err := image.BuildPushSpecificImage(o.ctx, o.fs, img, false)
+	if err != nil {
+		return err
+	}
+	o.builtImages[img.Image.ImageName] = true
+	return nil
+}
+
+// ApplyKubernetes collects the resources defined in the Kubernetes component, labelled with the Deploy mode
+func (o *podmanDeployHandler) ApplyKubernetes(kubernetes v1alpha2.Component) error {
+	uList, err := libdevfile.GetK8sComponentAsUnstructuredList(o.devfileObj, kubernetes.Name, o.path, devfilefs.DefaultFs{})
+	if err != nil {
+		return err
+	}
+
+	runtime := component.GetComponentRuntimeFromDevfileMetadata(o.devfileObj.Data.GetMetadata())
+	labels := odolabels.GetLabels(o.componentName, o.appName, runtime, odolabels.ComponentDeployMode, false)
+	odolabels.SetProjectType(labels, component.GetComponentTypeFromDevfileMetadata(o.devfileObj.Data.GetMetadata()))
+
+	for _, u := range uList {
+		if !podman.SupportedKinds[u.GetKind()] {
+			log.Warningf("Kubernetes resources of kind %q are not supported on Podman. Skipping: %s.", u.GetKind(), u.GetName())
+			continue
+		}
+		log.Sectionf("Deploying Kubernetes Component: %s", u.GetName())
+		err = addLabels(&u, labels)
+		if err != nil {
+			return err
+		}
+		o.resources = append(o.resources, u)
+	}
+	return nil
+}
+
+// Execute is not supported on Podman
+func (o *podmanDeployHandler) Execute(command v1alpha2.Command) error {
+	return errors.New("exec command is not implemented for Deploy")
+}
+
+// addLabels adds the labels to the resource and, for a Deployment, to the template of its pods,
+// so the pods created by Podman are labelled
+func addLabels(u *unstructured.Unstructured, labels map[string]string) error {
+	u.SetLabels(mergeLabels(u.GetLabels(), labels))
+	if u.GetKind() != "Deployment" {
+		return nil
+	}
+	templateLabels, _, err := unstructured.NestedStringMap(u.Object, "spec", "template", "metadata", "labels")
+	if err != nil {
+		return err
+	}
+	return unstructured.SetNestedStringMap(u.Object, mergeLabels(templateLabels, labels), "spec", "template", "metadata", "labels")
+}
+
+func mergeLabels(existing map[string]string, labels map[string]string) map[string]string {
+	result := make(map[string]string, len(existing)+len(labels))
+	for k, v := range existing {
+		result[k] = v
+	}
+	for k, v := range labels {
+		result[k] = v
+	}
+	return result
+}
+
+// useLocalImages makes the containers referencing one of the images built locally
+// use these images instead of pulling them from a registry
+func useLocalImages(u *unstructured.Unstructured, builtImages map[string]bool) {
+	var containersPath []string
+	switch u.GetKind() {
+	case "Pod":
+		containersPath = []string{"spec", "containers"}
+	case "Deployment":
+		containersPath = []string{"spec", "template", "spec", "containers"}
+	default:
+		return
+	}
+	containers, found, err := unstructured.NestedSlice(u.Object, containersPath...)
+	if err != nil || !found {
+		return
+	}
+	for i := range containers {
+		container, ok := containers[i].(map[string]interface{})
+		if !ok {
+			continue
+		}
+		img, _ := container["image"].(string)
+		if builtImages[img] || builtImages[strings.TrimSuffix(img, ":latest")] {
+			klog.V(4).Infof("using local image %q for container %v", img, container["name"])
+			container["imagePullPolicy"] = "Never"
+		}
+	}
+	_ = unstructured.SetNestedSlice(u.Object, containers, containersPath...)
+}
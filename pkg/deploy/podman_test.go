@@ -0,0 +1,292 @@
+package deploy
+
+import (
+	"context"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/config"
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+)
+
+const deploymentManifest = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: my-deployment
+  labels:
+    existing: label
+spec:
+  template:
+    metadata:
+      labels:
+        app: my-app
+    spec:
+      containers:
+      - name: built
+        image: quay.io/user/myimage
+      - name: pulled
+        image: quay.io/user/otherimage
+`
+
+const routeManifest = `apiVersion: route.openshift.io/v1
+kind: Route
+metadata:
+  name: my-route
+`
+
+func getKubernetesComponent(name string, manifest string) v1alpha2.Component {
+	return generator.GetKubernetesComponent(generator.KubernetesComponentParams{
+		Name: name,
+		Kubernetes: &v1alpha2.KubernetesComponent{
+			K8sLikeComponent: v1alpha2.K8sLikeComponent{
+				K8sLikeComponentLocation: v1alpha2.K8sLikeComponentLocation{
+					Inlined: manifest,
+				},
+			},
+		},
+	})
+}
+
+func TestPodmanDeployClient_Deploy(t *testing.T) {
+	imageComponent := generator.GetImageComponent(generator.ImageComponentParams{
+		Name: "image",
+		Image: v1alpha2.Image{
+			ImageName: "quay.io/user/myimage",
+			ImageUnion: v1alpha2.ImageUnion{
+				Dockerfile: &v1alpha2.DockerfileImage{
+					DockerfileSrc: v1alpha2.DockerfileSrc{
+						Uri: "Dockerfile",
+					},
+				},
+			},
+		},
+	})
+	applyCommand := func(component string) v1alpha2.Command {
+		return generator.GetApplyCommand(generator.ApplyCommandParams{Id: "apply-" + component, Component: component})
+	}
+	deployCommand := func(commands ...string) v1alpha2.Command {
+		return generator.GetCompositeCommand(generator.CompositeCommandParams{
+			Id:        "deploy",
+			Commands:  commands,
+			Kind:      v1alpha2.DeployCommandGroupKind,
+			IsDefault: pointer.Bool(true),
+		})
+	}
+
+	tests := []struct {
+		name       string
+		components []v1alpha2.Component
+		commands   []v1alpha2.Command
+		// wantResources are the names of the resources played by Podman, nil if no resource is played
+		wantResources []string
+		wantErr       bool
+	}{
+		{
+			name:       "image and resources",
+			components: []v1alpha2.Component{imageComponent, getKubernetesComponent("deployment", deploymentManifest)},
+			commands: []v1alpha2.Command{
+				applyCommand("image"),
+				applyCommand("deployment"),
+				deployCommand("apply-image", "apply-deployment"),
+			},
+			wantResources: []string{"my-deployment"},
+		},
+		{
+			name: "unsupported resource is skipped",
+			components: []v1alpha2.Component{
+				getKubernetesComponent("route", routeManifest),
+				getKubernetesComponent("deployment", deploymentManifest),
+			},
+			commands: []v1alpha2.Command{
+				applyCommand("route"),
+				applyCommand("deployment"),
+				deployCommand("apply-route", "apply-deployment"),
+			},
+			wantResources: []string{"my-deployment"},
+		},
+		{
+			name:       "image only",
+			components: []v1alpha2.Component{imageComponent},
+			commands: []v1alpha2.Command{
+				applyCommand("image"),
+				deployCommand("apply-image"),
+			},
+		},
+		{
+			name: "exec command",
+			components: []v1alpha2.Component{
+				generator.GetContainerComponent(generator.ContainerComponentParams{
+					Name:      "runtime",
+					Container: v1alpha2.Container{Image: "quay.io/user/runtime"},
+				}),
+				getKubernetesComponent("deployment", deploymentManifest),
+			},
+			commands: []v1alpha2.Command{
+				applyCommand("deployment"),
+				generator.GetExecCommand(generator.ExecCommandParams{Id: "migrate", Component: "runtime", CommandLine: "./migrate"}),
+				deployCommand("apply-deployment", "migrate"),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err = devfileData.AddComponents(tt.components); err != nil {
+				t.Fatal(err)
+			}
+			if err = devfileData.AddCommands(tt.commands); err != nil {
+				t.Fatal(err)
+			}
+			devfileObj := parser.DevfileObj{Data: devfileData}
+
+			ctx := context.Background()
+			// the image is "built" by echoing the build command
+			ctx = envcontext.WithEnvConfig(ctx, config.Configuration{PodmanCmd: "echo"})
+			ctx = odocontext.WithDevfileObj(ctx, &devfileObj)
+			ctx = odocontext.WithDevfilePath(ctx, "/project/devfile.yaml")
+			ctx = odocontext.WithApplication(ctx, "app")
+			ctx = odocontext.WithComponentName(ctx, "mycmp")
+
+			ctrl := gomock.NewController(t)
+			podmanClient := podman.NewMockClient(ctrl)
+			if tt.wantResources != nil {
+				podmanClient.EXPECT().PlayKubeResources(gomock.Any()).DoAndReturn(func(resources []unstructured.Unstructured) error {
+					var names []string
+					for _, u := range resources {
+						names = append(names, u.GetName())
+						if u.GetLabels()["app.kubernetes.io/instance"] != "mycmp" || u.GetLabels()["odo.dev/mode"] != "Deploy" {
+							t.Errorf("resource %s should be labelled with the component in Deploy mode, got %v", u.GetName(), u.GetLabels())
+						}
+					}
+					if diff := cmp.Diff(tt.wantResources, names); diff != "" {
+						t.Errorf("PlayKubeResources() resources mismatch (-want +got):\n%s", diff)
+					}
+					return nil
+				})
+			}
+
+			err = NewPodmanDeployClient(podmanClient, filesystem.NewFakeFs()).Deploy(ctx)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Deploy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_addLabels(t *testing.T) {
+	labels := map[string]string{"app.kubernetes.io/instance": "mycmp"}
+	tests := []struct {
+		name               string
+		resource           map[string]interface{}
+		wantLabels         map[string]string
+		wantTemplateLabels map[string]string
+	}{
+		{
+			name: "labels merged with existing ones",
+			resource: map[string]interface{}{
+				"kind":     "Service",
+				"metadata": map[string]interface{}{"name": "svc", "labels": map[string]interface{}{"existing": "label"}},
+			},
+			wantLabels: map[string]string{"existing": "label", "app.kubernetes.io/instance": "mycmp"},
+		},
+		{
+			name: "labels added to the pods of a Deployment",
+			resource: map[string]interface{}{
+				"kind":     "Deployment",
+				"metadata": map[string]interface{}{"name": "deploy"},
+				"spec": map[string]interface{}{
+					"template": map[string]interface{}{
+						"metadata": map[string]interface{}{"labels": map[string]interface{}{"app": "my-app"}},
+					},
+				},
+			},
+			wantLabels:         map[string]string{"app.kubernetes.io/instance": "mycmp"},
+			wantTemplateLabels: map[string]string{"app": "my-app", "app.kubernetes.io/instance": "mycmp"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := unstructured.Unstructured{Object: tt.resource}
+			if err := addLabels(&u, labels); err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.wantLabels, u.GetLabels()); diff != "" {
+				t.Errorf("addLabels() labels mismatch (-want +got):\n%s", diff)
+			}
+			templateLabels, _, _ := unstructured.NestedStringMap(u.Object, "spec", "template", "metadata", "labels")
+			if diff := cmp.Diff(tt.wantTemplateLabels, templateLabels); diff != "" {
+				t.Errorf("addLabels() template labels mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_useLocalImages(t *testing.T) {
+	builtImages := map[string]bool{"quay.io/user/myimage": true}
+	containers := func() []interface{} {
+		return []interface{}{
+			map[string]interface{}{"name": "built", "image": "quay.io/user/myimage"},
+			map[string]interface{}{"name": "latest", "image": "quay.io/user/myimage:latest"},
+			map[string]interface{}{"name": "pulled", "image": "quay.io/user/otherimage"},
+		}
+	}
+	tests := []struct {
+		name           string
+		resource       map[string]interface{}
+		containersPath []string
+		wantPolicies   []interface{}
+	}{
+		{
+			name:           "Pod",
+			resource:       map[string]interface{}{"kind": "Pod", "spec": map[string]interface{}{"containers": containers()}},
+			containersPath: []string{"spec", "containers"},
+			wantPolicies:   []interface{}{"Never", "Never", nil},
+		},
+		{
+			name: "Deployment",
+			resource: map[string]interface{}{"kind": "Deployment", "spec": map[string]interface{}{
+				"template": map[string]interface{}{"spec": map[string]interface{}{"containers": containers()}},
+			}},
+			containersPath: []string{"spec", "template", "spec", "containers"},
+			wantPolicies:   []interface{}{"Never", "Never", nil},
+		},
+		{
+			name:           "other kind",
+			resource:       map[string]interface{}{"kind": "ReplicaSet", "spec": map[string]interface{}{"containers": containers()}},
+			containersPath: []string{"spec", "containers"},
+			wantPolicies:   []interface{}{nil, nil, nil},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			u := unstructured.Unstructured{Object: tt.resource}
+			useLocalImages(&u, builtImages)
+			got, _, err := unstructured.NestedSlice(u.Object, tt.containersPath...)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var policies []interface{}
+			for _, c := range got {
+				policies = append(policies, c.(map[string]interface{})["imagePullPolicy"])
+			}
+			if diff := cmp.Diff(tt.wantPolicies, policies); diff != "" {
+				t.Errorf("useLocalImages() imagePullPolicy mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
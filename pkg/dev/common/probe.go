@@ -0,0 +1,214 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+const (
+	// DefaultProbeTimeout is the maximum duration to wait for an endpoint to be ready
+	DefaultProbeTimeout = 1 * time.Minute
+
+	// probeInterval is the duration between two probes of an endpoint
+	probeInterval = 1 * time.Second
+
+	// probeRequestTimeout is the maximum duration of a single probe
+	probeRequestTimeout = 2 * time.Second
+
+	// probeReadTimeout is the duration to wait for the connection to be closed after a TCP connection is opened
+	probeReadTimeout = 500 * time.Millisecond
+)
+
+// endpointProbe is the probe of an endpoint through its forwarded local port
+type endpointProbe struct {
+	name          string
+	containerName string
+	protocol      v1alpha2.EndpointProtocol
+	// address is the local address and port the endpoint is forwarded to
+	address string
+	// url is the URL requested for an HTTP endpoint, or the address for other endpoints
+	url       string
+	localPort int
+}
+
+// ProbeEndpoints checks, through the forwarded local ports, that the application is listening on the non-debug endpoints,
+// with an HTTP GET request on the path of the HTTP endpoints, or by opening a TCP connection for the other endpoints.
+// The readiness of each endpoint is sent to results, to be displayed by the watch loop.
+// The results of the endpoints not ready before the timeout include a function displaying the last logs of their container with displayLogs.
+// The function returns when all the endpoints are probed, or when ctx is done.
+func ProbeEndpoints(
+	ctx context.Context,
+	devfileObj parser.DevfileObj,
+	fwPorts []api.ForwardedPort,
+	timeout time.Duration,
+	results chan<- watch.ProbeResult,
+	displayLogs func(containerName string, out io.Writer) error,
+) {
+	probes, err := getEndpointProbes(devfileObj, fwPorts)
+	if err != nil {
+		klog.V(2).Infof("unable to get the endpoints to probe: %v", err)
+		return
+	}
+
+	var wg sync.WaitGroup
+	for _, probe := range probes {
+		probe := probe
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			start := time.Now()
+			ready := probe.wait(ctx, timeout)
+			if ctx.Err() != nil {
+				// The probe has been cancelled, the result is not relevant anymore
+				return
+			}
+			result := watch.ProbeResult{
+				Name:      probe.name,
+				URL:       probe.url,
+				LocalPort: probe.localPort,
+				Protocol:  string(probe.protocol),
+				Ready:     ready,
+				Duration:  timeout,
+			}
+			if ready {
+				result.Duration = time.Since(start)
+			} else if displayLogs != nil {
+				result.DisplayLogs = func(out io.Writer) error {
+					return displayLogs(probe.containerName, out)
+				}
+			}
+			select {
+			case results <- result:
+			case <-ctx.Done():
+			}
+		}()
+	}
+	wg.Wait()
+}
+
+// getEndpointProbes returns the probes of the non-debug endpoints of the Devfile forwarded to local ports
+func getEndpointProbes(devfileObj parser.DevfileObj, fwPorts []api.ForwardedPort) ([]endpointProbe, error) {
+	containers, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ceMapping := libdevfile.GetContainerEndpointMapping(containers, false)
+
+	var probes []endpointProbe
+	for _, fwPort := range fwPorts {
+		if fwPort.IsDebug {
+			continue
+		}
+		for _, ep := range ceMapping[fwPort.ContainerName] {
+			if ep.TargetPort != fwPort.ContainerPort {
+				continue
+			}
+			if ep.Protocol == v1alpha2.UDPEndpointProtocol {
+				// UDP ports are not forwarded
+				break
+			}
+			address := net.JoinHostPort(fwPort.LocalAddress, strconv.Itoa(fwPort.LocalPort))
+			probe := endpointProbe{
+				name:          ep.Name,
+				containerName: fwPort.ContainerName,
+				protocol:      ep.Protocol,
+				address:       address,
+				url:           address,
+				localPort:     fwPort.LocalPort,
+			}
+			// The HTTP probe is used only when the protocol is explicitly set, as the application may not be an HTTP server
+			if probe.protocol == "" {
+				probe.protocol = v1alpha2.TCPEndpointProtocol
+			}
+			if probe.protocol == v1alpha2.HTTPEndpointProtocol {
+				probe.url = "http://" + address + "/" + strings.TrimPrefix(ep.Path, "/")
+			}
+			probes = append(probes, probe)
+			break
+		}
+	}
+	return probes, nil
+}
+
+// wait probes the endpoint until it is ready, and returns false if it is not ready before the timeout
+func (o endpointProbe) wait(ctx context.Context, timeout time.Duration) bool {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	ticker := time.NewTicker(probeInterval)
+	defer ticker.Stop()
+	for {
+		err := o.probe(ctx)
+		if err == nil {
+			return true
+		}
+		klog.V(4).Infof("endpoint %q is not ready: %v", o.name, err)
+		select {
+		case <-ctx.Done():
+			return false
+		case <-ticker.C:
+		}
+	}
+}
+
+// probe checks once if the endpoint is ready
+func (o endpointProbe) probe(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, probeRequestTimeout)
+	defer cancel()
+
+	if o.protocol != v1alpha2.HTTPEndpointProtocol {
+		var dialer net.Dialer
+		conn, err := dialer.DialContext(ctx, "tcp", o.address)
+		if err != nil {
+			return err
+		}
+		defer conn.Close()
+		// The local port is listened by the port forwarder even when the application is not listening,
+		// in which case the connection is closed by the port forwarder.
+		// The application is considered as listening if the connection is kept open.
+		err = conn.SetReadDeadline(time.Now().Add(probeReadTimeout))
+		if err != nil {
+			return err
+		}
+		_, err = conn.Read(make([]byte, 1))
+		var netErr net.Error
+		if err != nil && !(errors.As(err, &netErr) && netErr.Timeout()) {
+			return err
+		}
+		return nil
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, o.url, nil)
+	if err != nil {
+		return err
+	}
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// The application is started, but not ready to serve the requests yet
+	if resp.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,212 @@
+package common
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+func getProbeDevfileObj(t *testing.T, endpoints ...v1alpha2.Endpoint) parser.DevfileObj {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{
+		{
+			Name: "runtime",
+			ComponentUnion: v1alpha2.ComponentUnion{
+				Container: &v1alpha2.ContainerComponent{
+					Container: v1alpha2.Container{
+						Image: "quay.io/nodejs",
+					},
+					Endpoints: endpoints,
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return parser.DevfileObj{Data: devfileData}
+}
+
+func Test_getEndpointProbes(t *testing.T) {
+	devfileObj := getProbeDevfileObj(t,
+		v1alpha2.Endpoint{Name: "http", TargetPort: 3000, Protocol: v1alpha2.HTTPEndpointProtocol, Path: "/health"},
+		v1alpha2.Endpoint{Name: "tcp", TargetPort: 5432},
+		v1alpha2.Endpoint{Name: "udp", TargetPort: 5353, Protocol: v1alpha2.UDPEndpointProtocol},
+		v1alpha2.Endpoint{Name: "debug", TargetPort: 5858},
+	)
+	fwPorts := []api.ForwardedPort{
+		{ContainerName: "runtime", PortName: "http", LocalAddress: "127.0.0.1", LocalPort: 20001, ContainerPort: 3000},
+		{ContainerName: "runtime", PortName: "tcp", LocalAddress: "127.0.0.1", LocalPort: 20002, ContainerPort: 5432},
+		{ContainerName: "runtime", PortName: "udp", LocalAddress: "127.0.0.1", LocalPort: 20003, ContainerPort: 5353},
+		{ContainerName: "runtime", PortName: "debug", LocalAddress: "127.0.0.1", LocalPort: 20004, ContainerPort: 5858, IsDebug: true},
+		{ContainerName: "unknown", PortName: "other", LocalAddress: "127.0.0.1", LocalPort: 20005, ContainerPort: 8080},
+	}
+	want := []endpointProbe{
+		{
+			name:          "http",
+			containerName: "runtime",
+			protocol:      v1alpha2.HTTPEndpointProtocol,
+			address:       "127.0.0.1:20001",
+			url:           "http://127.0.0.1:20001/health",
+			localPort:     20001,
+		},
+		{
+			name:          "tcp",
+			containerName: "runtime",
+			protocol:      v1alpha2.TCPEndpointProtocol,
+			address:       "127.0.0.1:20002",
+			url:           "127.0.0.1:20002",
+			localPort:     20002,
+		},
+	}
+	got, err := getEndpointProbes(devfileObj, fwPorts)
+	if err != nil {
+		t.Fatalf("getEndpointProbes() unexpected error: %v", err)
+	}
+	if diff := cmp.Diff(want, got, cmp.AllowUnexported(endpointProbe{})); diff != "" {
+		t.Errorf("getEndpointProbes() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestProbeEndpoints(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint v1alpha2.Endpoint
+		// listen returns the address the endpoint is forwarded to
+		listen    func(t *testing.T) string
+		wantReady bool
+		wantLogs  bool
+	}{
+		{
+			name:     "HTTP endpoint ready",
+			endpoint: v1alpha2.Endpoint{Name: "http", TargetPort: 3000, Protocol: v1alpha2.HTTPEndpointProtocol, Path: "/health"},
+			listen: func(t *testing.T) string {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					if r.URL.Path != "/health" {
+						w.WriteHeader(http.StatusServiceUnavailable)
+					}
+				}))
+				t.Cleanup(server.Close)
+				return server.Listener.Addr().String()
+			},
+			wantReady: true,
+		},
+		{
+			name:     "HTTP endpoint returning errors",
+			endpoint: v1alpha2.Endpoint{Name: "http", TargetPort: 3000, Protocol: v1alpha2.HTTPEndpointProtocol},
+			listen: func(t *testing.T) string {
+				server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.WriteHeader(http.StatusServiceUnavailable)
+				}))
+				t.Cleanup(server.Close)
+				return server.Listener.Addr().String()
+			},
+			wantLogs: true,
+		},
+		{
+			name:     "TCP endpoint ready",
+			endpoint: v1alpha2.Endpoint{Name: "tcp", TargetPort: 5432},
+			listen: func(t *testing.T) string {
+				listener, err := net.Listen("tcp", "127.0.0.1:0")
+				if err != nil {
+					t.Fatal(err)
+				}
+				done := make(chan struct{})
+				t.Cleanup(func() {
+					close(done)
+					listener.Close()
+				})
+				go func() {
+					for {
+						conn, err := listener.Accept()
+						if err != nil {
+							return
+						}
+						// The connection is kept open, as done by an application
+						go func() {
+							<-done
+							conn.Close()
+						}()
+					}
+				}()
+				return listener.Addr().String()
+			},
+			wantReady: true,
+		},
+		{
+			name:     "TCP connection closed by the port forwarder",
+			endpoint: v1alpha2.Endpoint{Name: "tcp", TargetPort: 5432},
+			listen: func(t *testing.T) string {
+				listener, err := net.Listen("tcp", "127.0.0.1:0")
+				if err != nil {
+					t.Fatal(err)
+				}
+				t.Cleanup(func() { listener.Close() })
+				go func() {
+					for {
+						conn, err := listener.Accept()
+						if err != nil {
+							return
+						}
+						conn.Close()
+					}
+				}()
+				return listener.Addr().String()
+			},
+			wantLogs: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			address := tt.listen(t)
+			host, port, err := net.SplitHostPort(address)
+			if err != nil {
+				t.Fatal(err)
+			}
+			localPort, err := strconv.Atoi(port)
+			if err != nil {
+				t.Fatal(err)
+			}
+			devfileObj := getProbeDevfileObj(t, tt.endpoint)
+			fwPorts := []api.ForwardedPort{
+				{ContainerName: "runtime", PortName: tt.endpoint.Name, LocalAddress: host, LocalPort: localPort, ContainerPort: tt.endpoint.TargetPort},
+			}
+
+			var logsContainer string
+			results := make(chan watch.ProbeResult, 1)
+			ProbeEndpoints(context.Background(), devfileObj, fwPorts, 1500*time.Millisecond, results, func(containerName string, _ io.Writer) error {
+				logsContainer = containerName
+				return nil
+			})
+			result := <-results
+
+			if result.Ready != tt.wantReady {
+				t.Errorf("ready = %v, want %v", result.Ready, tt.wantReady)
+			}
+			if result.DisplayLogs != nil {
+				if err = result.DisplayLogs(io.Discard); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if gotLogs := logsContainer == "runtime"; gotLogs != tt.wantLogs {
+				t.Errorf("logs displayed = %v, want %v", gotLogs, tt.wantLogs)
+			}
+		})
+	}
+}
@@ -0,0 +1,35 @@
+package common
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/sync"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// SyncBack copies the files changed in the source volume of the pod under the paths to sync back of the watch parameters
+// into the local directory. It returns the files copied, and the files in conflict, which are not copied.
+func SyncBack(syncClient sync.Client, pod *corev1.Pod, watchParams watch.WatchParameters) ([]string, []string, error) {
+	containerName, syncFolder, err := GetFirstContainerWithSourceVolume(pod.Spec.Containers)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error while retrieving container from pod %s with a mounted project volume: %w", pod.GetName(), err)
+	}
+
+	result, err := syncClient.SyncBack(sync.SyncBackParameters{
+		Path:         watchParams.Path,
+		Paths:        watchParams.SyncBackPaths,
+		IgnoredFiles: watchParams.FileIgnores,
+		CompInfo: sync.ComponentInfo{
+			ComponentName: watchParams.ComponentName,
+			ContainerName: containerName,
+			PodName:       pod.GetName(),
+			SyncFolder:    syncFolder,
+		},
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+	return result.FilesChanged, result.Conflicts, nil
+}
@@ -3,6 +3,9 @@ package dev
 import (
 	"context"
 	"io"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/watch"
 )
 
 type StartOptions struct {
@@ -18,10 +21,22 @@ type StartOptions struct {
 	DebugCommand string
 	// if RandomPorts is set, will port forward on random local ports, else uses ports starting at 20001
 	RandomPorts bool
+	// CustomForwardedPorts are the local ports requested by the user for some container ports, the other ports being assigned automatically
+	CustomForwardedPorts []api.ForwardedPort
+	// CustomAddress is the local address to forward the ports on, localhost if empty
+	CustomAddress string
 	// if WatchFiles is set, files changes will trigger a new sync to the container
 	WatchFiles bool
+	// SyncBackPaths are the paths, relative to the component directory, whose changes in the container are copied into the local directory
+	SyncBackPaths []string
 	// Variables to override in the Devfile
 	Variables map[string]string
+	// if CleanVolumes is set, the volumes of the component left by a previous session are deleted instead of being reused (Podman and Docker only)
+	CleanVolumes bool
+	// if AutoRestart is set, the run command is restarted with an exponential backoff when it crashes
+	AutoRestart bool
+	// ControlRequests receives the requests of the control API of the session, if enabled
+	ControlRequests <-chan watch.ControlRequest
 }
 
 type Client interface {
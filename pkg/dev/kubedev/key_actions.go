@@ -0,0 +1,90 @@
+package kubedev
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/devfile/adapters"
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// numberOfLogLines is the number of lines displayed for each container when the user requests the logs
+const numberOfLogLines = 20
+
+// keyActionHandler executes the actions requested by the user by pressing keys during the Dev session
+func (o *DevClient) keyActionHandler(ctx context.Context, action watch.KeyAction, watchParams watch.WatchParameters, componentStatus *watch.ComponentStatus) error {
+	switch action {
+	case watch.KeyActionRerun:
+		adapter, err := o.regenerateComponentAdapterFromWatchParams(watchParams)
+		if err != nil {
+			return fmt.Errorf("unable to generate component from watch parameters: %w", err)
+		}
+		return adapter.RerunCommand(ctx, adapters.PushParameters{
+			Path:            watchParams.Path,
+			DevfileBuildCmd: watchParams.DevfileBuildCmd,
+			DevfileRunCmd:   watchParams.DevfileRunCmd,
+			DevfileDebugCmd: watchParams.DevfileDebugCmd,
+			Debug:           watchParams.Debug,
+		})
+
+	case watch.KeyActionRestart:
+		pod, err := o.kubernetesClient.GetPodUsingComponentName(watchParams.ComponentName)
+		if err != nil {
+			return err
+		}
+		spinner := log.Spinner("Restarting the containers of the component")
+		defer spinner.End(false)
+		// The pod is recreated by its Deployment
+		err = o.kubernetesClient.DeleteDynamicResource(pod.GetName(), corev1.SchemeGroupVersion.WithResource("pods"), true)
+		if err != nil {
+			return err
+		}
+		componentStatus.State = watch.StateWaitDeployment
+		componentStatus.PostStartEventsDone = false
+		componentStatus.RunExecuted = false
+		spinner.End(true)
+		return nil
+
+	case watch.KeyActionLogs:
+		pod, err := o.kubernetesClient.GetPodUsingComponentName(watchParams.ComponentName)
+		if err != nil {
+			return err
+		}
+		return component.DisplayContainersLogs(o.kubernetesClient, pod, numberOfLogLines, log.GetStdout())
+
+	case watch.KeyActionTest:
+		devObj, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(""), watchParams.Variables)
+		if err != nil {
+			return err
+		}
+		pod, err := o.kubernetesClient.GetPodUsingComponentName(watchParams.ComponentName)
+		if err != nil {
+			return err
+		}
+		handler := component.NewRunCommandHandler(
+			ctx,
+			o.filesystem,
+			o.kubernetesClient,
+			o.kubernetesClient,
+			devObj,
+			watchParams.Path,
+			watchParams.ApplicationName,
+			watchParams.ComponentName,
+			pod.GetName(),
+			commonflags.PlatformCluster,
+			log.GetStdout(),
+			log.GetStderr(),
+		)
+		return libdevfile.ExecuteCommandByNameAndKind(devObj, "", v1alpha2.TestCommandGroupKind, handler, false)
+	}
+	return fmt.Errorf("unsupported action %q", action)
+}
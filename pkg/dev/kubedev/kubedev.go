@@ -6,14 +6,19 @@ import (
 	"io"
 	"path/filepath"
 
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+
 	"github.com/redhat-developer/odo/pkg/binding"
+	odocomponent "github.com/redhat-developer/odo/pkg/component"
 	_delete "github.com/redhat-developer/odo/pkg/component/delete"
 	"github.com/redhat-developer/odo/pkg/dev"
+	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/sync"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 
@@ -30,6 +35,12 @@ const (
 	promptMessage = `
 [Ctrl+c] - Exit and delete resources from the cluster
      [p] - Manually apply local changes to the application on the cluster
+     [r] - Execute again the run command, without applying local changes
+     [d] - Switch between the run and debug commands
+     [c] - Restart the containers of the application
+     [l] - Show the last lines of the logs of each container
+     [s] - Show the forwarded ports and the keyboard commands again
+     [t] - Run the default test command
 `
 )
 
@@ -43,6 +54,14 @@ type DevClient struct {
 	filesystem        filesystem.Filesystem
 	execClient        exec.Client
 	deleteClient      _delete.Client
+	stateClient       state.Client
+
+	// cancelProbes cancels the probes of the endpoints started after the previous push
+	cancelProbes context.CancelFunc
+	// probeResults receives the results of the probes of the endpoints, displayed by the watch loop
+	probeResults chan watch.ProbeResult
+	// runSupervisor restarts the run command when it crashes, it is nil if options.AutoRestart is not set
+	runSupervisor *odocomponent.RunSupervisor
 }
 
 var _ dev.Client = (*DevClient)(nil)
@@ -57,6 +76,7 @@ func NewDevClient(
 	filesystem filesystem.Filesystem,
 	execClient exec.Client,
 	deleteClient _delete.Client,
+	stateClient state.Client,
 ) *DevClient {
 	return &DevClient{
 		kubernetesClient:  kubernetesClient,
@@ -68,6 +88,7 @@ func NewDevClient(
 		filesystem:        filesystem,
 		execClient:        execClient,
 		deleteClient:      deleteClient,
+		stateClient:       stateClient,
 	}
 }
 
@@ -79,6 +100,12 @@ func (o *DevClient) Start(
 ) error {
 	klog.V(4).Infoln("Creating new adapter")
 
+	o.probeResults = make(chan watch.ProbeResult)
+
+	if options.AutoRestart {
+		o.runSupervisor = odocomponent.NewRunSupervisor(ctx)
+	}
+
 	var (
 		devfileObj    = odocontext.GetDevfileObj(ctx)
 		devfilePath   = odocontext.GetDevfilePath(ctx)
@@ -94,16 +121,19 @@ func (o *DevClient) Start(
 			AppName:       odocontext.GetApplication(ctx),
 			Devfile:       *devfileObj,
 			FS:            o.filesystem,
+			RunSupervisor: o.runSupervisor,
 		})
 
 	pushParameters := adapters.PushParameters{
-		Path:            path,
-		IgnoredFiles:    options.IgnorePaths,
-		Debug:           options.Debug,
-		DevfileBuildCmd: options.BuildCommand,
-		DevfileRunCmd:   options.RunCommand,
-		RandomPorts:     options.RandomPorts,
-		ErrOut:          errOut,
+		Path:                 path,
+		IgnoredFiles:         options.IgnorePaths,
+		Debug:                options.Debug,
+		DevfileBuildCmd:      options.BuildCommand,
+		DevfileRunCmd:        options.RunCommand,
+		RandomPorts:          options.RandomPorts,
+		CustomForwardedPorts: options.CustomForwardedPorts,
+		CustomAddress:        options.CustomAddress,
+		ErrOut:               errOut,
 	}
 
 	klog.V(4).Infoln("Creating inner-loop resources for the component")
@@ -113,24 +143,36 @@ func (o *DevClient) Start(
 		return err
 	}
 	klog.V(4).Infoln("Successfully created inner-loop resources")
+	if componentStatus.State == watch.StateReady {
+		o.probeEndpoints(ctx, *devfileObj, componentName)
+	}
 
 	watchParameters := watch.WatchParameters{
-		DevfilePath:         devfilePath,
-		Path:                path,
-		ComponentName:       componentName,
-		ApplicationName:     odocontext.GetApplication(ctx),
-		DevfileWatchHandler: o.regenerateAdapterAndPush,
-		FileIgnores:         options.IgnorePaths,
-		InitialDevfileObj:   *devfileObj,
-		Debug:               options.Debug,
-		DevfileBuildCmd:     options.BuildCommand,
-		DevfileRunCmd:       options.RunCommand,
-		Variables:           options.Variables,
-		RandomPorts:         options.RandomPorts,
-		WatchFiles:          options.WatchFiles,
-		WatchCluster:        true,
-		ErrOut:              errOut,
-		PromptMessage:       promptMessage,
+		DevfilePath:          devfilePath,
+		Path:                 path,
+		ComponentName:        componentName,
+		ApplicationName:      odocontext.GetApplication(ctx),
+		DevfileWatchHandler:  o.regenerateAdapterAndPush,
+		KeyActionHandler:     o.keyActionHandler,
+		SyncBackHandler:      o.syncBackHandler,
+		SyncBackPaths:        options.SyncBackPaths,
+		FileIgnores:          options.IgnorePaths,
+		InitialDevfileObj:    *devfileObj,
+		Debug:                options.Debug,
+		DevfileBuildCmd:      options.BuildCommand,
+		DevfileRunCmd:        options.RunCommand,
+		DevfileDebugCmd:      options.DebugCommand,
+		ControlRequests:      options.ControlRequests,
+		ProbeResults:         o.probeResults,
+		Variables:            options.Variables,
+		RandomPorts:          options.RandomPorts,
+		CustomForwardedPorts: options.CustomForwardedPorts,
+		CustomAddress:        options.CustomAddress,
+		WatchFiles:           options.WatchFiles,
+		WatchCluster:         true,
+		Out:                  out,
+		ErrOut:               errOut,
+		PromptMessage:        promptMessage,
 	}
 
 	return o.watchClient.WatchAndPush(out, watchParameters, ctx, componentStatus)
@@ -138,27 +180,42 @@ func (o *DevClient) Start(
 
 // RegenerateAdapterAndPush regenerates the adapter and pushes the files to remote pod
 func (o *DevClient) regenerateAdapterAndPush(ctx context.Context, pushParams adapters.PushParameters, watchParams watch.WatchParameters, componentStatus *watch.ComponentStatus) error {
-	var adapter component.ComponentAdapter
-
-	adapter, err := o.regenerateComponentAdapterFromWatchParams(watchParams)
+	devObj, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(""), watchParams.Variables)
 	if err != nil {
 		return fmt.Errorf("unable to generate component from watch parameters: %w", err)
 	}
+	adapter := o.newComponentAdapter(watchParams, devObj)
 
+	wasReady := componentStatus.State == watch.StateReady
 	err = adapter.Push(ctx, pushParams, componentStatus)
 	if err != nil {
 		return fmt.Errorf("watch command was unable to push component: %w", err)
 	}
 
+	if !wasReady && componentStatus.State == watch.StateReady {
+		o.probeEndpoints(ctx, devObj, watchParams.ComponentName)
+	}
 	return nil
 }
 
+// syncBackHandler copies the files changed in the container under the paths to sync back into the local directory
+func (o *DevClient) syncBackHandler(ctx context.Context, watchParams watch.WatchParameters) ([]string, []string, error) {
+	pod, err := o.kubernetesClient.GetPodUsingComponentName(watchParams.ComponentName)
+	if err != nil {
+		return nil, nil, err
+	}
+	return common.SyncBack(o.syncClient, pod, watchParams)
+}
+
 func (o *DevClient) regenerateComponentAdapterFromWatchParams(parameters watch.WatchParameters) (component.ComponentAdapter, error) {
 	devObj, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(""), parameters.Variables)
 	if err != nil {
 		return nil, err
 	}
+	return o.newComponentAdapter(parameters, devObj), nil
+}
 
+func (o *DevClient) newComponentAdapter(parameters watch.WatchParameters, devObj parser.DevfileObj) component.ComponentAdapter {
 	return component.NewKubernetesAdapter(
 		o.kubernetesClient,
 		o.prefClient,
@@ -172,6 +229,29 @@ func (o *DevClient) regenerateComponentAdapterFromWatchParams(parameters watch.W
 			AppName:       parameters.ApplicationName,
 			Devfile:       devObj,
 			FS:            o.filesystem,
+			RunSupervisor: o.runSupervisor,
 		},
-	), nil
+	)
+}
+
+// probeEndpoints checks in the background that the application is ready on its forwarded endpoints,
+// after cancelling the probes started after a previous push
+func (o *DevClient) probeEndpoints(ctx context.Context, devfileObj parser.DevfileObj, componentName string) {
+	if o.cancelProbes != nil {
+		o.cancelProbes()
+	}
+	fwPorts, err := o.stateClient.GetForwardedPorts()
+	if err != nil {
+		klog.V(2).Infof("unable to get the forwarded ports: %v", err)
+		return
+	}
+	ctx, o.cancelProbes = context.WithCancel(ctx)
+	displayLogs := func(containerName string, out io.Writer) error {
+		pod, err := o.kubernetesClient.GetPodUsingComponentName(componentName)
+		if err != nil {
+			return err
+		}
+		return odocomponent.DisplayContainerLogs(o.kubernetesClient, pod.GetName(), containerName, numberOfLogLines, out)
+	}
+	go common.ProbeEndpoints(ctx, devfileObj, fwPorts, common.DefaultProbeTimeout, o.probeResults, displayLogs)
 }
@@ -1,36 +1,43 @@
 package podmandev
 
 import (
+	"context"
+
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"k8s.io/klog"
 
 	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile/image"
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
 type commandHandler struct {
+	ctx             context.Context
+	fs              filesystem.Filesystem
 	execClient      exec.Client
 	platformClient  platform.Client
 	componentExists bool
 	podName         string
 	appName         string
 	componentName   string
+	// platformName is the name of the platform, to be displayed to the user
+	platformName string
+	supervisor   *component.RunSupervisor
 }
 
 var _ libdevfile.Handler = (*commandHandler)(nil)
 
 func (a commandHandler) ApplyImage(img devfilev1.Component) error {
-	klog.V(4).Info("apply image commands are not implemented on podman")
-	log.Warningf("Apply Image commands are not implemented on Podman. Skipping: %v", img.Name)
-	return nil
+	return image.BuildPushSpecificImage(a.ctx, a.fs, img, false)
 }
 
 func (a commandHandler) ApplyKubernetes(kubernetes devfilev1.Component) error {
-	klog.V(4).Info("apply kubernetes commands are not implemented on podman")
-	log.Warningf("Apply Kubernetes components are not supported on Podman. Skipping: %v.", kubernetes.Name)
+	klog.V(4).Infof("apply kubernetes commands are not implemented on %s", a.platformName)
+	log.Warningf("Apply Kubernetes components are not supported on %s. Skipping: %v.", a.platformName, kubernetes.Name)
 	return nil
 }
 
@@ -43,5 +50,6 @@ func (a commandHandler) Execute(devfileCmd devfilev1.Command) error {
 		a.podName,
 		a.appName,
 		a.componentName,
+		a.supervisor,
 	)
 }
@@ -0,0 +1,131 @@
+package podmandev
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"path/filepath"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/devfile/image"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// buildImages builds locally the Image components of the Devfile that need to be built for the dev session.
+// An image already built during the session is built again only if the content of its Dockerfile changed.
+// It returns the names of the images that have been (re)built.
+func (o *DevClient) buildImages(ctx context.Context) ([]string, error) {
+	var (
+		devfileObj  = odocontext.GetDevfileObj(ctx)
+		devfilePath = odocontext.GetDevfilePath(ctx)
+		path        = filepath.Dir(devfilePath)
+	)
+
+	components, err := getImageComponentsToBuild(*devfileObj)
+	if err != nil {
+		return nil, err
+	}
+
+	if o.builtImages == nil {
+		o.builtImages = map[string]string{}
+	}
+
+	var built []string
+	for _, comp := range components {
+		digest := getDockerfileDigest(o.filesystem, path, comp.Image)
+		previous, alreadyBuilt := o.builtImages[comp.Image.ImageName]
+		if alreadyBuilt && (digest == "" || digest == previous) {
+			klog.V(4).Infof("image %q is up to date, not building it again", comp.Image.ImageName)
+			continue
+		}
+		err = image.BuildPushSpecificImage(ctx, o.filesystem, comp, false)
+		if err != nil {
+			return nil, err
+		}
+		o.builtImages[comp.Image.ImageName] = digest
+		built = append(built, comp.Image.ImageName)
+	}
+	return built, nil
+}
+
+// getImageComponentsToBuild returns the Image components to build before starting the dev session:
+// the ones with autoBuild set to true, and the ones without autoBuild set and referenced by a container component.
+func getImageComponentsToBuild(devfileObj parser.DevfileObj) ([]devfilev1.Component, error) {
+	imageComponents, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: devfilev1.ImageComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(imageComponents) == 0 {
+		return nil, nil
+	}
+
+	containerComponents, err := devfileObj.Data.GetComponents(common.DevfileOptions{
+		ComponentOptions: common.ComponentOptions{ComponentType: devfilev1.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	referenced := map[string]bool{}
+	for _, comp := range containerComponents {
+		referenced[comp.Container.Image] = true
+	}
+
+	var result []devfilev1.Component
+	for _, comp := range imageComponents {
+		autoBuild := comp.Image.AutoBuild
+		if autoBuild != nil && !*autoBuild {
+			continue
+		}
+		if (autoBuild != nil && *autoBuild) || referenced[comp.Image.ImageName] {
+			result = append(result, comp)
+		}
+	}
+	return result, nil
+}
+
+// getDockerfileDigest returns a digest of the content of the local Dockerfile used to build img,
+// or an empty string if the Dockerfile is remote or cannot be read
+func getDockerfileDigest(fs filesystem.Filesystem, path string, img *devfilev1.ImageComponent) string {
+	if img == nil || img.Dockerfile == nil {
+		return ""
+	}
+	uri := img.Dockerfile.Uri
+	if uri == "" || strings.HasPrefix(uri, "http://") || strings.HasPrefix(uri, "https://") {
+		return ""
+	}
+	if !filepath.IsAbs(uri) {
+		uri = filepath.Join(path, uri)
+	}
+	content, err := fs.ReadFile(uri)
+	if err != nil {
+		klog.V(4).Infof("unable to read Dockerfile %q: %v", uri, err)
+		return ""
+	}
+	sum := sha256.Sum256(content)
+	return hex.EncodeToString(sum[:])
+}
+
+// useLocalImages makes the containers of the pod referencing one of the locally built images
+// use the local image instead of pulling it from a registry
+func useLocalImages(pod *corev1.Pod, localImages map[string]string) {
+	for i := range pod.Spec.Containers {
+		if _, found := localImages[pod.Spec.Containers[i].Image]; found {
+			pod.Spec.Containers[i].ImagePullPolicy = corev1.PullNever
+		}
+	}
+	for i := range pod.Spec.InitContainers {
+		if _, found := localImages[pod.Spec.InitContainers[i].Image]; found {
+			pod.Spec.InitContainers[i].ImagePullPolicy = corev1.PullNever
+		}
+	}
+}
@@ -0,0 +1,106 @@
+package podmandev
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/pointer"
+)
+
+func getImageComponent(name string, imageName string, autoBuild *bool) v1alpha2.Component {
+	comp := generator.GetImageComponent(generator.ImageComponentParams{
+		Name: name,
+		Image: v1alpha2.Image{
+			ImageName: imageName,
+			ImageUnion: v1alpha2.ImageUnion{
+				Dockerfile: &v1alpha2.DockerfileImage{
+					DockerfileSrc: v1alpha2.DockerfileSrc{
+						Uri: "Dockerfile",
+					},
+				},
+			},
+		},
+	})
+	comp.Image.AutoBuild = autoBuild
+	return comp
+}
+
+func Test_getImageComponentsToBuild(t *testing.T) {
+	tests := []struct {
+		name            string
+		imageComponents []v1alpha2.Component
+		want            []string
+	}{
+		{
+			name: "no image component",
+		},
+		{
+			name: "image referenced by a container is built",
+			imageComponents: []v1alpha2.Component{
+				getImageComponent("img", "myimage", nil),
+			},
+			want: []string{"img"},
+		},
+		{
+			name: "image not referenced by a container is not built",
+			imageComponents: []v1alpha2.Component{
+				getImageComponent("img", "otherimage", nil),
+			},
+		},
+		{
+			name: "image not referenced by a container with autoBuild is built",
+			imageComponents: []v1alpha2.Component{
+				getImageComponent("img", "otherimage", pointer.Bool(true)),
+			},
+			want: []string{"img"},
+		},
+		{
+			name: "image referenced by a container with autoBuild false is not built",
+			imageComponents: []v1alpha2.Component{
+				getImageComponent("img", "myimage", pointer.Bool(false)),
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, _ := data.NewDevfileData(string(data.APISchemaVersion220))
+			_ = devfileData.AddComponents(append([]v1alpha2.Component{baseComponent}, tt.imageComponents...))
+			got, err := getImageComponentsToBuild(parser.DevfileObj{Data: devfileData})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var gotNames []string
+			for _, comp := range got {
+				gotNames = append(gotNames, comp.Name)
+			}
+			if diff := cmp.Diff(tt.want, gotNames); diff != "" {
+				t.Errorf("getImageComponentsToBuild() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_useLocalImages(t *testing.T) {
+	pod := basePod.DeepCopy()
+	pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{
+		Name:            "other",
+		Image:           "otherimage",
+		ImagePullPolicy: corev1.PullAlways,
+	})
+
+	useLocalImages(pod, map[string]string{"myimage": "digest"})
+
+	if got := pod.Spec.Containers[0].ImagePullPolicy; got != corev1.PullNever {
+		t.Errorf("expected pull policy %q for locally built image, got %q", corev1.PullNever, got)
+	}
+	if got := pod.Spec.Containers[1].ImagePullPolicy; got != corev1.PullAlways {
+		t.Errorf("expected pull policy %q for remote image, got %q", corev1.PullAlways, got)
+	}
+}
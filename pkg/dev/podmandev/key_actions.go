@@ -0,0 +1,104 @@
+package podmandev
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/devfile/location"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// numberOfLogLines is the number of lines displayed for each container when the user requests the logs
+const numberOfLogLines = 20
+
+// keyActionHandler executes the actions requested by the user by pressing keys during the Dev session
+func (o *DevClient) keyActionHandler(ctx context.Context, action watch.KeyAction, watchParams watch.WatchParameters, componentStatus *watch.ComponentStatus) error {
+	if o.deployedPod == nil {
+		return errors.New("the component is not deployed")
+	}
+	pod := o.deployedPod
+
+	switch action {
+	case watch.KeyActionRerun:
+		devObj, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(""), watchParams.Variables)
+		if err != nil {
+			return fmt.Errorf("unable to read the Devfile: %w", err)
+		}
+		err = component.StopRunCommands(o.execClient, devObj, pod.Name, watchParams.DevfileRunCmd, watchParams.DevfileDebugCmd, o.runSupervisor)
+		if err != nil {
+			return err
+		}
+		cmdKind := devfilev1.RunCommandGroupKind
+		cmdName := watchParams.DevfileRunCmd
+		if watchParams.Debug {
+			cmdKind = devfilev1.DebugCommandGroupKind
+			cmdName = watchParams.DevfileDebugCmd
+		}
+		cmdHandler := commandHandler{
+			ctx:             ctx,
+			fs:              o.filesystem,
+			execClient:      o.execClient,
+			platformClient:  o.podmanClient,
+			componentExists: false,
+			platformName:    o.platformName(),
+			podName:         pod.Name,
+			appName:         watchParams.ApplicationName,
+			componentName:   watchParams.ComponentName,
+			supervisor:      o.runSupervisor,
+		}
+		return libdevfile.ExecuteCommandByNameAndKind(devObj, cmdName, cmdKind, &cmdHandler, false)
+
+	case watch.KeyActionRestart:
+		spinner := log.Spinner("Restarting the containers of the component")
+		defer spinner.End(false)
+		// The pod is deployed again by the next reconciliation, reusing its volumes
+		err := o.removePod(pod.Name)
+		if err != nil {
+			return err
+		}
+		err = podman.RemoveConfigResources(o.podmanClient, pod)
+		if err != nil {
+			return err
+		}
+		o.deployedPod = nil
+		o.deployedResources = nil
+		componentStatus.State = watch.StateWaitDeployment
+		componentStatus.PostStartEventsDone = false
+		componentStatus.RunExecuted = false
+		spinner.End(true)
+		return nil
+
+	case watch.KeyActionLogs:
+		return component.DisplayContainersLogs(o.podmanClient, pod, numberOfLogLines, log.GetStdout())
+
+	case watch.KeyActionTest:
+		devObj, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(""), watchParams.Variables)
+		if err != nil {
+			return fmt.Errorf("unable to read the Devfile: %w", err)
+		}
+		handler := component.NewRunCommandHandler(
+			ctx,
+			o.filesystem,
+			o.podmanClient,
+			nil,
+			devObj,
+			watchParams.Path,
+			watchParams.ApplicationName,
+			watchParams.ComponentName,
+			pod.Name,
+			o.platformName(),
+			log.GetStdout(),
+			log.GetStderr(),
+		)
+		return libdevfile.ExecuteCommandByNameAndKind(devObj, "", devfilev1.TestCommandGroupKind, handler, false)
+	}
+	return fmt.Errorf("unsupported action %q", action)
+}
@@ -15,7 +15,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/devfile/adapters/kubernetes/utils"
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
-	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/portForward"
 	"github.com/redhat-developer/odo/pkg/storage"
 	"github.com/redhat-developer/odo/pkg/util"
 
@@ -32,7 +32,10 @@ func createPodFromComponent(
 	runCommand string,
 	debugCommand string,
 	randomPorts bool,
+	customForwardedPorts []api.ForwardedPort,
+	customAddress string,
 	usedPorts []int,
+	platform string,
 ) (*corev1.Pod, []api.ForwardedPort, error) {
 	containers, err := generator.GetContainers(devfileObj, common.DevfileOptions{})
 	if err != nil {
@@ -57,7 +60,7 @@ func createPodFromComponent(
 		return nil, nil, err
 	}
 	ceMapping := libdevfile.GetContainerEndpointMapping(containerComponents, debug)
-	fwPorts := addHostPorts(containers, ceMapping, debug, randomPorts, usedPorts)
+	fwPorts := addHostPorts(containers, ceMapping, debug, randomPorts, customForwardedPorts, customAddress, usedPorts, platform)
 
 	volumes := []corev1.Volume{
 		{
@@ -123,8 +126,24 @@ func getVolumeName(volume string, componentName string, appName string) string {
 	return volume + "-" + componentName + "-" + appName
 }
 
-func addHostPorts(containers []corev1.Container, ceMapping map[string][]v1alpha2.Endpoint, debug bool, randomPorts bool, usedPorts []int) []api.ForwardedPort {
+// addHostPorts sets the host ports of the container ports, and returns the forwarded ports.
+// The local ports requested in customForwardedPorts are used for their container ports, the other ports being assigned automatically.
+// The host ports are bound on customAddress, or on 127.0.0.1 if empty.
+func addHostPorts(
+	containers []corev1.Container,
+	ceMapping map[string][]v1alpha2.Endpoint,
+	debug bool,
+	randomPorts bool,
+	customForwardedPorts []api.ForwardedPort,
+	customAddress string,
+	usedPorts []int,
+	platform string,
+) []api.ForwardedPort {
 	var result []api.ForwardedPort
+	localAddress := "127.0.0.1"
+	if customAddress != "" {
+		localAddress = customAddress
+	}
 	startPort := 20001
 	endPort := startPort + 10000
 	usedPortsCopy := make([]int, len(usedPorts))
@@ -141,7 +160,13 @@ func addHostPorts(containers []corev1.Container, ceMapping map[string][]v1alpha2
 				continue
 			}
 			var freePort int
-			if randomPorts {
+			if customPort := portForward.GetCustomLocalPort(customForwardedPorts, containerName, int(port.ContainerPort)); customPort != 0 {
+				freePort = customPort
+			} else if randomPorts {
+				// The requested local ports are not reused for other container ports
+				for len(usedPortsCopy) != 0 && portForward.IsCustomLocalPort(customForwardedPorts, usedPortsCopy[0]) {
+					usedPortsCopy = usedPortsCopy[1:]
+				}
 				if len(usedPortsCopy) != 0 {
 					freePort = usedPortsCopy[0]
 					usedPortsCopy = usedPortsCopy[1:]
@@ -149,7 +174,7 @@ func addHostPorts(containers []corev1.Container, ceMapping map[string][]v1alpha2
 					rand.Seed(time.Now().UnixNano()) //#nosec
 					for {
 						freePort = rand.Intn(endPort-startPort+1) + startPort //#nosec
-						if util.IsPortFree(freePort) {
+						if util.IsPortFree(freePort) && !portForward.IsCustomLocalPort(customForwardedPorts, freePort) {
 							break
 						}
 						time.Sleep(100 * time.Millisecond)
@@ -157,7 +182,13 @@ func addHostPorts(containers []corev1.Container, ceMapping map[string][]v1alpha2
 				}
 			} else {
 				var err error
-				freePort, err = util.NextFreePort(startPort, endPort, usedPorts)
+				for {
+					freePort, err = util.NextFreePort(startPort, endPort, usedPorts)
+					if err != nil || !portForward.IsCustomLocalPort(customForwardedPorts, freePort) {
+						break
+					}
+					startPort = freePort + 1
+				}
 				if err != nil {
 					klog.Infof("%s", err)
 					continue
@@ -167,11 +198,11 @@ func addHostPorts(containers []corev1.Container, ceMapping map[string][]v1alpha2
 			// Find the endpoint in the container-endpoint mapping
 			containerPort := int(port.ContainerPort)
 			fp := api.ForwardedPort{
-				Platform:      commonflags.PlatformPodman,
+				Platform:      platform,
 				PortName:      portName,
 				IsDebug:       isDebugPort,
 				ContainerName: containerName,
-				LocalAddress:  "127.0.0.1",
+				LocalAddress:  localAddress,
 				LocalPort:     freePort,
 				ContainerPort: containerPort,
 			}
@@ -184,6 +215,9 @@ func addHostPorts(containers []corev1.Container, ceMapping map[string][]v1alpha2
 			}
 			result = append(result, fp)
 			port.HostPort = int32(freePort)
+			if customAddress != "" {
+				port.HostIP = customAddress
+			}
 			ports = append(ports, port)
 		}
 		containers[i].Ports = ports
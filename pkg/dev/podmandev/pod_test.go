@@ -12,6 +12,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile/generator"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	"github.com/redhat-developer/odo/pkg/version"
 
 	corev1 "k8s.io/api/core/v1"
@@ -123,6 +124,9 @@ func Test_createPodFromComponent(t *testing.T) {
 		buildCommand  string
 		runCommand    string
 		debugCommand  string
+
+		customForwardedPorts []api.ForwardedPort
+		customAddress        string
 	}
 	tests := []struct {
 		name        string
@@ -383,6 +387,73 @@ func Test_createPodFromComponent(t *testing.T) {
 				return pod
 			},
 		},
+		{
+			name: "basic component + application endpoint + debug endpoint - with custom port and address",
+			args: args{
+				devfileObj: func() parser.DevfileObj {
+					data, _ := data.NewDevfileData(string(data.APISchemaVersion200))
+					_ = data.AddCommands([]v1alpha2.Command{command})
+					cmp := baseComponent.DeepCopy()
+					cmp.Container.Endpoints = append(cmp.Container.Endpoints, v1alpha2.Endpoint{
+						Name:       "http",
+						TargetPort: 8080,
+					})
+					cmp.Container.Endpoints = append(cmp.Container.Endpoints, v1alpha2.Endpoint{
+						Name:       "debug",
+						TargetPort: 5858,
+					})
+					_ = data.AddComponents([]v1alpha2.Component{*cmp})
+					return parser.DevfileObj{
+						Data: data,
+					}
+				},
+				componentName: devfileName,
+				appName:       appName,
+				debug:         true,
+				customForwardedPorts: []api.ForwardedPort{
+					{ContainerName: "mycomponent", PortName: "http", LocalPort: 18080, ContainerPort: 8080},
+				},
+				customAddress: "0.0.0.0",
+			},
+			wantPod: func() *corev1.Pod {
+				pod := basePod.DeepCopy()
+				pod.Spec.Containers[0].Ports = append(pod.Spec.Containers[0].Ports, corev1.ContainerPort{
+					Name:          "http",
+					ContainerPort: 8080,
+					Protocol:      "TCP",
+					HostPort:      18080,
+					HostIP:        "0.0.0.0",
+				})
+				pod.Spec.Containers[0].Ports = append(pod.Spec.Containers[0].Ports, corev1.ContainerPort{
+					Name:          "debug",
+					ContainerPort: 5858,
+					Protocol:      "TCP",
+					HostPort:      20001,
+					HostIP:        "0.0.0.0",
+				})
+				return pod
+			},
+			wantFwPorts: []api.ForwardedPort{
+				{
+					Platform:      "podman",
+					ContainerName: "mycomponent",
+					PortName:      "http",
+					LocalAddress:  "0.0.0.0",
+					LocalPort:     18080,
+					ContainerPort: 8080,
+					IsDebug:       false,
+				},
+				{
+					Platform:      "podman",
+					ContainerName: "mycomponent",
+					PortName:      "debug",
+					LocalAddress:  "0.0.0.0",
+					LocalPort:     20001,
+					ContainerPort: 5858,
+					IsDebug:       true,
+				},
+			},
+		},
 
 		// TODO: Add test cases.
 	}
@@ -397,7 +468,10 @@ func Test_createPodFromComponent(t *testing.T) {
 				tt.args.runCommand,
 				tt.args.debugCommand,
 				false,
+				tt.args.customForwardedPorts,
+				tt.args.customAddress,
 				[]int{20001, 20002},
+				commonflags.PlatformPodman,
 			)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("createPodFromComponent() error = %v, wantErr %v", err, tt.wantErr)
@@ -1,69 +1,105 @@
 package podmandev
 
 import (
-	"bytes"
 	"context"
-	"encoding/json"
 	"fmt"
 	"io"
 	"path/filepath"
 	"strings"
 
-	"github.com/devfile/library/v2/pkg/devfile/parser"
-	"k8s.io/klog"
-
+	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/dev/common"
 	"github.com/redhat-developer/odo/pkg/devfile"
 	"github.com/redhat-developer/odo/pkg/devfile/adapters"
 	"github.com/redhat-developer/odo/pkg/devfile/location"
 	"github.com/redhat-developer/odo/pkg/exec"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/podman"
 	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/sync"
+	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 	"github.com/redhat-developer/odo/pkg/watch"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
 )
 
 const (
 	promptMessage = `
-[Ctrl+c] - Exit and delete resources from podman
-     [p] - Manually apply local changes to the application on podman
+[Ctrl+c] - Exit and delete resources from %[1]s
+     [p] - Manually apply local changes to the application on %[1]s
+     [r] - Execute again the run command, without applying local changes
+     [d] - Switch between the run and debug commands
+     [c] - Restart the containers of the application
+     [l] - Show the last lines of the logs of each container
+     [s] - Show the forwarded ports and the keyboard commands again
+     [t] - Run the default test command
 `
 )
 
+// DevClient runs the component on a local container platform, either Podman or Docker,
+// with the podman client of the platform
 type DevClient struct {
+	platform     string
 	podmanClient podman.Client
 	syncClient   sync.Client
 	execClient   exec.Client
 	stateClient  state.Client
 	watchClient  watch.Client
+	filesystem   filesystem.Filesystem
 
 	deployedPod *corev1.Pod
-	usedPorts   []int
+	// deployedResources are the resources of Kubernetes components created alongside the deployed pod
+	deployedResources []unstructured.Unstructured
+	// volumesPrepared is true once the volumes left by a previous session have been reused or deleted,
+	// so that they are not deleted again when the pod is deployed again during the session
+	volumesPrepared bool
+	usedPorts       []int
+	// builtImages contains the digests of the Dockerfiles used to build images locally, indexed by image name
+	builtImages map[string]string
+	// cancelProbes cancels the probes of the endpoints started after the previous reconciliation
+	cancelProbes context.CancelFunc
+	// probeResults receives the results of the probes of the endpoints, displayed by the watch loop
+	probeResults chan watch.ProbeResult
+	// runSupervisor restarts the run command when it crashes, it is nil if options.AutoRestart is not set
+	runSupervisor *component.RunSupervisor
 }
 
 var _ dev.Client = (*DevClient)(nil)
 
 func NewDevClient(
+	platform string,
 	podmanClient podman.Client,
 	syncClient sync.Client,
 	execClient exec.Client,
 	stateClient state.Client,
 	watchClient watch.Client,
+	filesystem filesystem.Filesystem,
 ) *DevClient {
 	return &DevClient{
+		platform:     platform,
 		podmanClient: podmanClient,
 		syncClient:   syncClient,
 		execClient:   execClient,
 		stateClient:  stateClient,
 		watchClient:  watchClient,
+		filesystem:   filesystem,
 	}
 }
 
+// platformName returns the name of the platform, to be displayed to the user
+func (o *DevClient) platformName() string {
+	if o.platform == commonflags.PlatformDocker {
+		return "Docker"
+	}
+	return "Podman"
+}
+
 func (o *DevClient) Start(
 	ctx context.Context,
 	out io.Writer,
@@ -80,31 +116,44 @@ func (o *DevClient) Start(
 		componentStatus = watch.ComponentStatus{}
 	)
 
+	if options.AutoRestart {
+		o.runSupervisor = component.NewRunSupervisor(ctx)
+	}
+	o.probeResults = make(chan watch.ProbeResult)
+
 	err := o.reconcile(ctx, out, errOut, options, &componentStatus)
 	if err != nil {
 		return err
 	}
 
-	watch.PrintInfoMessage(out, path, options.WatchFiles, promptMessage)
+	watch.PrintInfoMessage(out, path, options.WatchFiles, fmt.Sprintf(promptMessage, o.platform))
 
 	watchParameters := watch.WatchParameters{
-		DevfilePath:         devfilePath,
-		Path:                path,
-		ComponentName:       componentName,
-		ApplicationName:     appName,
-		InitialDevfileObj:   *devfileObj,
-		DevfileWatchHandler: o.watchHandler,
-		FileIgnores:         options.IgnorePaths,
-		Debug:               options.Debug,
-		DevfileBuildCmd:     options.BuildCommand,
-		DevfileRunCmd:       options.RunCommand,
-		Variables:           options.Variables,
-		RandomPorts:         options.RandomPorts,
-		WatchFiles:          options.WatchFiles,
-		WatchCluster:        false,
-		Out:                 out,
-		ErrOut:              errOut,
-		PromptMessage:       promptMessage,
+		DevfilePath:          devfilePath,
+		Path:                 path,
+		ComponentName:        componentName,
+		ApplicationName:      appName,
+		InitialDevfileObj:    *devfileObj,
+		DevfileWatchHandler:  o.watchHandler,
+		KeyActionHandler:     o.keyActionHandler,
+		SyncBackHandler:      o.syncBackHandler,
+		SyncBackPaths:        options.SyncBackPaths,
+		FileIgnores:          options.IgnorePaths,
+		Debug:                options.Debug,
+		DevfileBuildCmd:      options.BuildCommand,
+		DevfileRunCmd:        options.RunCommand,
+		DevfileDebugCmd:      options.DebugCommand,
+		ControlRequests:      options.ControlRequests,
+		ProbeResults:         o.probeResults,
+		Variables:            options.Variables,
+		RandomPorts:          options.RandomPorts,
+		CustomForwardedPorts: options.CustomForwardedPorts,
+		CustomAddress:        options.CustomAddress,
+		WatchFiles:           options.WatchFiles,
+		WatchCluster:         false,
+		Out:                  out,
+		ErrOut:               errOut,
+		PromptMessage:        fmt.Sprintf(promptMessage, o.platform),
 	}
 
 	return o.watchClient.WatchAndPush(out, watchParameters, ctx, componentStatus)
@@ -146,68 +195,83 @@ func (o *DevClient) syncFiles(ctx context.Context, options dev.StartOptions, pod
 	return execRequired, nil
 }
 
-// checkVolumesFree checks that all persistent volumes declared in pod
-// are not using an existing volume
-func (o *DevClient) checkVolumesFree(pod *corev1.Pod) error {
-	existingVolumesSet, err := o.podmanClient.VolumeLs()
+// syncBackHandler copies the files changed in the deployed pod under the paths to sync back into the local directory
+func (o *DevClient) syncBackHandler(ctx context.Context, watchParams watch.WatchParameters) ([]string, []string, error) {
+	if o.deployedPod == nil {
+		return nil, nil, nil
+	}
+	return common.SyncBack(o.syncClient, o.deployedPod, watchParams)
+}
+
+// prepareVolumes checks the persistent volumes declared in pod which already exist.
+// The volumes owned by the component, left by a previous session, are reused, or deleted if cleanVolumes is true.
+// An error is returned if some volumes are owned by another component.
+func (o *DevClient) prepareVolumes(pod *corev1.Pod, componentName string, appName string, cleanVolumes bool) error {
+	existingVolumesSet, err := o.podmanClient.VolumeLs("")
 	if err != nil {
 		return err
 	}
-	var problematicVolumes []string
+	var reusedVolumes, problematicVolumes []string
 	for _, volume := range pod.Spec.Volumes {
-		if volume.PersistentVolumeClaim != nil && existingVolumesSet[volume.PersistentVolumeClaim.ClaimName] {
-			problematicVolumes = append(problematicVolumes, volume.PersistentVolumeClaim.ClaimName)
+		if volume.PersistentVolumeClaim == nil || !existingVolumesSet[volume.PersistentVolumeClaim.ClaimName] {
+			continue
+		}
+		volumeName := volume.PersistentVolumeClaim.ClaimName
+		inspect, err := o.podmanClient.VolumeInspect(volumeName)
+		if err != nil {
+			return err
+		}
+		if !isVolumeOwned(volume, inspect.Labels, componentName, appName) {
+			problematicVolumes = append(problematicVolumes, volumeName)
+			continue
+		}
+		if cleanVolumes {
+			klog.V(3).Infof("deleting %s volume %q", o.platform, volumeName)
+			err = o.podmanClient.VolumeRm(volumeName)
+			if err != nil {
+				return err
+			}
+			continue
 		}
+		reusedVolumes = append(reusedVolumes, volumeName)
 	}
 	if len(problematicVolumes) > 0 {
-		return fmt.Errorf("volumes already exist, please remove them before to run odo dev: %s", strings.Join(problematicVolumes, ", "))
+		return fmt.Errorf("volumes already exist and are used by another component, please remove them before to run odo dev: %s", strings.Join(problematicVolumes, ", "))
+	}
+	if len(reusedVolumes) > 0 {
+		log.Infof("Reusing the existing volumes: %s. Use --clean-volumes to start with clean volumes", strings.Join(reusedVolumes, ", "))
 	}
 	return nil
 }
 
-func (o *DevClient) watchHandler(ctx context.Context, pushParams adapters.PushParameters, watchParams watch.WatchParameters, componentStatus *watch.ComponentStatus) error {
-	printWarningsOnDevfileChanges(ctx, watchParams)
-
-	startOptions := dev.StartOptions{
-		IgnorePaths:  watchParams.FileIgnores,
-		Debug:        watchParams.Debug,
-		BuildCommand: watchParams.DevfileBuildCmd,
-		RunCommand:   watchParams.DevfileRunCmd,
-		RandomPorts:  watchParams.RandomPorts,
-		WatchFiles:   watchParams.WatchFiles,
-		Variables:    watchParams.Variables,
+// isVolumeOwned returns true if the existing volume, having the given labels, is owned by the component.
+// The volume is owned by the component if it is labelled with the name of the component and of the application,
+// or, if it has no odo labels, if its name is the one given by odo to the volume for this component.
+func isVolumeOwned(volume corev1.Volume, labels map[string]string, componentName string, appName string) bool {
+	if name := odolabels.GetComponentName(labels); name != "" {
+		return name == componentName && odolabels.GetAppName(labels) == appName
 	}
-	return o.reconcile(ctx, watchParams.Out, watchParams.ErrOut, startOptions, componentStatus)
+	return volume.PersistentVolumeClaim.ClaimName == getVolumeName(volume.Name, componentName, appName)
 }
 
-func printWarningsOnDevfileChanges(ctx context.Context, parameters watch.WatchParameters) {
-	var warning string
-	currentDevfile := odocontext.GetDevfileObj(ctx)
-	newDevfile, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(""), parameters.Variables)
+func (o *DevClient) watchHandler(ctx context.Context, pushParams adapters.PushParameters, watchParams watch.WatchParameters, componentStatus *watch.ComponentStatus) error {
+	devObj, err := devfile.ParseAndValidateFromFileWithVariables(location.DevfileLocation(""), watchParams.Variables)
 	if err != nil {
-		warning = fmt.Sprintf("error while reading the Devfile. Please restart 'odo dev' if you made any changes to the Devfile. Error message is: %v", err)
-	} else {
-		devfileEquals := func(d1, d2 parser.DevfileObj) (bool, error) {
-			// Compare two Devfile objects by comparing the result of their JSON encoding,
-			// because reflect.DeepEqual does not work properly with the parser.DevfileObj structure.
-			d1Json, jsonErr := json.Marshal(d1.Data)
-			if jsonErr != nil {
-				return false, jsonErr
-			}
-			d2Json, jsonErr := json.Marshal(d2.Data)
-			if jsonErr != nil {
-				return false, jsonErr
-			}
-			return bytes.Equal(d1Json, d2Json), nil
-		}
-		equal, eqErr := devfileEquals(*currentDevfile, newDevfile)
-		if eqErr != nil {
-			klog.V(5).Infof("error while checking if Devfile has changed: %v", eqErr)
-		} else if !equal {
-			warning = "Detected changes in the Devfile, but this is not supported yet on Podman. Please restart 'odo dev' for such changes to be applied."
-		}
+		return fmt.Errorf("unable to read the Devfile: %w", err)
 	}
-	if warning != "" {
-		log.Fwarning(parameters.Out, warning+"\n")
+	ctx = odocontext.WithDevfileObj(ctx, &devObj)
+
+	startOptions := dev.StartOptions{
+		IgnorePaths:          watchParams.FileIgnores,
+		Debug:                watchParams.Debug,
+		BuildCommand:         watchParams.DevfileBuildCmd,
+		RunCommand:           watchParams.DevfileRunCmd,
+		DebugCommand:         watchParams.DevfileDebugCmd,
+		RandomPorts:          watchParams.RandomPorts,
+		CustomForwardedPorts: watchParams.CustomForwardedPorts,
+		CustomAddress:        watchParams.CustomAddress,
+		WatchFiles:           watchParams.WatchFiles,
+		Variables:            watchParams.Variables,
 	}
+	return o.reconcile(ctx, watchParams.Out, watchParams.ErrOut, startOptions, componentStatus)
 }
@@ -0,0 +1,73 @@
+package podmandev
+
+import (
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_isVolumeOwned(t *testing.T) {
+	newVolume := func(name string, claimName string) corev1.Volume {
+		return corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: claimName,
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		volume corev1.Volume
+		labels map[string]string
+		want   bool
+	}{
+		{
+			name:   "labelled with the component and application",
+			volume: newVolume("myvolume", "myvolume-mycmp-app"),
+			labels: map[string]string{
+				"app.kubernetes.io/instance": "mycmp",
+				"app.kubernetes.io/part-of":  "app",
+			},
+			want: true,
+		},
+		{
+			name:   "labelled with another component",
+			volume: newVolume("myvolume", "myvolume-mycmp-app"),
+			labels: map[string]string{
+				"app.kubernetes.io/instance": "othercmp",
+				"app.kubernetes.io/part-of":  "app",
+			},
+			want: false,
+		},
+		{
+			name:   "labelled with another application",
+			volume: newVolume("myvolume", "myvolume-mycmp-app"),
+			labels: map[string]string{
+				"app.kubernetes.io/instance": "mycmp",
+				"app.kubernetes.io/part-of":  "otherapp",
+			},
+			want: false,
+		},
+		{
+			name:   "not labelled, named after the component",
+			volume: newVolume("myvolume", "myvolume-mycmp-app"),
+			want:   true,
+		},
+		{
+			name:   "not labelled, with another name",
+			volume: newVolume("myvolume", "shared-volume"),
+			want:   false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := isVolumeOwned(tt.volume, tt.labels, "mycmp", "app")
+			if got != tt.want {
+				t.Errorf("isVolumeOwned() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
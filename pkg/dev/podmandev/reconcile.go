@@ -5,7 +5,6 @@ import (
 	"fmt"
 	"io"
 	"path/filepath"
-	"strings"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
@@ -14,10 +13,13 @@ import (
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/dev"
-	"github.com/redhat-developer/odo/pkg/devfile"
+	"github.com/redhat-developer/odo/pkg/dev/common"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/podman"
 	"github.com/redhat-developer/odo/pkg/watch"
 
 	corev1 "k8s.io/api/core/v1"
@@ -31,27 +33,42 @@ func (o *DevClient) reconcile(
 	errOut io.Writer,
 	options dev.StartOptions,
 	componentStatus *watch.ComponentStatus,
-) error {
+) (err error) {
 	var (
 		appName       = odocontext.GetApplication(ctx)
 		componentName = odocontext.GetComponentName(ctx)
 		devfileObj    = odocontext.GetDevfileObj(ctx)
 		devfilePath   = odocontext.GetDevfilePath(ctx)
 		path          = filepath.Dir(devfilePath)
+
+		logger = machineoutput.NewMachineEventLoggingClient()
 	)
 
-	o.warnAboutK8sComponents(*devfileObj)
+	logger.ReconcileStart(machineoutput.TimestampNow())
+	defer func() {
+		logger.ReconcileComplete(machineoutput.TimestampNow(), err)
+	}()
 
+	previousPod := o.deployedPod
 	pod, fwPorts, err := o.deployPod(ctx, options)
 	if err != nil {
 		return err
 	}
 	o.deployedPod = pod
+	if previousPod != pod {
+		reportContainersStatus(pod, "running")
+	}
+	if previousPod != nil && previousPod != pod {
+		// The pod has been recreated, commands need to be executed again in the new containers
+		componentStatus.PostStartEventsDone = false
+		componentStatus.RunExecuted = false
+	}
 
 	execRequired, err := o.syncFiles(ctx, options, pod, path)
 	if err != nil {
 		return err
 	}
+	o.runSupervisor.Reset()
 
 	// PostStart events from the devfile will only be executed when the component
 	// didn't previously exist
@@ -97,12 +114,16 @@ func (o *DevClient) reconcile(
 			cmdName = options.DebugCommand
 		}
 		cmdHandler := commandHandler{
+			ctx:             ctx,
+			fs:              o.filesystem,
 			execClient:      o.execClient,
 			platformClient:  o.podmanClient,
 			componentExists: componentStatus.RunExecuted,
+			platformName:    o.platformName(),
 			podName:         pod.Name,
 			appName:         appName,
 			componentName:   componentName,
+			supervisor:      o.runSupervisor,
 		}
 		err = libdevfile.ExecuteCommandByNameAndKind(*devfileObj, cmdName, cmdKind, &cmdHandler, false)
 		if err != nil {
@@ -119,29 +140,30 @@ func (o *DevClient) reconcile(
 	if err != nil {
 		return err
 	}
+	logger.PortsForwarded(fwPorts, machineoutput.TimestampNow())
+
+	if execRequired {
+		o.probeEndpoints(ctx, *devfileObj, pod.Name, fwPorts)
+	}
 
 	componentStatus.State = watch.StateReady
 	return nil
 }
 
-// warnAboutApplyComponents prints a warning if the Devfile contains standalone K8s components (not referenced by any Apply commands). These resources are currently applied when running in the cluster mode, but not on Podman.
-func (o *DevClient) warnAboutK8sComponents(devfileObj parser.DevfileObj) {
-	var components []string
-	// get all standalone k8s components for a given commandGK
-	k8sComponents, _ := devfile.GetKubernetesComponentsToPush(devfileObj, false)
-
-	if len(k8sComponents) == 0 {
-		return
+// probeEndpoints checks in the background that the application is ready on its forwarded endpoints,
+// after cancelling the probes started after a previous reconciliation
+func (o *DevClient) probeEndpoints(ctx context.Context, devfileObj parser.DevfileObj, podName string, fwPorts []api.ForwardedPort) {
+	if o.cancelProbes != nil {
+		o.cancelProbes()
 	}
-
-	for _, comp := range k8sComponents {
-		components = append(components, comp.Name)
+	ctx, o.cancelProbes = context.WithCancel(ctx)
+	displayLogs := func(containerName string, out io.Writer) error {
+		return component.DisplayContainerLogs(o.podmanClient, podName, containerName, numberOfLogLines, out)
 	}
-
-	log.Warningf("Kubernetes components are not supported on Podman. Skipping: %v.", strings.Join(components, ", "))
+	go common.ProbeEndpoints(ctx, devfileObj, fwPorts, common.DefaultProbeTimeout, o.probeResults, displayLogs)
 }
 
-// deployPod deploys the component as a Pod in podman
+// deployPod deploys the component as a Pod on the platform
 func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions) (*corev1.Pod, []api.ForwardedPort, error) {
 	var (
 		appName       = odocontext.GetApplication(ctx)
@@ -149,6 +171,16 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions) (*c
 		devfileObj    = odocontext.GetDevfileObj(ctx)
 	)
 
+	resources, err := o.getKubernetesResources(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	builtImages, err := o.buildImages(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	spinner := log.Spinner("Deploying pod")
 	defer spinner.End(false)
 
@@ -161,34 +193,123 @@ func (o *DevClient) deployPod(ctx context.Context, options dev.StartOptions) (*c
 		options.RunCommand,
 		"",
 		options.RandomPorts,
+		options.CustomForwardedPorts,
+		options.CustomAddress,
 		o.usedPorts,
+		o.platform,
 	)
 	if err != nil {
 		return nil, nil, err
 	}
 	o.usedPorts = getUsedPorts(fwPorts)
+	useLocalImages(pod, o.builtImages)
+	useKubernetesVolumes(pod, resources)
+	volumeResources, err := getVolumeResources(pod, componentName, appName)
+	if err != nil {
+		return nil, nil, err
+	}
+	resources = append(resources, volumeResources...)
 
-	if equality.Semantic.DeepEqual(o.deployedPod, pod) {
+	if len(builtImages) == 0 && equality.Semantic.DeepEqual(o.deployedPod, pod) && equality.Semantic.DeepEqual(o.deployedResources, resources) {
 		klog.V(4).Info("pod is already deployed as required")
 		spinner.End(true)
 		return o.deployedPod, fwPorts, nil
 	}
 
-	err = o.checkVolumesFree(pod)
+	if o.deployedPod != nil {
+		// The pod deployed during this session needs to be replaced,
+		// because the Devfile changed or because an image it uses has been rebuilt.
+		// Named volumes still used by the new pod are preserved,
+		// the resources created for its ConfigMap and Secret volumes are created again.
+		previousPod := o.deployedPod
+		klog.V(4).Infof("replacing pod %q", previousPod.GetName())
+		err = o.removePod(previousPod.GetName())
+		if err != nil {
+			return nil, nil, err
+		}
+		err = podman.RemoveConfigResources(o.podmanClient, previousPod)
+		if err != nil {
+			return nil, nil, err
+		}
+		// The previous pod does not exist anymore, it must not be removed again if the new pod cannot be created
+		o.deployedPod = nil
+		o.deployedResources = nil
+		for _, volume := range getUnusedVolumes(previousPod, pod) {
+			klog.V(3).Infof("deleting podman volume %q", volume)
+			err = o.podmanClient.VolumeRm(volume)
+			if err != nil {
+				return nil, nil, err
+			}
+		}
+	} else if !o.volumesPrepared {
+		err = o.prepareVolumes(pod, componentName, appName, options.CleanVolumes)
+		if err != nil {
+			return nil, nil, err
+		}
+		o.volumesPrepared = true
+	}
+
+	existingVolumes, err := o.podmanClient.VolumeLs("")
 	if err != nil {
 		return nil, nil, err
 	}
-
-	err = o.podmanClient.PlayKube(pod)
+	err = o.podmanClient.PlayKube(pod, getResourcesToPlay(resources, existingVolumes))
 	if err != nil {
 		// there are cases when pod is created even if there is an error with the pod def; for e.g. incorrect image
-		if podMap, _ := o.podmanClient.PodLs(); podMap[pod.Name] {
+		if podMap, _ := o.podmanClient.PodLs(odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, true)); podMap[pod.Name] {
 			o.deployedPod = &corev1.Pod{}
 			o.deployedPod.SetName(pod.Name)
 		}
 		return nil, nil, err
 	}
 
+	o.deployedResources = resources
 	spinner.End(true)
 	return pod, fwPorts, nil
 }
+
+// removePod stops and deletes the pod, keeping its volumes
+func (o *DevClient) removePod(name string) error {
+	err := o.podmanClient.PodStop(name)
+	if err != nil {
+		return err
+	}
+	err = o.podmanClient.PodRm(name)
+	if err != nil {
+		return err
+	}
+	if o.deployedPod != nil && o.deployedPod.GetName() == name {
+		reportContainersStatus(o.deployedPod, "removed")
+	}
+	return nil
+}
+
+// reportContainersStatus emits a machine-readable event with the same status for all the containers of the pod.
+// The containers are identified by the name given to them by the platform.
+func reportContainersStatus(pod *corev1.Pod, status string) {
+	statuses := make([]machineoutput.ContainerStatusEntry, 0, len(pod.Spec.Containers))
+	for _, container := range pod.Spec.Containers {
+		statuses = append(statuses, machineoutput.ContainerStatusEntry{
+			ID:     pod.GetName() + "-" + container.Name,
+			Status: status,
+		})
+	}
+	machineoutput.NewMachineEventLoggingClient().ContainerStatus(statuses, machineoutput.TimestampNow())
+}
+
+// getUnusedVolumes returns the names of the volumes used by oldPod and not used anymore by newPod
+func getUnusedVolumes(oldPod, newPod *corev1.Pod) []string {
+	used := map[string]bool{}
+	for _, volume := range newPod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil {
+			used[volume.PersistentVolumeClaim.ClaimName] = true
+		}
+	}
+	var result []string
+	for _, volume := range oldPod.Spec.Volumes {
+		if volume.PersistentVolumeClaim != nil && !used[volume.PersistentVolumeClaim.ClaimName] {
+			result = append(result, volume.PersistentVolumeClaim.ClaimName)
+		}
+	}
+	return result
+}
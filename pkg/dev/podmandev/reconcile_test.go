@@ -0,0 +1,55 @@
+package podmandev
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func Test_getUnusedVolumes(t *testing.T) {
+	withVolume := func(pod *corev1.Pod, name string) *corev1.Pod {
+		pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: name + "-mycmp-app",
+				},
+			},
+		})
+		return pod
+	}
+
+	tests := []struct {
+		name   string
+		oldPod *corev1.Pod
+		newPod *corev1.Pod
+		want   []string
+	}{
+		{
+			name:   "same volumes",
+			oldPod: withVolume(basePod.DeepCopy(), "myvolume"),
+			newPod: withVolume(basePod.DeepCopy(), "myvolume"),
+		},
+		{
+			name:   "volume added",
+			oldPod: basePod.DeepCopy(),
+			newPod: withVolume(basePod.DeepCopy(), "myvolume"),
+		},
+		{
+			name:   "volume removed",
+			oldPod: withVolume(withVolume(basePod.DeepCopy(), "myvolume"), "othervolume"),
+			newPod: withVolume(basePod.DeepCopy(), "othervolume"),
+			want:   []string{"myvolume-mycmp-app"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getUnusedVolumes(tt.oldPod, tt.newPod)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getUnusedVolumes() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
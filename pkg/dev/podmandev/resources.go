@@ -0,0 +1,157 @@
+package podmandev
+
+import (
+	"context"
+	"path/filepath"
+
+	devfilefs "github.com/devfile/library/v2/pkg/testingutil/filesystem"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/devfile"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/storage"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+const (
+	kindConfigMap             = "ConfigMap"
+	kindSecret                = "Secret"
+	kindPersistentVolumeClaim = "PersistentVolumeClaim"
+	kindService               = "Service"
+	kindPod                   = "Pod"
+	kindDeployment            = "Deployment"
+)
+
+// getKubernetesResources returns the resources defined in the standalone Kubernetes components of the Devfile
+// which can be created on the platform, labelled as part of the component in Dev mode.
+// A warning is displayed for the resources of other kinds, which are skipped.
+func (o *DevClient) getKubernetesResources(ctx context.Context) ([]unstructured.Unstructured, error) {
+	var (
+		appName       = odocontext.GetApplication(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+		devfileObj    = odocontext.GetDevfileObj(ctx)
+		devfilePath   = odocontext.GetDevfilePath(ctx)
+		path          = filepath.Dir(devfilePath)
+	)
+
+	k8sComponents, err := devfile.GetKubernetesComponentsToPush(*devfileObj, false)
+	if err != nil {
+		return nil, err
+	}
+
+	runtime := component.GetComponentRuntimeFromDevfileMetadata(devfileObj.Data.GetMetadata())
+	labels := odolabels.GetLabels(componentName, appName, runtime, odolabels.ComponentDevMode, false)
+
+	var result []unstructured.Unstructured
+	for _, comp := range k8sComponents {
+		uList, err := libdevfile.GetK8sComponentAsUnstructuredList(*devfileObj, comp.Name, path, devfilefs.DefaultFs{})
+		if err != nil {
+			return nil, err
+		}
+		for _, u := range uList {
+			kind := u.GetKind()
+			if kind == kindService {
+				// The endpoints of the component are already forwarded by odo
+				log.Warningf("Kubernetes Services are not created on %s, the endpoints of the component are forwarded by odo. Skipping: %s (component %s).", o.platformName(), u.GetName(), comp.Name)
+				continue
+			}
+			// Docker has no equivalent of the resources supported by Podman,
+			// and the containers of the component run in the pod created by odo, not in other workloads
+			if o.platform == commonflags.PlatformDocker || !podman.SupportedKinds[kind] || kind == kindPod || kind == kindDeployment {
+				log.Warningf("Kubernetes resources of kind %q are not supported on %s. Skipping: %s (component %s).", kind, o.platformName(), u.GetName(), comp.Name)
+				continue
+			}
+			resourceLabels := u.GetLabels()
+			if resourceLabels == nil {
+				resourceLabels = map[string]string{}
+			}
+			for k, v := range labels {
+				resourceLabels[k] = v
+			}
+			u.SetLabels(resourceLabels)
+			result = append(result, u)
+		}
+	}
+	return result, nil
+}
+
+// useKubernetesVolumes makes the volumes of the pod having the name of a ConfigMap, Secret or PersistentVolumeClaim
+// defined in the resources use this resource, instead of a volume dedicated to the component
+func useKubernetesVolumes(pod *corev1.Pod, resources []unstructured.Unstructured) {
+	kinds := make(map[string]string, len(resources))
+	for _, resource := range resources {
+		kinds[resource.GetName()] = resource.GetKind()
+	}
+	for i := range pod.Spec.Volumes {
+		volume := &pod.Spec.Volumes[i]
+		var source corev1.VolumeSource
+		switch kinds[volume.Name] {
+		case kindConfigMap:
+			source.ConfigMap = &corev1.ConfigMapVolumeSource{
+				LocalObjectReference: corev1.LocalObjectReference{Name: volume.Name},
+			}
+		case kindSecret:
+			source.Secret = &corev1.SecretVolumeSource{
+				SecretName: volume.Name,
+			}
+		case kindPersistentVolumeClaim:
+			source.PersistentVolumeClaim = &corev1.PersistentVolumeClaimVolumeSource{
+				ClaimName: volume.Name,
+			}
+		default:
+			continue
+		}
+		klog.V(4).Infof("volume %q uses the %s defined in Kubernetes components", volume.Name, kinds[volume.Name])
+		volume.VolumeSource = source
+	}
+}
+
+// getResourcesToPlay returns the resources to create alongside the pod.
+// PersistentVolumeClaims whose volume already exists are excluded, the existing volume being used by the pod.
+func getResourcesToPlay(resources []unstructured.Unstructured, existingVolumes map[string]bool) []unstructured.Unstructured {
+	var result []unstructured.Unstructured
+	for _, resource := range resources {
+		if resource.GetKind() == kindPersistentVolumeClaim && existingVolumes[resource.GetName()] {
+			klog.V(4).Infof("volume %q already exists", resource.GetName())
+			continue
+		}
+		result = append(result, resource)
+	}
+	return result
+}
+
+// getVolumeResources returns the PersistentVolumeClaims of the volumes of the pod owned by the component,
+// so that the volumes are created labelled as part of the component
+func getVolumeResources(pod *corev1.Pod, componentName string, appName string) ([]unstructured.Unstructured, error) {
+	var result []unstructured.Unstructured
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil || volume.PersistentVolumeClaim.ClaimName != getVolumeName(volume.Name, componentName, appName) {
+			continue
+		}
+		labels := make(map[string]string, len(pod.GetLabels()))
+		for k, v := range pod.GetLabels() {
+			labels[k] = v
+		}
+		odolabels.AddStorageInfo(labels, volume.Name, volume.Name == storage.OdoSourceVolume)
+
+		u := unstructured.Unstructured{}
+		u.SetAPIVersion("v1")
+		u.SetKind(kindPersistentVolumeClaim)
+		u.SetName(volume.PersistentVolumeClaim.ClaimName)
+		u.SetLabels(labels)
+		err := unstructured.SetNestedStringSlice(u.Object, []string{string(corev1.ReadWriteOnce)}, "spec", "accessModes")
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, u)
+	}
+	return result, nil
+}
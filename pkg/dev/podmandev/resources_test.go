@@ -0,0 +1,162 @@
+package podmandev
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func newResource(kind string, name string) unstructured.Unstructured {
+	u := unstructured.Unstructured{}
+	u.SetKind(kind)
+	u.SetName(name)
+	return u
+}
+
+func Test_useKubernetesVolumes(t *testing.T) {
+	odoVolume := func(name string) corev1.Volume {
+		return corev1.Volume{
+			Name: name,
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: name + "-mycmp-app",
+				},
+			},
+		}
+	}
+
+	pod := basePod.DeepCopy()
+	pod.Spec.Volumes = []corev1.Volume{
+		odoVolume("myvolume"),
+		odoVolume("myconfig"),
+		odoVolume("mysecret"),
+		odoVolume("myclaim"),
+	}
+	resources := []unstructured.Unstructured{
+		newResource("ConfigMap", "myconfig"),
+		newResource("Secret", "mysecret"),
+		newResource("PersistentVolumeClaim", "myclaim"),
+		newResource("ConfigMap", "unused"),
+	}
+
+	useKubernetesVolumes(pod, resources)
+
+	want := []corev1.Volume{
+		odoVolume("myvolume"),
+		{
+			Name: "myconfig",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "myconfig"},
+				},
+			},
+		},
+		{
+			Name: "mysecret",
+			VolumeSource: corev1.VolumeSource{
+				Secret: &corev1.SecretVolumeSource{
+					SecretName: "mysecret",
+				},
+			},
+		},
+		{
+			Name: "myclaim",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: "myclaim",
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, pod.Spec.Volumes); diff != "" {
+		t.Errorf("useKubernetesVolumes() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getResourcesToPlay(t *testing.T) {
+	resources := []unstructured.Unstructured{
+		newResource("ConfigMap", "myconfig"),
+		newResource("PersistentVolumeClaim", "existing"),
+		newResource("PersistentVolumeClaim", "myclaim"),
+	}
+	existingVolumes := map[string]bool{
+		"existing": true,
+		"myconfig": true,
+	}
+
+	got := getResourcesToPlay(resources, existingVolumes)
+
+	want := []unstructured.Unstructured{
+		newResource("ConfigMap", "myconfig"),
+		newResource("PersistentVolumeClaim", "myclaim"),
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getResourcesToPlay() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getVolumeResources(t *testing.T) {
+	pod := basePod.DeepCopy()
+	pod.SetLabels(map[string]string{
+		"app.kubernetes.io/instance": "mycmp",
+	})
+	pod.Spec.Volumes = []corev1.Volume{
+		{
+			Name: "odo-projects",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: "odo-projects-mycmp-app",
+				},
+			},
+		},
+		{
+			Name: "myclaim",
+			VolumeSource: corev1.VolumeSource{
+				PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+					ClaimName: "myclaim",
+				},
+			},
+		},
+		{
+			Name: "myconfig",
+			VolumeSource: corev1.VolumeSource{
+				ConfigMap: &corev1.ConfigMapVolumeSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "myconfig"},
+				},
+			},
+		},
+	}
+
+	got, err := getVolumeResources(pod, "mycmp", "app")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []unstructured.Unstructured{
+		{
+			Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "PersistentVolumeClaim",
+				"metadata": map[string]interface{}{
+					"name": "odo-projects-mycmp-app",
+					"labels": map[string]interface{}{
+						"app.kubernetes.io/instance":     "mycmp",
+						"app.kubernetes.io/storage-name": "odo-projects",
+						"component":                      "mycmp",
+						"odo-source-pvc":                 "odo-projects",
+						"storage-name":                   "odo-projects",
+					},
+				},
+				"spec": map[string]interface{}{
+					"accessModes": []interface{}{"ReadWriteOnce"},
+				},
+			},
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getVolumeResources() mismatch (-want +got):\n%s", diff)
+	}
+}
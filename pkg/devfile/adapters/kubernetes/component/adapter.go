@@ -69,6 +69,8 @@ type AdapterContext struct {
 	AppName       string                // the application name associated to a component
 	Devfile       parser.DevfileObj     // Devfile is the object returned by the Devfile parser
 	FS            filesystem.Filesystem // FS is the object used for building image component if present
+	// RunSupervisor restarts the run command when it crashes, it is nil if the run command is not restarted
+	RunSupervisor *component.RunSupervisor
 }
 
 var _ ComponentAdapter = (*Adapter)(nil)
@@ -99,6 +101,10 @@ func NewKubernetesAdapter(
 // Once the component has started, it will sync the source code to it.
 // The componentStatus will be modified to reflect the status of the component when the function returns
 func (a Adapter) Push(ctx context.Context, parameters adapters.PushParameters, componentStatus *watch.ComponentStatus) (err error) {
+	a.logger.ReconcileStart(machineoutput.TimestampNow())
+	defer func() {
+		a.logger.ReconcileComplete(machineoutput.TimestampNow(), err)
+	}()
 
 	// preliminary checks
 	err = dfutil.ValidateK8sResourceName("component name", a.ComponentName)
@@ -252,6 +258,7 @@ func (a Adapter) Push(ctx context.Context, parameters adapters.PushParameters, c
 		return fmt.Errorf("failed to sync to component with name %s: %w", a.ComponentName, err)
 	}
 	s.End(true)
+	a.RunSupervisor.Reset()
 
 	// PostStart events from the devfile will only be executed when the component
 	// didn't previously exist
@@ -291,6 +298,7 @@ func (a Adapter) Push(ctx context.Context, parameters adapters.PushParameters, c
 		devfile:       a.Devfile,
 		path:          parameters.Path,
 		podName:       pod.GetName(),
+		supervisor:    a.RunSupervisor,
 		ctx:           ctx,
 	}
 
@@ -342,7 +350,8 @@ func (a Adapter) Push(ctx context.Context, parameters adapters.PushParameters, c
 		a.portForwardClient.StopPortForwarding()
 	}
 
-	err = a.portForwardClient.StartPortForwarding(a.Devfile, a.ComponentName, parameters.Debug, parameters.RandomPorts, parameters.ErrOut)
+	err = a.portForwardClient.StartPortForwarding(a.Devfile, a.ComponentName, parameters.Debug, parameters.RandomPorts,
+		parameters.CustomForwardedPorts, parameters.CustomAddress, parameters.ErrOut)
 	if err != nil {
 		return adapters.NewErrPortForward(err)
 	}
@@ -352,6 +361,40 @@ func (a Adapter) Push(ctx context.Context, parameters adapters.PushParameters, c
 	return nil
 }
 
+// RerunCommand stops the run and debug commands if they are running, and executes again the run command,
+// or the debug command if parameters.Debug is true, without syncing the files
+func (a Adapter) RerunCommand(ctx context.Context, parameters adapters.PushParameters) error {
+	pod, err := a.kubeClient.GetPodUsingComponentName(a.ComponentName)
+	if err != nil {
+		return fmt.Errorf("unable to get pod for component %s: %w", a.ComponentName, err)
+	}
+
+	err = component.StopRunCommands(a.execClient, a.Devfile, pod.GetName(), parameters.DevfileRunCmd, parameters.DevfileDebugCmd, a.RunSupervisor)
+	if err != nil {
+		return err
+	}
+
+	cmdKind := devfilev1.RunCommandGroupKind
+	cmdName := parameters.DevfileRunCmd
+	if parameters.Debug {
+		cmdKind = devfilev1.DebugCommandGroupKind
+		cmdName = parameters.DevfileDebugCmd
+	}
+	cmdHandler := runHandler{
+		fs:            a.FS,
+		execClient:    a.execClient,
+		kubeClient:    a.kubeClient,
+		appName:       a.AppName,
+		componentName: a.ComponentName,
+		devfile:       a.Devfile,
+		path:          parameters.Path,
+		podName:       pod.GetName(),
+		supervisor:    a.RunSupervisor,
+		ctx:           ctx,
+	}
+	return libdevfile.ExecuteCommandByNameAndKind(a.Devfile, cmdName, cmdKind, &cmdHandler, false)
+}
+
 // createOrUpdateComponent creates the deployment or updates it if it already exists
 // with the expected spec.
 // Returns the new deployment and if the generation of the deployment has been updated
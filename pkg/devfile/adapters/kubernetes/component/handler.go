@@ -25,6 +25,7 @@ type runHandler struct {
 	path            string
 	componentExists bool
 	podName         string
+	supervisor      *component.RunSupervisor
 
 	ctx context.Context
 }
@@ -40,7 +41,7 @@ func (a *runHandler) ApplyKubernetes(kubernetes devfilev1.Component) error {
 }
 
 func (a *runHandler) Execute(devfileCmd devfilev1.Command) error {
-	return component.ExecuteRunCommand(a.execClient, a.kubeClient, devfileCmd, a.componentExists, a.podName, a.appName, a.componentName)
+	return component.ExecuteRunCommand(a.execClient, a.kubeClient, devfileCmd, a.componentExists, a.podName, a.appName, a.componentName, a.supervisor)
 
 }
 
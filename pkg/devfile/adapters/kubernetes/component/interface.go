@@ -10,4 +10,5 @@ import (
 // ComponentAdapter defines the functions that platform-specific adapters must implement
 type ComponentAdapter interface {
 	Push(ctx context.Context, parameters adapters.PushParameters, componentStatus *watch.ComponentStatus) error
+	RerunCommand(ctx context.Context, parameters adapters.PushParameters) error
 }
@@ -4,6 +4,7 @@ package image
 import (
 	"context"
 	"errors"
+	"fmt"
 	"os/exec"
 	"path/filepath"
 
@@ -13,6 +14,8 @@ import (
 	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
@@ -99,9 +102,18 @@ func buildPushImage(backend Backend, fs filesystem.Filesystem, image *devfile.Im
 
 // selectBackend selects the container backend to use for building and pushing images
 // It will detect podman and docker CLIs (in this order),
-// or return an error if none are present locally
+// or return an error if none are present locally.
+// The docker CLI is always used when running on the Docker platform, so that the images are available to the Docker daemon.
 func selectBackend(ctx context.Context) (Backend, error) {
 
+	if fcontext.GetPlatform(ctx, "") == commonflags.PlatformDocker {
+		dockerCmd := envcontext.GetEnvConfig(ctx).DockerCmd
+		if _, err := lookPathCmd(dockerCmd); err != nil {
+			return nil, fmt.Errorf("executable %q not found, it is required to build images on the Docker platform", dockerCmd)
+		}
+		return NewDockerCompatibleBackend(dockerCmd), nil
+	}
+
 	podmanCmd := envcontext.GetEnvConfig(ctx).PodmanCmd
 	if _, err := lookPathCmd(podmanCmd); err == nil {
 
@@ -11,6 +11,7 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/config"
 	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
@@ -123,6 +124,7 @@ func TestSelectBackend(t *testing.T) {
 	tests := []struct {
 		name        string
 		envConfig   config.Configuration
+		platform    string
 		lookPathCmd func(string) (string, error)
 		wantType    string
 		wantErr     bool
@@ -210,6 +212,34 @@ func TestSelectBackend(t *testing.T) {
 			wantErr:  false,
 			wantType: "docker",
 		},
+		{
+			name: "docker on the docker platform, even if podman is present",
+			envConfig: config.Configuration{
+				DockerCmd: "docker",
+				PodmanCmd: "podman",
+			},
+			platform: "docker",
+			lookPathCmd: func(string) (string, error) {
+				return "", nil
+			},
+			wantErr:  false,
+			wantType: "docker",
+		},
+		{
+			name: "docker not present on the docker platform",
+			envConfig: config.Configuration{
+				DockerCmd: "docker",
+				PodmanCmd: "podman",
+			},
+			platform: "docker",
+			lookPathCmd: func(name string) (string, error) {
+				if name == "podman" {
+					return "podman", nil
+				}
+				return "", errors.New("")
+			},
+			wantErr: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -218,6 +248,9 @@ func TestSelectBackend(t *testing.T) {
 			defer func() { lookPathCmd = exec.LookPath }()
 			ctx := context.Background()
 			ctx = envcontext.WithEnvConfig(ctx, tt.envConfig)
+			if tt.platform != "" {
+				ctx = fcontext.WithPlatform(ctx, tt.platform)
+			}
 			backend, err := selectBackend(ctx)
 			if tt.wantErr != (err != nil) {
 				t.Errorf("%s: Error result wanted %v, got %v", tt.name, tt.wantErr, err != nil)
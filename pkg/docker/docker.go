@@ -0,0 +1,272 @@
+package docker
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os/exec"
+
+	envcontext "github.com/redhat-developer/odo/pkg/config/context"
+	"github.com/redhat-developer/odo/pkg/podman"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/klog"
+)
+
+type DockerCli struct {
+	dockerCmd string
+}
+
+var _ Client = (*DockerCli)(nil)
+
+// NewDockerCli returns a new docker client, or nil if the docker command is not accessible in the system
+// or if the Docker daemon is not reachable
+func NewDockerCli(ctx context.Context) (*DockerCli, error) {
+	cli := &DockerCli{
+		dockerCmd: envcontext.GetEnvConfig(ctx).DockerCmd,
+	}
+	version, err := cli.Version()
+	if err != nil {
+		return nil, fmt.Errorf("executable %q not found or docker daemon not reachable: %w", cli.dockerCmd, err)
+	}
+	if version.Client == nil {
+		return nil, fmt.Errorf("executable %q not recognized as docker client", cli.dockerCmd)
+	}
+	return cli, nil
+}
+
+// run executes the docker command with the given arguments and returns its standard output
+func (o *DockerCli) run(args ...string) ([]byte, error) {
+	cmd := exec.Command(o.dockerCmd, args...)
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+		return nil, err
+	}
+	return out, nil
+}
+
+// versionReport contains the result of the `docker version --format {{json .}}` command
+type versionReport struct {
+	Client *struct {
+		Version    string
+		APIVersion string `json:"ApiVersion"`
+		GoVersion  string
+		GitCommit  string
+		BuildTime  string
+		Os         string
+		Arch       string
+	}
+}
+
+func (o *DockerCli) Version() (podman.SystemVersionReport, error) {
+	out, err := o.run("version", "--format", "{{json .}}")
+	if err != nil {
+		return podman.SystemVersionReport{}, err
+	}
+	var report versionReport
+	err = json.Unmarshal(out, &report)
+	if err != nil {
+		return podman.SystemVersionReport{}, err
+	}
+	if report.Client == nil {
+		return podman.SystemVersionReport{}, nil
+	}
+	return podman.SystemVersionReport{
+		Client: &podman.Version{
+			APIVersion: report.Client.APIVersion,
+			Version:    report.Client.Version,
+			GoVersion:  report.Client.GoVersion,
+			GitCommit:  report.Client.GitCommit,
+			BuiltTime:  report.Client.BuildTime,
+			OsArch:     report.Client.Os + "/" + report.Client.Arch,
+			Os:         report.Client.Os,
+		},
+	}, nil
+}
+
+// PlayKubeResources is not supported on Docker, only pods created with PlayKube can be run
+func (o *DockerCli) PlayKubeResources(resources []unstructured.Unstructured) error {
+	return errors.New("creating Kubernetes resources is not supported on Docker")
+}
+
+func (o *DockerCli) PodStop(podname string) error {
+	ids, err := o.getPodContainerIDs(podname)
+	if err != nil {
+		return err
+	}
+	out, err := o.run(append([]string{"stop"}, ids...)...)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Stopped containers of pod %s: %s", podname, string(out))
+	return nil
+}
+
+func (o *DockerCli) PodRm(podname string) error {
+	ids, err := o.getPodContainerIDs(podname)
+	if err != nil {
+		return err
+	}
+	// --volumes removes the anonymous volumes of the containers, the named volumes are kept
+	out, err := o.run(append([]string{"rm", "--force", "--volumes"}, ids...)...)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Deleted containers of pod %s: %s", podname, string(out))
+
+	// The volumes created for the emptyDir volumes of the pod are deleted with the pod
+	volumes, err := o.VolumeLs(podLabel + "=" + podname)
+	if err != nil {
+		return err
+	}
+	for volume := range volumes {
+		err = o.VolumeRm(volume)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getPodContainerIDs returns the IDs of all the containers of the pod, including its infra container
+func (o *DockerCli) getPodContainerIDs(podname string) ([]string, error) {
+	containers, err := o.listContainers([]string{podLabel + "=" + podname})
+	if err != nil {
+		return nil, err
+	}
+	if len(containers) == 0 {
+		return nil, fmt.Errorf("no pod with name %q found", podname)
+	}
+	ids := make([]string, 0, len(containers))
+	for _, container := range containers {
+		ids = append(ids, container.ID)
+	}
+	return ids, nil
+}
+
+func (o *DockerCli) PodLs(selector string) (map[string]bool, error) {
+	args := []string{"ps", "--all", "--filter", "label=" + containerLabel + "=" + infraContainerName}
+	filters, err := podman.LabelFilters(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range filters {
+		args = append(args, "--filter", "label="+label)
+	}
+	out, err := o.run(append(args, "--format", fmt.Sprintf("{{.Label %q}}", podLabel))...)
+	if err != nil {
+		return nil, err
+	}
+	return podman.SplitLinesAsSet(string(out)), nil
+}
+
+func (o *DockerCli) VolumeRm(volumeName string) error {
+	out, err := o.run("volume", "rm", volumeName)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Deleted volume %s", string(out))
+	return nil
+}
+
+func (o *DockerCli) VolumeInspect(volumeName string) (podman.VolumeInspectData, error) {
+	out, err := o.run("volume", "inspect", volumeName)
+	if err != nil {
+		return podman.VolumeInspectData{}, err
+	}
+	var result []podman.VolumeInspectData
+	err = json.Unmarshal(out, &result)
+	if err != nil {
+		return podman.VolumeInspectData{}, err
+	}
+	if len(result) == 0 {
+		return podman.VolumeInspectData{}, fmt.Errorf("no volume with name %q found", volumeName)
+	}
+	return result[0], nil
+}
+
+func (o *DockerCli) VolumeLs(selector string) (map[string]bool, error) {
+	args := []string{"volume", "ls"}
+	filters, err := podman.LabelFilters(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range filters {
+		args = append(args, "--filter", "label="+label)
+	}
+	out, err := o.run(append(args, "--format", "{{.Name}}")...)
+	if err != nil {
+		return nil, err
+	}
+	return podman.SplitLinesAsSet(string(out)), nil
+}
+
+// SecretLs returns no secret, as secrets are never created for a pod on Docker
+func (o *DockerCli) SecretLs() (map[string]bool, error) {
+	return map[string]bool{}, nil
+}
+
+// SecretRm is not supported on Docker, as secrets are never created for a pod
+func (o *DockerCli) SecretRm(secretName string) error {
+	return errors.New("secrets are not supported on Docker")
+}
+
+func (o *DockerCli) CleanupPodResources(pod *corev1.Pod) error {
+	err := o.PodStop(pod.GetName())
+	if err != nil {
+		return err
+	}
+	err = o.PodRm(pod.GetName())
+	if err != nil {
+		return err
+	}
+
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil {
+			continue
+		}
+		volumeName := volume.PersistentVolumeClaim.ClaimName
+		klog.V(3).Infof("deleting docker volume %q", volumeName)
+		err = o.VolumeRm(volumeName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *DockerCli) ExecCMDInContainer(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+	options := []string{}
+	if tty {
+		options = append(options, "--tty")
+	}
+
+	name := getContainerName(podName, containerName)
+
+	args := []string{"exec", "--interactive"}
+	args = append(args, options...)
+	args = append(args, name)
+	args = append(args, cmd...)
+
+	command := exec.Command(o.dockerCmd, args...)
+	klog.V(3).Infof("executing %v", command.Args)
+	command.Stdin = stdin
+	command.Stdout = stdout
+	command.Stderr = stderr
+
+	return command.Run()
+}
+
+// ExecInteractiveCMDInContainer executes the command in the container of a pod, in a TTY attached to stdin and stdout.
+// The docker command being attached to the terminal of odo, it resizes the TTY itself, and sizeQueue is not used.
+func (o *DockerCli) ExecInteractiveCMDInContainer(containerName, podName string, cmd []string, stdin io.Reader, stdout io.Writer, sizeQueue remotecommand.TerminalSizeQueue) error {
+	return o.ExecCMDInContainer(containerName, podName, cmd, stdout, nil, stdin, true)
+}
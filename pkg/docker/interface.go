@@ -0,0 +1,13 @@
+package docker
+
+import (
+	"github.com/redhat-developer/odo/pkg/podman"
+)
+
+// Client is the interface of the Docker client.
+// It provides the same operations as the Podman client, so that the components running locally
+// can be managed the same way on Docker and on Podman: a pod is run on Docker as a set of containers
+// sharing the network namespace of an infra container.
+type Client interface {
+	podman.Client
+}
@@ -0,0 +1,85 @@
+package docker
+
+import (
+	"fmt"
+	"io"
+	"os/exec"
+	"sync"
+
+	"k8s.io/klog"
+)
+
+// GetPodLogs returns the logs of the specified pod container.
+// All logs for all containers part of the pod are returned if an empty string is provided as container name.
+func (o *DockerCli) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+	var containers []string
+	if containerName != "" {
+		containers = []string{getContainerName(podName, containerName)}
+	} else {
+		inspect, err := o.listContainers([]string{podLabel + "=" + podName})
+		if err != nil {
+			return nil, err
+		}
+		pods := groupByPod(inspect)
+		if len(pods) == 0 {
+			return nil, fmt.Errorf("no pod with name %q found", podName)
+		}
+		for _, container := range pods[0].containers {
+			containers = append(containers, getContainerName(podName, container.Config.Labels[containerLabel]))
+		}
+	}
+
+	// The logs of all the containers are written to the same pipe, which is closed when all the commands terminated
+	pr, pw := io.Pipe()
+	result := &logsReadCloser{PipeReader: pr}
+	var wg sync.WaitGroup
+	for _, container := range containers {
+		args := []string{"logs"}
+		if followLog {
+			args = append(args, "--follow")
+		}
+		args = append(args, container)
+		cmd := exec.Command(o.dockerCmd, args...)
+		klog.V(3).Infof("executing %v", cmd.Args)
+		// docker logs outputs the logs in stdout and stderr, depending on the stream they were written to by the container
+		cmd.Stdout = pw
+		cmd.Stderr = pw
+		if err := cmd.Start(); err != nil {
+			result.kill()
+			return nil, err
+		}
+		result.cmds = append(result.cmds, cmd)
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if err := cmd.Wait(); err != nil {
+				klog.V(4).Infof("command %v terminated: %v", cmd.Args, err)
+			}
+		}()
+	}
+	go func() {
+		wg.Wait()
+		pw.Close()
+	}()
+	return result, nil
+}
+
+// logsReadCloser reads the output of the commands getting the logs of the containers,
+// and terminates the commands when closed
+type logsReadCloser struct {
+	*io.PipeReader
+	cmds []*exec.Cmd
+}
+
+func (o *logsReadCloser) Close() error {
+	o.kill()
+	return o.PipeReader.Close()
+}
+
+func (o *logsReadCloser) kill() {
+	for _, cmd := range o.cmds {
+		if cmd.Process != nil {
+			_ = cmd.Process.Kill()
+		}
+	}
+}
@@ -0,0 +1,299 @@
+package docker
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+const (
+	// podLabel is set on all the containers of a pod, with the name of the pod as value
+	podLabel = "odo.dev/docker-pod"
+	// containerLabel is set on all the containers of a pod, with the name of the container in the pod as value
+	containerLabel = "odo.dev/docker-container"
+
+	// infraContainerName is the name of the container holding the network namespace shared by the containers of a pod,
+	// and publishing the ports of the pod
+	infraContainerName = "infra"
+	// infraImage is the image of the infra container, doing nothing but waiting to be stopped
+	infraImage = "registry.k8s.io/pause:3.9"
+)
+
+// getContainerName returns the name of the Docker container running the container of the pod,
+// following the naming of the containers created by Podman for a pod
+func getContainerName(podName string, containerName string) string {
+	return podName + "-" + containerName
+}
+
+// PlayKube runs the pod as a set of containers sharing the network namespace of an infra container.
+// The PersistentVolumeClaim resources are created as named volumes with their labels,
+// and the other persistent volumes of the pod as named volumes without labels, if they do not exist yet.
+// The emptyDir volumes are created empty as named volumes labelled with the name of the pod, and are deleted with the pod.
+// Creating other kinds of resources alongside the pod is not supported on Docker.
+func (o *DockerCli) PlayKube(pod *corev1.Pod, resources []unstructured.Unstructured) error {
+	for _, resource := range resources {
+		if resource.GetKind() != "PersistentVolumeClaim" {
+			return fmt.Errorf("creating Kubernetes resources of kind %q is not supported on Docker", resource.GetKind())
+		}
+		_, err := o.run(getVolumeCreateArgs(resource.GetName(), resource.GetLabels())...)
+		if err != nil {
+			return err
+		}
+	}
+
+	volumes := getVolumes(pod)
+	for _, volume := range pod.Spec.Volumes {
+		volumeName, found := volumes[volume.Name]
+		if !found {
+			continue
+		}
+		if volume.EmptyDir != nil {
+			// A volume left by a pod which has not been deleted is removed, so that the volume starts empty
+			_, err := o.run("volume", "rm", "--force", volumeName)
+			if err != nil {
+				return err
+			}
+			_, err = o.run(getVolumeCreateArgs(volumeName, map[string]string{podLabel: pod.GetName()})...)
+			if err != nil {
+				return err
+			}
+			continue
+		}
+		_, err := o.run("volume", "create", volumeName)
+		if err != nil {
+			return err
+		}
+	}
+
+	_, err := o.run(getInfraRunArgs(pod)...)
+	if err != nil {
+		return err
+	}
+
+	// Init containers are run one after the other, and must terminate before the containers are started
+	for _, container := range pod.Spec.InitContainers {
+		_, err = o.run(getContainerRunArgs(pod, container, volumes, false)...)
+		if err != nil {
+			return fmt.Errorf("init container %q failed: %w", container.Name, err)
+		}
+	}
+
+	for _, container := range pod.Spec.Containers {
+		_, err = o.run(getContainerRunArgs(pod, container, volumes, true)...)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getVolumes returns the names of the Docker volumes to mount for each volume of the pod.
+// The name of the claim is used for persistent volumes, and a name built from the pod name for the other ones.
+func getVolumes(pod *corev1.Pod) map[string]string {
+	result := map[string]string{}
+	for _, volume := range pod.Spec.Volumes {
+		switch {
+		case volume.PersistentVolumeClaim != nil:
+			result[volume.Name] = volume.PersistentVolumeClaim.ClaimName
+		case volume.EmptyDir != nil:
+			result[volume.Name] = getContainerName(pod.GetName(), volume.Name)
+		default:
+			klog.V(2).Infof("volume %q of pod %q is not supported on Docker and is ignored", volume.Name, pod.GetName())
+		}
+	}
+	return result
+}
+
+// getVolumeCreateArgs returns the arguments to create a named volume with the labels
+func getVolumeCreateArgs(name string, labels map[string]string) []string {
+	args := []string{"volume", "create"}
+	for _, k := range sortedKeys(labels) {
+		args = append(args, "--label", k+"="+labels[k])
+	}
+	return append(args, name)
+}
+
+// sortedKeys returns the keys of the map, sorted
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// getLabelArgs returns the arguments to label a container of the pod
+func getLabelArgs(pod *corev1.Pod, containerName string) []string {
+	podLabels := pod.GetLabels()
+	var args []string
+	for _, k := range sortedKeys(podLabels) {
+		args = append(args, "--label", k+"="+podLabels[k])
+	}
+	args = append(args,
+		"--label", podLabel+"="+pod.GetName(),
+		"--label", containerLabel+"="+containerName,
+	)
+	return args
+}
+
+// getInfraRunArgs returns the arguments to run the infra container of the pod, publishing the host ports of the pod
+func getInfraRunArgs(pod *corev1.Pod) []string {
+	args := []string{"run", "--detach",
+		"--name", getContainerName(pod.GetName(), infraContainerName),
+		"--hostname", pod.GetName(),
+	}
+	args = append(args, getLabelArgs(pod, infraContainerName)...)
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.HostPort == 0 {
+				continue
+			}
+			publish := fmt.Sprintf("%d:%d", port.HostPort, port.ContainerPort)
+			if port.HostIP != "" {
+				publish = port.HostIP + ":" + publish
+			}
+			if port.Protocol != "" {
+				publish += "/" + strings.ToLower(string(port.Protocol))
+			}
+			args = append(args, "--publish", publish)
+		}
+	}
+	return append(args, infraImage)
+}
+
+// getContainerRunArgs returns the arguments to run the container of the pod in the network namespace of the infra container,
+// with volumes indicating the Docker volume to mount for each volume of the pod.
+// A detached container runs in the background, otherwise the command waits for the container to terminate and removes it.
+func getContainerRunArgs(pod *corev1.Pod, container corev1.Container, volumes map[string]string, detach bool) []string {
+	args := []string{"run"}
+	if detach {
+		args = append(args, "--detach")
+	} else {
+		args = append(args, "--rm")
+	}
+	args = append(args,
+		"--name", getContainerName(pod.GetName(), container.Name),
+		"--network", "container:"+getContainerName(pod.GetName(), infraContainerName),
+	)
+	args = append(args, getLabelArgs(pod, container.Name)...)
+
+	for _, env := range container.Env {
+		if env.ValueFrom != nil {
+			klog.V(2).Infof("environment variable %q of container %q is defined from a source not supported on Docker and is ignored", env.Name, container.Name)
+			continue
+		}
+		args = append(args, "--env", env.Name+"="+env.Value)
+	}
+
+	for _, mount := range container.VolumeMounts {
+		volumeName, found := volumes[mount.Name]
+		if !found {
+			continue
+		}
+		if mount.SubPath != "" {
+			klog.V(2).Infof("subPath of volume mount %q of container %q is not supported on Docker and is ignored", mount.Name, container.Name)
+		}
+		volume := volumeName + ":" + mount.MountPath
+		if mount.ReadOnly {
+			volume += ":ro"
+		}
+		args = append(args, "--volume", volume)
+	}
+
+	if container.WorkingDir != "" {
+		args = append(args, "--workdir", container.WorkingDir)
+	}
+	if memory, found := container.Resources.Limits[corev1.ResourceMemory]; found {
+		args = append(args, "--memory", strconv.FormatInt(memory.Value(), 10))
+	}
+	if cpu, found := container.Resources.Limits[corev1.ResourceCPU]; found {
+		args = append(args, "--cpus", strconv.FormatFloat(float64(cpu.MilliValue())/1000, 'f', -1, 64))
+	}
+	switch container.ImagePullPolicy {
+	case corev1.PullNever:
+		args = append(args, "--pull", "never")
+	case corev1.PullAlways:
+		args = append(args, "--pull", "always")
+	case corev1.PullIfNotPresent:
+		args = append(args, "--pull", "missing")
+	}
+
+	// The command of a Kubernetes container replaces the entrypoint of the image, and its args replace the command of the image
+	var cmd []string
+	if len(container.Command) > 0 {
+		args = append(args, "--entrypoint", container.Command[0])
+		cmd = append(cmd, container.Command[1:]...)
+	}
+	cmd = append(cmd, container.Args...)
+
+	args = append(args, container.Image)
+	return append(args, cmd...)
+}
+
+// containerInspect contains the result of the `docker inspect` command for a container
+type containerInspect struct {
+	ID     string `json:"Id"`
+	Config struct {
+		Image  string
+		Labels map[string]string
+	}
+	State struct {
+		Status  string
+		Running bool
+	}
+	Mounts []struct {
+		Type        string
+		Name        string
+		Destination string
+	}
+}
+
+// listContainers returns the containers, running or not, having all the labels
+func (o *DockerCli) listContainers(labels []string) ([]containerInspect, error) {
+	args := []string{"ps", "--all", "--quiet", "--no-trunc"}
+	for _, label := range labels {
+		if label == "" {
+			continue
+		}
+		args = append(args, "--filter", "label="+label)
+	}
+	out, err := o.run(args...)
+	if err != nil {
+		return nil, err
+	}
+	ids := strings.Fields(string(out))
+	if len(ids) == 0 {
+		return nil, nil
+	}
+
+	out, err = o.run(append([]string{"inspect"}, ids...)...)
+	if err != nil {
+		return nil, err
+	}
+	var result []containerInspect
+	err = json.Unmarshal(out, &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// KubeGenerate returns a Kubernetes Pod definition of an existing pod, with its name, labels, containers and named volumes
+func (o *DockerCli) KubeGenerate(name string) (*corev1.Pod, error) {
+	containers, err := o.listContainers([]string{podLabel + "=" + name})
+	if err != nil {
+		return nil, err
+	}
+	pods := groupByPod(containers)
+	if len(pods) == 0 {
+		return nil, fmt.Errorf("no pod with name %q found", name)
+	}
+	return pods[0].toPod(), nil
+}
@@ -0,0 +1,342 @@
+package docker
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newPod() *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "mycmp-app",
+			Labels: map[string]string{
+				"component":                  "mycmp",
+				"app.kubernetes.io/instance": "mycmp",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{
+					Name: "odo-projects",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "odo-projects-mycmp-app",
+						},
+					},
+				},
+				{
+					Name: "tmp",
+					VolumeSource: corev1.VolumeSource{
+						EmptyDir: &corev1.EmptyDirVolumeSource{},
+					},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:  "runtime",
+					Image: "nodejs",
+					Ports: []corev1.ContainerPort{
+						{
+							Name:          "http",
+							ContainerPort: 3000,
+							HostPort:      20001,
+							HostIP:        "127.0.0.1",
+							Protocol:      corev1.ProtocolTCP,
+						},
+						{
+							Name:          "internal",
+							ContainerPort: 4000,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func Test_getVolumes(t *testing.T) {
+	got := getVolumes(newPod())
+	want := map[string]string{
+		"odo-projects": "odo-projects-mycmp-app",
+		"tmp":          "mycmp-app-tmp",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getVolumes() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getInfraRunArgs(t *testing.T) {
+	got := getInfraRunArgs(newPod())
+	want := []string{
+		"run", "--detach",
+		"--name", "mycmp-app-infra",
+		"--hostname", "mycmp-app",
+		"--label", "app.kubernetes.io/instance=mycmp",
+		"--label", "component=mycmp",
+		"--label", "odo.dev/docker-pod=mycmp-app",
+		"--label", "odo.dev/docker-container=infra",
+		"--publish", "127.0.0.1:20001:3000/tcp",
+		"registry.k8s.io/pause:3.9",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getInfraRunArgs() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getContainerRunArgs(t *testing.T) {
+	tests := []struct {
+		name      string
+		container corev1.Container
+		detach    bool
+		want      []string
+	}{
+		{
+			name: "detached container with command, args, env, volumes and limits",
+			container: corev1.Container{
+				Name:       "runtime",
+				Image:      "nodejs",
+				Command:    []string{"tail", "-f"},
+				Args:       []string{"/dev/null"},
+				WorkingDir: "/projects",
+				Env: []corev1.EnvVar{
+					{Name: "PROJECTS_ROOT", Value: "/projects"},
+					{Name: "FROM_SECRET", ValueFrom: &corev1.EnvVarSource{}},
+				},
+				VolumeMounts: []corev1.VolumeMount{
+					{Name: "odo-projects", MountPath: "/projects"},
+					{Name: "tmp", MountPath: "/tmp", ReadOnly: true},
+					{Name: "unknown", MountPath: "/unknown"},
+				},
+				Resources: corev1.ResourceRequirements{
+					Limits: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("1Gi"),
+						corev1.ResourceCPU:    resource.MustParse("500m"),
+					},
+				},
+				ImagePullPolicy: corev1.PullIfNotPresent,
+			},
+			detach: true,
+			want: []string{
+				"run", "--detach",
+				"--name", "mycmp-app-runtime",
+				"--network", "container:mycmp-app-infra",
+				"--label", "app.kubernetes.io/instance=mycmp",
+				"--label", "component=mycmp",
+				"--label", "odo.dev/docker-pod=mycmp-app",
+				"--label", "odo.dev/docker-container=runtime",
+				"--env", "PROJECTS_ROOT=/projects",
+				"--volume", "odo-projects-mycmp-app:/projects",
+				"--volume", "mycmp-app-tmp:/tmp:ro",
+				"--workdir", "/projects",
+				"--memory", "1073741824",
+				"--cpus", "0.5",
+				"--pull", "missing",
+				"--entrypoint", "tail",
+				"nodejs", "-f", "/dev/null",
+			},
+		},
+		{
+			name: "init container without command",
+			container: corev1.Container{
+				Name:  "init",
+				Image: "busybox",
+			},
+			detach: false,
+			want: []string{
+				"run", "--rm",
+				"--name", "mycmp-app-init",
+				"--network", "container:mycmp-app-infra",
+				"--label", "app.kubernetes.io/instance=mycmp",
+				"--label", "component=mycmp",
+				"--label", "odo.dev/docker-pod=mycmp-app",
+				"--label", "odo.dev/docker-container=init",
+				"busybox",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pod := newPod()
+			got := getContainerRunArgs(pod, tt.container, getVolumes(pod), tt.detach)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getContainerRunArgs() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_groupByPod(t *testing.T) {
+	newContainer := func(pod, container string, running bool) containerInspect {
+		var c containerInspect
+		c.ID = pod + "-" + container
+		c.Config.Image = "image-" + container
+		c.Config.Labels = map[string]string{
+			podLabel:                     pod,
+			containerLabel:               container,
+			"app.kubernetes.io/instance": pod,
+		}
+		c.State.Running = running
+		return c
+	}
+	runtime := newContainer("pod2", "runtime", true)
+	runtime.Mounts = append(runtime.Mounts, struct {
+		Type        string
+		Name        string
+		Destination string
+	}{Type: "volume", Name: "odo-projects-pod2", Destination: "/projects"})
+
+	containers := []containerInspect{
+		newContainer("pod2", infraContainerName, true),
+		runtime,
+		newContainer("pod1", "runtime", false),
+		newContainer("pod1", infraContainerName, false),
+		{ID: "not-a-pod"},
+	}
+
+	pods := groupByPod(containers)
+	if len(pods) != 2 {
+		t.Fatalf("expected 2 pods, got %d", len(pods))
+	}
+	if pods[0].name != "pod1" || pods[1].name != "pod2" {
+		t.Errorf("pods not sorted by name: %q, %q", pods[0].name, pods[1].name)
+	}
+	if pods[0].running() {
+		t.Errorf("pod1 should not be running")
+	}
+
+	got := pods[1].toPod()
+	want := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "pod2",
+			Labels: map[string]string{
+				"app.kubernetes.io/instance": "pod2",
+			},
+		},
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{
+					Name: "odo-projects-pod2",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: "odo-projects-pod2",
+						},
+					},
+				},
+			},
+			Containers: []corev1.Container{
+				{
+					Name:  "runtime",
+					Image: "image-runtime",
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "odo-projects-pod2", MountPath: "/projects"},
+					},
+				},
+			},
+		},
+		Status: corev1.PodStatus{
+			Phase: corev1.PodRunning,
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("toPod() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_getVolumeCreateArgs(t *testing.T) {
+	got := getVolumeCreateArgs("odo-projects-mycmp-app", map[string]string{
+		"component":                  "mycmp",
+		"app.kubernetes.io/instance": "mycmp",
+	})
+	want := []string{
+		"volume", "create",
+		"--label", "app.kubernetes.io/instance=mycmp",
+		"--label", "component=mycmp",
+		"odo-projects-mycmp-app",
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("getVolumeCreateArgs() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+// newFakeDockerCli returns a client executing a script in place of the docker command, which records its arguments
+// and lists a container and an emptyDir volume for the pod. It returns the function to get the recorded commands.
+func newFakeDockerCli(t *testing.T) (*DockerCli, func() []string) {
+	if runtime.GOOS == "windows" {
+		t.Skip("the fake docker command is a shell script")
+	}
+	dir := t.TempDir()
+	logFile := filepath.Join(dir, "commands.log")
+	script := fmt.Sprintf(`#!/bin/sh
+echo "$*" >> %q
+case "$1 $2" in
+  "ps "*) echo id1 ;;
+  "inspect "*) echo '[{"Id":"id1"}]' ;;
+  "volume ls") echo mycmp-app-tmp ;;
+esac
+`, logFile)
+	dockerCmd := filepath.Join(dir, "docker")
+	if err := os.WriteFile(dockerCmd, []byte(script), 0700); err != nil {
+		t.Fatal(err)
+	}
+	return &DockerCli{dockerCmd: dockerCmd}, func() []string {
+		content, err := os.ReadFile(logFile)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return strings.Split(strings.TrimSpace(string(content)), "\n")
+	}
+}
+
+func TestDockerCli_emptyDirVolumes(t *testing.T) {
+	contains := func(commands []string, want string) bool {
+		for _, command := range commands {
+			if command == want {
+				return true
+			}
+		}
+		return false
+	}
+
+	t.Run("emptyDir volumes are created empty for the pod", func(t *testing.T) {
+		cli, getCommands := newFakeDockerCli(t)
+		if err := cli.PlayKube(newPod(), nil); err != nil {
+			t.Fatal(err)
+		}
+		commands := getCommands()
+		for _, want := range []string{
+			"volume create odo-projects-mycmp-app",
+			"volume rm --force mycmp-app-tmp",
+			"volume create --label odo.dev/docker-pod=mycmp-app mycmp-app-tmp",
+		} {
+			if !contains(commands, want) {
+				t.Errorf("command %q not executed, got %v", want, commands)
+			}
+		}
+	})
+
+	t.Run("emptyDir volumes are deleted with the pod", func(t *testing.T) {
+		cli, getCommands := newFakeDockerCli(t)
+		if err := cli.PodRm("mycmp-app"); err != nil {
+			t.Fatal(err)
+		}
+		commands := getCommands()
+		for _, want := range []string{
+			"rm --force --volumes id1",
+			"volume ls --filter label=odo.dev/docker-pod=mycmp-app --format {{.Name}}",
+			"volume rm mycmp-app-tmp",
+		} {
+			if !contains(commands, want) {
+				t.Errorf("command %q not executed, got %v", want, commands)
+			}
+		}
+	})
+}
@@ -0,0 +1,230 @@
+package docker
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/podman"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+// dockerPod is a pod running on Docker, made of its infra container and of the containers sharing its network namespace
+type dockerPod struct {
+	name       string
+	infra      *containerInspect
+	containers []containerInspect
+}
+
+// groupByPod groups the containers by pod, sorted by name
+func groupByPod(containers []containerInspect) []dockerPod {
+	byName := map[string]*dockerPod{}
+	var names []string
+	for i := range containers {
+		container := containers[i]
+		podName := container.Config.Labels[podLabel]
+		if podName == "" {
+			continue
+		}
+		pod, found := byName[podName]
+		if !found {
+			pod = &dockerPod{name: podName}
+			byName[podName] = pod
+			names = append(names, podName)
+		}
+		if container.Config.Labels[containerLabel] == infraContainerName {
+			pod.infra = &container
+		} else {
+			pod.containers = append(pod.containers, container)
+		}
+	}
+	sort.Strings(names)
+	result := make([]dockerPod, 0, len(names))
+	for _, name := range names {
+		result = append(result, *byName[name])
+	}
+	return result
+}
+
+// labels returns the labels of the pod, without the labels added by odo to manage the pod on Docker
+func (o dockerPod) labels() map[string]string {
+	if o.infra == nil {
+		return nil
+	}
+	result := map[string]string{}
+	for k, v := range o.infra.Config.Labels {
+		if k == podLabel || k == containerLabel {
+			continue
+		}
+		result[k] = v
+	}
+	return result
+}
+
+// running returns true if the infra container of the pod is running
+func (o dockerPod) running() bool {
+	return o.infra != nil && o.infra.State.Running
+}
+
+// toPod returns a Pod with the name, labels, phase, containers and named volumes of the pod
+func (o dockerPod) toPod() *corev1.Pod {
+	var pod corev1.Pod
+	pod.SetName(o.name)
+	pod.SetLabels(o.labels())
+	if o.running() {
+		pod.Status.Phase = corev1.PodRunning
+	}
+	volumes := map[string]bool{}
+	for _, container := range o.containers {
+		c := corev1.Container{
+			Name:  container.Config.Labels[containerLabel],
+			Image: container.Config.Image,
+		}
+		for _, mount := range container.Mounts {
+			if mount.Type != "volume" {
+				continue
+			}
+			c.VolumeMounts = append(c.VolumeMounts, corev1.VolumeMount{
+				Name:      mount.Name,
+				MountPath: mount.Destination,
+			})
+			if !volumes[mount.Name] {
+				volumes[mount.Name] = true
+				pod.Spec.Volumes = append(pod.Spec.Volumes, corev1.Volume{
+					Name: mount.Name,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: mount.Name,
+						},
+					},
+				})
+			}
+		}
+		pod.Spec.Containers = append(pod.Spec.Containers, c)
+	}
+	return &pod
+}
+
+// getPodsFromSelector returns the pods matching the given label selector
+func (o *DockerCli) getPodsFromSelector(selector string) ([]dockerPod, error) {
+	filters, err := podman.LabelFilters(selector)
+	if err != nil {
+		return nil, err
+	}
+	containers, err := o.listContainers(filters)
+	if err != nil {
+		return nil, err
+	}
+	return groupByPod(containers), nil
+}
+
+// GetPodsMatchingSelector returns all pods matching the given label selector.
+func (o *DockerCli) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
+	pods, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	result := &corev1.PodList{}
+	for _, pod := range pods {
+		result.Items = append(result.Items, *pod.toPod())
+	}
+	return result, nil
+}
+
+// GetAllResourcesFromSelector returns all resources of any kind matching the given label selector.
+func (o *DockerCli) GetAllResourcesFromSelector(selector string, _ string) ([]unstructured.Unstructured, error) {
+	pods, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	var result []unstructured.Unstructured
+	for _, pod := range pods {
+		u := unstructured.Unstructured{}
+		u.SetName(pod.name)
+		u.SetLabels(pod.labels())
+		result = append(result, u)
+	}
+	return result, nil
+}
+
+// GetAllPodsInNamespaceMatchingSelector returns all pods matching the given label selector and in the specified namespace.
+// As there is no namespace on Docker, the namespace is ignored.
+func (o *DockerCli) GetAllPodsInNamespaceMatchingSelector(selector string, _ string) (*corev1.PodList, error) {
+	return o.GetPodsMatchingSelector(selector)
+}
+
+// GetRunningPodFromSelector returns any pod matching the given label selector.
+// If multiple pods are found, implementations might have different behavior, by either returning an error or returning any element.
+func (o *DockerCli) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	pods, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	numPods := len(pods)
+	if numPods == 0 {
+		return nil, &platform.PodNotFoundError{Selector: selector}
+	} else if numPods > 1 {
+		return nil, fmt.Errorf("multiple Pods exist for the selector: %v. Only one must be present", selector)
+	}
+	return pods[0].toPod(), nil
+}
+
+func (o *DockerCli) ListAllComponents() ([]api.ComponentAbstract, error) {
+	containers, err := o.listContainers([]string{
+		containerLabel + "=" + infraContainerName,
+		odolabels.SelectorBuilder().WithAnyComponent().Selector(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var components []api.ComponentAbstract
+	for _, pod := range groupByPod(containers) {
+		if !pod.running() {
+			continue
+		}
+
+		labels := pod.labels()
+		klog.V(5).Infof("\npod name: %s", pod.name)
+		klog.V(5).Infof("labels:")
+		for k, v := range labels {
+			klog.V(5).Infof(" - %s: %s", k, v)
+		}
+
+		// if there is no instance label (app.kubernetes.io/instance),
+		// we SKIP the resource as it is not a component
+		name := odolabels.GetComponentName(labels)
+		if name == "" {
+			continue
+		}
+
+		componentType, err := odolabels.GetProjectType(labels, nil)
+		if err != nil || componentType == "" {
+			componentType = api.TypeUnknown
+		}
+
+		component := api.ComponentAbstract{
+			Name:             name,
+			ManagedBy:        odolabels.GetManagedBy(labels),
+			Type:             componentType,
+			ManagedByVersion: odolabels.GetManagedByVersion(labels),
+			//lint:ignore SA1019 we need to output the deprecated value, before to remove it in a future release
+			RunningOn: commonflags.PlatformDocker,
+			Platform:  commonflags.PlatformDocker,
+		}
+		mode := odolabels.GetMode(labels)
+		if mode != "" {
+			component.RunningIn = api.NewRunningModes()
+			component.RunningIn.AddRunningMode(api.RunningMode(strings.ToLower(mode)))
+		}
+		components = append(components, component)
+	}
+	return components, nil
+}
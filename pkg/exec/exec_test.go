@@ -8,6 +8,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/remotecommand"
 )
 
 const (
@@ -23,6 +24,10 @@ func (o fakePlatform) ExecCMDInContainer(containerName, podName string, cmd []st
 	return o.execCMDInContainer(containerName, podName, cmd, stdout, stderr, stdin, tty)
 }
 
+func (o fakePlatform) ExecInteractiveCMDInContainer(containerName, podName string, cmd []string, stdin io.Reader, stdout io.Writer, sizeQueue remotecommand.TerminalSizeQueue) error {
+	panic("not implemented yet")
+}
+
 func (o fakePlatform) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
 	panic("not implemented yet")
 }
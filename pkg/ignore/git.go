@@ -0,0 +1,115 @@
+package ignore
+
+import (
+	"bufio"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// findGitRepository returns the git directory and the root of the working tree of the git repository containing directory,
+// and false if directory is not part of a git repository
+func findGitRepository(directory string) (string, string, bool) {
+	for dir := directory; ; {
+		candidate := filepath.Join(dir, dotGitDirectory)
+		if info, err := os.Stat(candidate); err == nil {
+			if info.IsDir() {
+				return candidate, dir, true
+			}
+			// for worktrees and submodules, .git is a file containing the path of the git directory
+			if content, err := os.ReadFile(candidate); err == nil && strings.HasPrefix(string(content), "gitdir:") { // #nosec G304
+				gitDir := strings.TrimSpace(strings.TrimPrefix(string(content), "gitdir:"))
+				if !filepath.IsAbs(gitDir) {
+					gitDir = filepath.Join(dir, gitDir)
+				}
+				return gitDir, dir, true
+			}
+		}
+		parent := filepath.Dir(dir)
+		if parent == dir {
+			return "", "", false
+		}
+		dir = parent
+	}
+}
+
+// getExcludesFile returns the path of the excludes file of git: the value of core.excludesFile defined in the global
+// configuration of the user or in the configuration of the repository whose git directory is gitDir, if any,
+// or $XDG_CONFIG_HOME/git/ignore by default.
+// The system configuration and the files included in the configuration are not read.
+func getExcludesFile(gitDir string) string {
+	home, _ := os.UserHomeDir()
+	configHome := os.Getenv("XDG_CONFIG_HOME")
+	if configHome == "" && home != "" {
+		configHome = filepath.Join(home, ".config")
+	}
+
+	var configFiles []string
+	if configHome != "" {
+		configFiles = append(configFiles, filepath.Join(configHome, "git", "config"))
+	}
+	if home != "" {
+		configFiles = append(configFiles, filepath.Join(home, ".gitconfig"))
+	}
+	if gitDir != "" {
+		configFiles = append(configFiles, filepath.Join(gitDir, "config"))
+	}
+
+	excludesFile := ""
+	for _, configFile := range configFiles {
+		if value, found := getGitConfigValue(configFile, "core", "excludesFile"); found {
+			excludesFile = value
+		}
+	}
+	if excludesFile == "" {
+		if configHome == "" {
+			return ""
+		}
+		return filepath.Join(configHome, "git", "ignore")
+	}
+	if strings.HasPrefix(excludesFile, "~/") && home != "" {
+		excludesFile = filepath.Join(home, excludesFile[2:])
+	}
+	return excludesFile
+}
+
+// getGitConfigValue returns the last value of the key in the section of the git configuration file,
+// and false if the file does not define it. Section and key names are case-insensitive.
+func getGitConfigValue(configFile string, section string, key string) (string, bool) {
+	file, err := os.Open(configFile) // #nosec G304
+	if err != nil {
+		return "", false
+	}
+	defer file.Close() // #nosec G307
+
+	var (
+		value          string
+		found          bool
+		currentSection string
+	)
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, ";") {
+			continue
+		}
+		if strings.HasPrefix(line, "[") {
+			fields := strings.Fields(strings.Trim(line, "[]"))
+			currentSection = ""
+			if len(fields) > 0 {
+				currentSection = fields[0]
+			}
+			continue
+		}
+		if !strings.EqualFold(currentSection, section) {
+			continue
+		}
+		name, v, _ := strings.Cut(line, "=")
+		if !strings.EqualFold(strings.TrimSpace(name), key) {
+			continue
+		}
+		value = strings.Trim(strings.TrimSpace(v), `"`)
+		found = true
+	}
+	return value, found
+}
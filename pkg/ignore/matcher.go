@@ -0,0 +1,190 @@
+// Package ignore matches paths against rules using the syntax and the semantics of gitignore files,
+// as described in https://git-scm.com/docs/gitignore
+package ignore
+
+import (
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// Rule is a rule to ignore files, relative to the root directory of a Matcher
+type Rule struct {
+	// Pattern is the pattern of the rule, relative to the root directory, using the syntax of gitignore files
+	Pattern string
+	// Source is the path of the file defining the rule, empty for the rules added by odo
+	Source string
+	// Line is the line of the rule in Source, starting at 1
+	Line int
+	// Text is the pattern as written in Source, relative to the directory of Source
+	Text string
+}
+
+// Matcher indicates if paths relative to a root directory are ignored by a list of rules.
+// As with git, a rule overrides the previous ones, and a path is ignored if one of its parent directories is ignored,
+// even if a later negated rule matches the path.
+type Matcher struct {
+	rules []compiledRule
+}
+
+type compiledRule struct {
+	rule Rule
+	// negate is true if the pattern starts with "!"
+	negate bool
+	// dirOnly is true if the pattern ends with "/"
+	dirOnly bool
+	// segments are the glob patterns matching the segments of a path, "**" matching any number of segments
+	segments []string
+}
+
+// NewMatcher returns a matcher for the patterns, relative to the root directory
+func NewMatcher(patterns ...string) *Matcher {
+	rules := make([]Rule, 0, len(patterns))
+	for _, pattern := range patterns {
+		rules = append(rules, Rule{Pattern: pattern, Text: pattern})
+	}
+	return NewMatcherFromRules(rules)
+}
+
+// NewMatcherFromRules returns a matcher for the rules. Comments, empty lines and invalid patterns are ignored.
+func NewMatcherFromRules(rules []Rule) *Matcher {
+	m := &Matcher{}
+	for _, rule := range rules {
+		m.add(rule)
+	}
+	return m
+}
+
+// Patterns returns the patterns of the rules
+func Patterns(rules []Rule) []string {
+	result := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		result = append(result, rule.Pattern)
+	}
+	return result
+}
+
+func (m *Matcher) add(rule Rule) {
+	compiled, ok := compile(rule)
+	if ok {
+		m.rules = append(m.rules, compiled)
+	}
+}
+
+// Matches returns true if the path, relative to the root directory, is ignored.
+// isDir indicates if the path is a directory, for the patterns matching only directories.
+func (m *Matcher) Matches(relPath string, isDir bool) bool {
+	return m.MatchingRule(relPath, isDir) != nil
+}
+
+// MatchingRule returns the rule ignoring the path, relative to the root directory, or nil if the path is not ignored.
+// When a parent directory of the path is ignored, the rule ignoring this directory is returned.
+func (m *Matcher) MatchingRule(relPath string, isDir bool) *Rule {
+	p := path.Clean(filepath.ToSlash(relPath))
+	if p == "." || p == ".." || strings.HasPrefix(p, "../") || path.IsAbs(p) {
+		return nil
+	}
+	segments := strings.Split(p, "/")
+	// a path cannot be re-included if one of its parent directories is ignored
+	for i := 1; i < len(segments); i++ {
+		if rule, ignored := m.match(segments[:i], true); ignored {
+			return rule
+		}
+	}
+	if rule, ignored := m.match(segments, isDir); ignored {
+		return rule
+	}
+	return nil
+}
+
+// match returns the last rule matching the segments of the path, and true if this rule ignores the path
+func (m *Matcher) match(segments []string, isDir bool) (*Rule, bool) {
+	for i := len(m.rules) - 1; i >= 0; i-- {
+		r := &m.rules[i]
+		if r.dirOnly && !isDir {
+			continue
+		}
+		if matchSegments(r.segments, segments) {
+			return &r.rule, !r.negate
+		}
+	}
+	return nil, false
+}
+
+// compile parses the pattern of the rule. It returns false for comments, empty lines and invalid patterns.
+func compile(rule Rule) (compiledRule, bool) {
+	result := compiledRule{rule: rule}
+	p := trimPattern(rule.Pattern)
+	if p == "" || strings.HasPrefix(p, "#") {
+		return result, false
+	}
+	if strings.HasPrefix(p, "!") {
+		result.negate = true
+		p = p[1:]
+	}
+	if strings.HasSuffix(p, "/") {
+		result.dirOnly = true
+		p = strings.TrimRight(p, "/")
+	}
+	if p == "" {
+		return result, false
+	}
+
+	if strings.Contains(p, "/") {
+		// the pattern is relative to the root directory
+		p = strings.TrimPrefix(p, "/")
+	} else {
+		// the pattern matches at any level
+		p = "**/" + p
+	}
+	for _, segment := range strings.Split(p, "/") {
+		if segment == "" {
+			continue
+		}
+		if segment == "**" && len(result.segments) > 0 && result.segments[len(result.segments)-1] == "**" {
+			continue
+		}
+		// git accepts "!" as well as "^" to negate a character class
+		segment = strings.ReplaceAll(segment, "[!", "[^")
+		if _, err := path.Match(segment, ""); err != nil {
+			return result, false
+		}
+		result.segments = append(result.segments, segment)
+	}
+	return result, len(result.segments) > 0
+}
+
+// trimPattern removes the carriage return and the trailing spaces of the pattern, unless they are escaped with a backslash
+func trimPattern(p string) string {
+	p = strings.TrimSuffix(p, "\r")
+	for strings.HasSuffix(p, " ") && !strings.HasSuffix(p, "\\ ") {
+		p = p[:len(p)-1]
+	}
+	return p
+}
+
+// matchSegments returns true if the segments of the path match the segments of the pattern.
+// "**" matches zero or more segments, except at the end of the pattern, where it matches one or more segments.
+func matchSegments(pattern []string, segments []string) bool {
+	if len(pattern) == 0 {
+		return len(segments) == 0
+	}
+	if pattern[0] == "**" {
+		if len(pattern) == 1 {
+			return len(segments) > 0
+		}
+		for i := 0; i <= len(segments); i++ {
+			if matchSegments(pattern[1:], segments[i:]) {
+				return true
+			}
+		}
+		return false
+	}
+	if len(segments) == 0 {
+		return false
+	}
+	if ok, _ := path.Match(pattern[0], segments[0]); !ok {
+		return false
+	}
+	return matchSegments(pattern[1:], segments[1:])
+}
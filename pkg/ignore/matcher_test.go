@@ -0,0 +1,153 @@
+package ignore
+
+import (
+	"testing"
+)
+
+func TestMatcher_Matches(t *testing.T) {
+	tests := []struct {
+		name     string
+		patterns []string
+		path     string
+		isDir    bool
+		want     bool
+	}{
+		{
+			name:     "pattern without slash matches at any level",
+			patterns: []string{"*.log"},
+			path:     "a/b/debug.log",
+			want:     true,
+		},
+		{
+			name:     "pattern with leading slash matches at the root only",
+			patterns: []string{"/build"},
+			path:     "a/build",
+			want:     false,
+		},
+		{
+			name:     "pattern with a slash in the middle is anchored",
+			patterns: []string{"doc/*.html"},
+			path:     "tools/doc/index.html",
+			want:     false,
+		},
+		{
+			name:     "wildcard does not match a slash",
+			patterns: []string{"doc/*.html"},
+			path:     "doc/api/index.html",
+			want:     false,
+		},
+		{
+			name:     "question mark matches a single character",
+			patterns: []string{"file?.txt"},
+			path:     "file1.txt",
+			want:     true,
+		},
+		{
+			name:     "character class",
+			patterns: []string{"file[!0-9].txt"},
+			path:     "file1.txt",
+			want:     false,
+		},
+		{
+			name:     "pattern with trailing slash does not match a file",
+			patterns: []string{"build/"},
+			path:     "build",
+			want:     false,
+		},
+		{
+			name:     "pattern with trailing slash matches a directory",
+			patterns: []string{"build/"},
+			path:     "build",
+			isDir:    true,
+			want:     true,
+		},
+		{
+			name:     "content of an ignored directory is ignored",
+			patterns: []string{"build/"},
+			path:     "build/out/app",
+			want:     true,
+		},
+		{
+			name:     "double asterisk matches zero directory",
+			patterns: []string{"a/**/b"},
+			path:     "a/b",
+			want:     true,
+		},
+		{
+			name:     "double asterisk matches several directories",
+			patterns: []string{"a/**/b"},
+			path:     "a/x/y/b",
+			want:     true,
+		},
+		{
+			name:     "trailing double asterisk does not match the directory itself",
+			patterns: []string{"a/**"},
+			path:     "a",
+			isDir:    true,
+			want:     false,
+		},
+		{
+			name:     "negated pattern re-includes a file",
+			patterns: []string{"*.log", "!keep.log"},
+			path:     "keep.log",
+			want:     false,
+		},
+		{
+			name:     "last matching pattern wins",
+			patterns: []string{"!keep.log", "*.log"},
+			path:     "keep.log",
+			want:     true,
+		},
+		{
+			name:     "file cannot be re-included if its parent directory is ignored",
+			patterns: []string{"logs/", "!logs/keep.log"},
+			path:     "logs/keep.log",
+			want:     true,
+		},
+		{
+			name:     "file can be re-included if only the content of its parent is ignored",
+			patterns: []string{"logs/*", "!logs/keep.log"},
+			path:     "logs/keep.log",
+			want:     false,
+		},
+		{
+			name:     "escaped exclamation mark",
+			patterns: []string{`\!important`},
+			path:     "!important",
+			want:     true,
+		},
+		{
+			name:     "comments and trailing spaces",
+			patterns: []string{"# comment", "tmp   "},
+			path:     "tmp",
+			want:     true,
+		},
+		{
+			name:     "path outside of the root directory",
+			patterns: []string{"*"},
+			path:     "../file",
+			want:     false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMatcher(tt.patterns...)
+			if got := m.Matches(tt.path, tt.isDir); got != tt.want {
+				t.Errorf("Matches(%q) = %v, want %v", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMatcher_MatchingRule(t *testing.T) {
+	m := NewMatcherFromRules([]Rule{
+		{Pattern: "node_modules/", Source: ".gitignore", Line: 1},
+		{Pattern: "*.log", Source: ".gitignore", Line: 2},
+	})
+	if rule := m.MatchingRule("node_modules/express/index.js", false); rule == nil || rule.Line != 1 {
+		t.Errorf("MatchingRule() = %v, want rule of line 1", rule)
+	}
+	if rule := m.MatchingRule("server.js", false); rule != nil {
+		t.Errorf("MatchingRule() = %v, want nil", rule)
+	}
+}
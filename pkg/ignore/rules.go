@@ -0,0 +1,278 @@
+package ignore
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"k8s.io/klog"
+)
+
+const (
+	dotOdoIgnoreFile = ".odoignore"
+	dotGitIgnoreFile = ".gitignore"
+	dotGitDirectory  = ".git"
+)
+
+// IgnoredPath is a path ignored by a rule
+type IgnoredPath struct {
+	// Path is the path relative to the root directory, using slashes as separators
+	Path string
+	// IsDir is true if the path is a directory; the content of an ignored directory is ignored too
+	IsDir bool
+	// Rule is the rule ignoring the path
+	Rule Rule
+}
+
+// LoadRules returns the rules to ignore files in directory, by increasing order of precedence:
+//   - the rules of the excludes file of git (core.excludesFile, or $XDG_CONFIG_HOME/git/ignore by default),
+//   - the rules of the .git/info/exclude file of the git repository containing directory,
+//   - the rules of the .gitignore files of the parent directories of directory, up to the root of the git repository,
+//   - the rules of the .odoignore file, or of the .gitignore file if there is no .odoignore file, of directory
+//     and of its subdirectories which are not ignored, the rules of a subdirectory overriding the ones of its parents.
+//
+// The patterns of the rules are relative to directory.
+func LoadRules(directory string) ([]Rule, error) {
+	root, err := filepath.Abs(directory)
+	if err != nil {
+		return nil, err
+	}
+
+	// the rules defined by git are relative to the root of the git repository, if any
+	var rules []Rule
+	gitDir, workTree, inRepository := findGitRepository(root)
+	var rootSegments []string
+	if inRepository {
+		rel, err := filepath.Rel(workTree, root)
+		if err != nil {
+			return nil, err
+		}
+		if rel != "." {
+			rootSegments = strings.Split(filepath.ToSlash(rel), "/")
+		}
+	}
+
+	addRebasedRules := func(source string, segments []string) error {
+		lines, err := readLines(source)
+		if err != nil {
+			return err
+		}
+		for i, line := range lines {
+			for _, pattern := range rebasePattern(line, segments) {
+				rules = append(rules, Rule{Pattern: pattern, Source: source, Line: i + 1, Text: line})
+			}
+		}
+		return nil
+	}
+
+	if excludesFile := getExcludesFile(gitDir); excludesFile != "" {
+		if err = addRebasedRules(excludesFile, rootSegments); err != nil {
+			return nil, err
+		}
+	}
+	if inRepository {
+		if err = addRebasedRules(filepath.Join(gitDir, "info", "exclude"), rootSegments); err != nil {
+			return nil, err
+		}
+		for i := range rootSegments {
+			dir := filepath.Join(workTree, filepath.FromSlash(strings.Join(rootSegments[:i], "/")))
+			if err = addRebasedRules(filepath.Join(dir, dotGitIgnoreFile), rootSegments[i:]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// the subdirectories are walked after their parent, so that the rules of the parents
+	// are known when checking if a subdirectory is ignored
+	matcher := NewMatcherFromRules(rules)
+	err = filepath.WalkDir(root, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == root {
+				return err
+			}
+			klog.V(4).Infof("unable to read %s, ignoring it: %v", p, err)
+			return nil
+		}
+		if !d.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(root, p)
+		if err != nil {
+			return err
+		}
+		dir := ""
+		if rel != "." {
+			if d.Name() == dotGitDirectory || matcher.Matches(rel, true) {
+				return filepath.SkipDir
+			}
+			dir = filepath.ToSlash(rel)
+		}
+
+		source, lines, err := readIgnoreFile(p)
+		if err != nil {
+			return err
+		}
+		for i, line := range lines {
+			rule := Rule{Pattern: prefixPattern(line, dir), Source: source, Line: i + 1, Text: line}
+			if _, ok := compile(rule); !ok {
+				continue
+			}
+			rules = append(rules, rule)
+			matcher.add(rule)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return rules, nil
+}
+
+// ListIgnored returns the paths in directory ignored by the matcher. The content of an ignored directory is not listed.
+func ListIgnored(directory string, matcher *Matcher) ([]IgnoredPath, error) {
+	var result []IgnoredPath
+	err := filepath.WalkDir(directory, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			if p == directory {
+				return err
+			}
+			klog.V(4).Infof("unable to read %s, ignoring it: %v", p, err)
+			return nil
+		}
+		rel, err := filepath.Rel(directory, p)
+		if err != nil || rel == "." {
+			return err
+		}
+		rule := matcher.MatchingRule(rel, d.IsDir())
+		if rule == nil {
+			return nil
+		}
+		result = append(result, IgnoredPath{
+			Path:  filepath.ToSlash(rel),
+			IsDir: d.IsDir(),
+			Rule:  *rule,
+		})
+		if d.IsDir() {
+			return filepath.SkipDir
+		}
+		return nil
+	})
+	return result, err
+}
+
+// readIgnoreFile returns the path and the lines of the .odoignore file of the directory,
+// or of the .gitignore file if there is no .odoignore file
+func readIgnoreFile(directory string) (string, []string, error) {
+	for _, name := range []string{dotOdoIgnoreFile, dotGitIgnoreFile} {
+		source := filepath.Join(directory, name)
+		if _, err := os.Stat(source); err != nil {
+			continue
+		}
+		lines, err := readLines(source)
+		return source, lines, err
+	}
+	return "", nil, nil
+}
+
+// readLines returns the lines of the file, or nil if the file does not exist
+func readLines(file string) ([]string, error) {
+	content, err := os.ReadFile(file) // #nosec G304 -- ignore files of the user
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return strings.Split(string(content), "\n"), nil
+}
+
+// prefixPattern returns the pattern, read from the ignore file of the subdirectory dir of the root directory,
+// relative to the root directory
+func prefixPattern(pattern string, dir string) string {
+	p := trimPattern(pattern)
+	if dir == "" || p == "" || strings.HasPrefix(p, "#") {
+		return p
+	}
+	negate, body, suffix := splitPattern(p)
+	dir = escapeGlob(dir)
+	if strings.Contains(body, "/") {
+		return negate + "/" + dir + "/" + strings.TrimPrefix(body, "/") + suffix
+	}
+	return negate + "/" + dir + "/**/" + body + suffix
+}
+
+// rebasePattern returns the patterns, relative to a subdirectory, equivalent to the pattern read from the ignore file
+// of a parent directory. segments are the segments of the path of the subdirectory relative to the parent directory.
+// No pattern is returned if the pattern cannot match any path in the subdirectory.
+func rebasePattern(pattern string, segments []string) []string {
+	p := trimPattern(pattern)
+	if p == "" || strings.HasPrefix(p, "#") {
+		return nil
+	}
+	negate, body, suffix := splitPattern(p)
+	if len(segments) == 0 || !strings.Contains(body, "/") {
+		return []string{p}
+	}
+	var patternSegments []string
+	for _, segment := range strings.Split(body, "/") {
+		if segment != "" {
+			patternSegments = append(patternSegments, segment)
+		}
+	}
+	var result []string
+	for _, rest := range rebaseSegments(patternSegments, segments) {
+		result = append(result, negate+"/"+rest+suffix)
+	}
+	return result
+}
+
+// rebaseSegments returns the patterns matching the paths of the subdirectory whose segments are dirSegments,
+// which are matched by the pattern whose segments are patternSegments
+func rebaseSegments(patternSegments []string, dirSegments []string) []string {
+	if len(patternSegments) == 0 {
+		// the pattern matches the subdirectory itself, or one of its parents
+		return nil
+	}
+	if len(dirSegments) == 0 {
+		return []string{strings.Join(patternSegments, "/")}
+	}
+	if patternSegments[0] == "**" {
+		// "**" matches all the segments of the subdirectory, and possibly some segments in the subdirectory
+		result := []string{strings.Join(patternSegments, "/")}
+		// or only the first segments of the subdirectory
+		for i := range dirSegments {
+			result = append(result, rebaseSegments(patternSegments[1:], dirSegments[i:])...)
+		}
+		return result
+	}
+	if ok, _ := path.Match(strings.ReplaceAll(patternSegments[0], "[!", "[^"), dirSegments[0]); !ok {
+		return nil
+	}
+	return rebaseSegments(patternSegments[1:], dirSegments[1:])
+}
+
+// splitPattern returns the negation prefix, the body and the trailing slash of the pattern
+func splitPattern(p string) (string, string, string) {
+	negate := ""
+	if strings.HasPrefix(p, "!") {
+		negate = "!"
+		p = p[1:]
+	}
+	body := strings.TrimRight(p, "/")
+	return negate, body, p[len(body):]
+}
+
+// escapeGlob escapes the characters of the path having a special meaning in patterns
+func escapeGlob(p string) string {
+	var b strings.Builder
+	for _, c := range p {
+		if strings.ContainsRune(`*?[\`, c) {
+			b.WriteRune('\\')
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
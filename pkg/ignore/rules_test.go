@@ -0,0 +1,171 @@
+package ignore
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_prefixPattern(t *testing.T) {
+	tests := []struct {
+		pattern string
+		dir     string
+		want    string
+	}{
+		{pattern: "*.log", dir: "", want: "*.log"},
+		{pattern: "*.log", dir: "api", want: "/api/**/*.log"},
+		{pattern: "/build/", dir: "api", want: "/api/build/"},
+		{pattern: "doc/*.html", dir: "api/v1", want: "/api/v1/doc/*.html"},
+		{pattern: "!keep.log", dir: "api", want: "!/api/**/keep.log"},
+		{pattern: "tmp", dir: "a[1]", want: `/a\[1]/**/tmp`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.pattern+" in "+tt.dir, func(t *testing.T) {
+			if got := prefixPattern(tt.pattern, tt.dir); got != tt.want {
+				t.Errorf("prefixPattern() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_rebasePattern(t *testing.T) {
+	tests := []struct {
+		name     string
+		pattern  string
+		segments []string
+		want     []string
+	}{
+		{
+			name:     "pattern matching at any level",
+			pattern:  "*.log",
+			segments: []string{"services", "api"},
+			want:     []string{"*.log"},
+		},
+		{
+			name:     "anchored pattern in the directory",
+			pattern:  "/services/api/dist/",
+			segments: []string{"services", "api"},
+			want:     []string{"/dist/"},
+		},
+		{
+			name:     "anchored pattern in another directory",
+			pattern:  "/services/web/dist",
+			segments: []string{"services", "api"},
+		},
+		{
+			name:     "anchored pattern with wildcard",
+			pattern:  "!services/*/dist",
+			segments: []string{"services", "api"},
+			want:     []string{"!/dist"},
+		},
+		{
+			name:     "pattern with double asterisk",
+			pattern:  "**/api/dist",
+			segments: []string{"services", "api"},
+			want:     []string{"/**/api/dist", "/dist"},
+		},
+		{
+			name:     "pattern matching the directory itself",
+			pattern:  "/services/api",
+			segments: []string{"services", "api"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := rebasePattern(tt.pattern, tt.segments)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("rebasePattern() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestLoadRules(t *testing.T) {
+	writeFiles := func(t *testing.T, dir string, files map[string]string) {
+		for name, content := range files {
+			p := filepath.Join(dir, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(p), 0750); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("XDG_CONFIG_HOME", "")
+	writeFiles(t, home, map[string]string{
+		".gitconfig":         "[user]\n\tname = dev\n[core]\n\texcludesFile = ~/.global-excludes\n",
+		".global-excludes":   "*.swp\n",
+		".config/git/ignore": "*.global\n",
+	})
+
+	repository := t.TempDir()
+	writeFiles(t, repository, map[string]string{
+		".git/info/exclude":            "/services/api/local.env\n",
+		".gitignore":                   "*.log\n/services/api/dist/\n/services/web/\n",
+		"services/.gitignore":          "!important.log\n",
+		"services/api/.gitignore":      "# comment\n/tmp/\nnode_modules\n",
+		"services/api/gen/.gitignore":  "*.go\n!keep.go\n",
+		"services/api/docs/.odoignore": "*.pdf\n",
+		"services/api/docs/.gitignore": "*.md\n",
+		"services/api/tmp/.gitignore":  "!*\n",
+	})
+	component := filepath.Join(repository, "services", "api")
+
+	rules, err := LoadRules(component)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m := NewMatcherFromRules(rules)
+
+	for path, want := range map[string]bool{
+		"file.swp":               true,
+		"file.global":            false,
+		"local.env":              true,
+		"server.log":             true,
+		"important.log":          false,
+		"dist/app.js":            true,
+		"tmp/cache":              true,
+		"lib/node_modules/a.js":  true,
+		"gen/api.go":             true,
+		"gen/keep.go":            false,
+		"server.go":              false,
+		"docs/guide.pdf":         true,
+		"docs/README.md":         false,
+		"docs/sub/guide.pdf":     true,
+		"services/web/index.js":  false,
+		"services/api/server.js": false,
+	} {
+		if got := m.Matches(path, false); got != want {
+			t.Errorf("Matches(%q) = %v, want %v", path, got, want)
+		}
+	}
+
+	writeFiles(t, component, map[string]string{
+		"server.log":   "",
+		"tmp/cache":    "",
+		"gen/api.go":   "",
+		"gen/keep.go":  "",
+		"server.go":    "",
+		"important.go": "",
+	})
+	ignored, err := ListIgnored(component, m)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ignoredPaths []string
+	for _, p := range ignored {
+		ignoredPaths = append(ignoredPaths, p.Path)
+	}
+	if diff := cmp.Diff([]string{"gen/api.go", "server.log", "tmp"}, ignoredPaths); diff != "" {
+		t.Fatalf("ListIgnored() mismatch (-want +got):\n%s", diff)
+	}
+	if ignored[2].Rule.Source != filepath.Join(component, ".gitignore") || ignored[2].Rule.Line != 2 {
+		t.Errorf("tmp should be ignored by line 2 of the .gitignore file of the component, got %+v", ignored[2].Rule)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	projectv1 "github.com/openshift/api/project/v1"
 	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/api/networking/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
@@ -21,6 +22,7 @@ import (
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/client-go/tools/remotecommand"
 
 	bindingApi "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
 	specApi "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
@@ -65,6 +67,12 @@ type ClientInterface interface {
 	// events.go
 	PodWarningEventWatcher(ctx context.Context) (result watch.Interface, isForbidden bool, err error)
 
+	// jobs.go
+	CreateJob(job batchv1.Job, namespace string) (*batchv1.Job, error)
+	WaitForJobToComplete(ctx context.Context, job *batchv1.Job, timeout time.Duration) (*batchv1.Job, error)
+	GetJobLogs(ctx context.Context, job *batchv1.Job, containerName string) (io.ReadCloser, error)
+	DeleteJob(jobName string) error
+
 	// kclient.go
 	GetClient() kubernetes.Interface
 	GetConfig() clientcmd.ClientConfig
@@ -108,6 +116,7 @@ type ClientInterface interface {
 
 	// pods.go
 	ExecCMDInContainer(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error
+	ExecInteractiveCMDInContainer(containerName, podName string, cmd []string, stdin io.Reader, stdout io.Writer, sizeQueue remotecommand.TerminalSizeQueue) error
 	GetPodUsingComponentName(componentName string) (*corev1.Pod, error)
 	GetRunningPodFromSelector(selector string) (*corev1.Pod, error)
 	GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error)
@@ -118,9 +127,10 @@ type ClientInterface interface {
 
 	// port_forwarding.go
 	// SetupPortForwarding creates port-forwarding for the pod on the port pairs provided in the
-	// ["<localhost-port>":"<remote-pod-port>"] format. errOut is used by the client-go library to output any errors
+	// ["<localhost-port>":"<remote-pod-port>"] format. The local ports are listened on address,
+	// or on localhost if address is empty. errOut is used by the client-go library to output any errors
 	// encountered while the port-forwarding is running
-	SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}) error
+	SetupPortForwarding(pod *corev1.Pod, address string, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}) error
 
 	// projects.go
 	CreateNewProject(projectName string, wait bool) error
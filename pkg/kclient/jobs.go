@@ -0,0 +1,165 @@
+package kclient
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/klog"
+)
+
+// controllerUIDLabel is the label set by the Job controller on the pods created for a Job, with the UID of the Job.
+// It is used instead of the name of the Job, so that the pods of a previous Job with the same name,
+// deleted in the background, are not selected.
+const controllerUIDLabel = "controller-uid"
+
+// getJobPodsSelector returns the label selector of the pods created for the Job
+func getJobPodsSelector(job *batchv1.Job) string {
+	return controllerUIDLabel + "=" + string(job.UID)
+}
+
+// CreateJob creates the given Job in the given namespace, or in the current namespace if namespace is empty
+func (c *Client) CreateJob(job batchv1.Job, namespace string) (*batchv1.Job, error) {
+	if namespace == "" {
+		namespace = c.Namespace
+	}
+	createdJob, err := c.KubeClient.BatchV1().Jobs(namespace).Create(context.TODO(), &job, metav1.CreateOptions{FieldManager: FieldManager})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Job %s: %w", job.Name, err)
+	}
+	return createdJob, nil
+}
+
+// jobPodErrorReasons are the reasons for which a container of the pod of a Job is waiting,
+// which need an action from the user to be resolved
+var jobPodErrorReasons = map[string]bool{
+	"ErrImagePull":               true,
+	"ImagePullBackOff":           true,
+	"InvalidImageName":           true,
+	"CreateContainerConfigError": true,
+	"CreateContainerError":       true,
+}
+
+// WaitForJobToComplete waits for the Job to complete, and returns the Job in its final state.
+// An error is returned if the Job failed, if a container of its pod cannot be started,
+// or if the Job is not complete after the timeout.
+func (c *Client) WaitForJobToComplete(ctx context.Context, job *batchv1.Job, timeout time.Duration) (*batchv1.Job, error) {
+	klog.V(3).Infof("Waiting for Job %s to complete", job.Name)
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	w, err := c.KubeClient.BatchV1().Jobs(job.Namespace).Watch(ctx, metav1.ListOptions{
+		FieldSelector: fields.Set{"metadata.name": job.Name}.AsSelector().String(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to watch Job: %w", err)
+	}
+	defer w.Stop()
+	podWatcher, err := c.KubeClient.CoreV1().Pods(job.Namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector: getJobPodsSelector(job),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to watch pods of Job: %w", err)
+	}
+	defer podWatcher.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				return nil, fmt.Errorf("job %s is not complete after %s", job.Name, timeout)
+			}
+			return nil, ctx.Err()
+
+		case val, ok := <-podWatcher.ResultChan():
+			if !ok {
+				return nil, fmt.Errorf("unknown error while watching the pods of Job %q", job.Name)
+			}
+			pod, ok := val.Object.(*corev1.Pod)
+			if !ok || !metav1.IsControlledBy(pod, job) {
+				continue
+			}
+			for _, status := range pod.Status.ContainerStatuses {
+				if status.State.Waiting != nil && jobPodErrorReasons[status.State.Waiting.Reason] {
+					return nil, fmt.Errorf("container %s of job %s cannot be started: %s: %s",
+						status.Name, job.Name, status.State.Waiting.Reason, status.State.Waiting.Message)
+				}
+			}
+
+		case val, ok := <-w.ResultChan():
+			if !ok {
+				return nil, fmt.Errorf("unknown error while waiting for Job %q to complete", job.Name)
+			}
+			j, ok := val.Object.(*batchv1.Job)
+			if !ok || j.UID != job.UID {
+				// a previous Job with the same name may still be reported while it is deleted
+				continue
+			}
+			for _, condition := range j.Status.Conditions {
+				if condition.Status != corev1.ConditionTrue {
+					continue
+				}
+				switch condition.Type {
+				case batchv1.JobComplete:
+					klog.V(3).Infof("Job %s completed", j.Name)
+					return j, nil
+				case batchv1.JobFailed:
+					return j, fmt.Errorf("job %s failed: %s", j.Name, condition.Message)
+				}
+			}
+		}
+	}
+}
+
+// GetJobLogs returns the logs of the given container of the pod created for the Job.
+// It waits for the pod to be started before to get its logs, which are followed until the container terminates
+// or ctx is cancelled.
+func (c *Client) GetJobLogs(ctx context.Context, job *batchv1.Job, containerName string) (io.ReadCloser, error) {
+	w, err := c.KubeClient.CoreV1().Pods(job.Namespace).Watch(ctx, metav1.ListOptions{
+		LabelSelector: getJobPodsSelector(job),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to watch pods of Job: %w", err)
+	}
+	defer w.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case val, ok := <-w.ResultChan():
+			if !ok {
+				return nil, fmt.Errorf("unable to get a started pod for Job %q", job.Name)
+			}
+			pod, ok := val.Object.(*corev1.Pod)
+			if !ok || !metav1.IsControlledBy(pod, job) || pod.Status.Phase == corev1.PodPending || pod.Status.Phase == "" {
+				continue
+			}
+			klog.V(4).Infof("Getting logs of pod %s of Job %s", pod.Name, job.Name)
+			return c.KubeClient.CoreV1().Pods(job.Namespace).GetLogs(pod.Name, &corev1.PodLogOptions{
+				Container: containerName,
+				Follow:    true,
+			}).Stream(ctx)
+		}
+	}
+}
+
+// DeleteJob deletes the Job with the given name, and the pods it created, from the current namespace.
+// No error is returned if the Job does not exist.
+func (c *Client) DeleteJob(jobName string) error {
+	propagation := metav1.DeletePropagationBackground
+	err := c.KubeClient.BatchV1().Jobs(c.Namespace).Delete(context.TODO(), jobName, metav1.DeleteOptions{
+		PropagationPolicy: &propagation,
+	})
+	if err != nil && !kerrors.IsNotFound(err) {
+		return fmt.Errorf("unable to delete Job %s: %w", jobName, err)
+	}
+	return nil
+}
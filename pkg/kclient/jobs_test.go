@@ -0,0 +1,204 @@
+package kclient
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	ktesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+)
+
+func TestCreateJob(t *testing.T) {
+	tests := []struct {
+		name          string
+		namespace     string
+		wantNamespace string
+	}{
+		{
+			name:          "Case 1: Job created in the current namespace",
+			namespace:     "",
+			wantNamespace: "current",
+		},
+		{
+			name:          "Case 2: Job created in the given namespace",
+			namespace:     "other",
+			wantNamespace: "other",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fkclient, fkclientset := FakeNew()
+			fkclient.Namespace = "current"
+
+			job := batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "myjob",
+				},
+			}
+			got, err := fkclient.CreateJob(job, tt.namespace)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got.Name != "myjob" {
+				t.Errorf("expected Job name %q, got %q", "myjob", got.Name)
+			}
+
+			actions := fkclientset.Kubernetes.Actions()
+			if len(actions) != 1 {
+				t.Fatalf("expected 1 action, got %d: %v", len(actions), actions)
+			}
+			action := actions[0].(ktesting.CreateAction)
+			if action.GetNamespace() != tt.wantNamespace {
+				t.Errorf("expected namespace %q, got %q", tt.wantNamespace, action.GetNamespace())
+			}
+		})
+	}
+}
+
+func TestDeleteJob(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing bool
+	}{
+		{
+			name:     "Case 1: existing Job is deleted",
+			existing: true,
+		},
+		{
+			name:     "Case 2: no error if the Job does not exist",
+			existing: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fkclient, _ := FakeNew()
+			fkclient.Namespace = "current"
+
+			if tt.existing {
+				_, err := fkclient.CreateJob(batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "myjob"}}, "")
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+			}
+
+			err := fkclient.DeleteJob("myjob")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+// getJobOwnerReferences returns the owner references set by the Job controller on the pods of the Job with the uid
+func getJobOwnerReferences(uid types.UID) []metav1.OwnerReference {
+	return []metav1.OwnerReference{{APIVersion: "batch/v1", Kind: "Job", Name: "myjob", UID: uid, Controller: pointer.Bool(true)}}
+}
+
+func TestWaitForJobToComplete(t *testing.T) {
+	tests := []struct {
+		name    string
+		job     *batchv1.Job
+		pod     *corev1.Pod
+		wantJob bool
+		wantErr string
+	}{
+		{
+			name: "Case 1: Job completed",
+			job: &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "myjob", UID: "new-uid"},
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+				},
+			},
+			wantJob: true,
+		},
+		{
+			name: "Case 2: Job failed",
+			job: &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "myjob", UID: "new-uid"},
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Message: "BackoffLimitExceeded"}},
+				},
+			},
+			wantJob: true,
+			wantErr: "job myjob failed: BackoffLimitExceeded",
+		},
+		{
+			name: "Case 3: image of the pod cannot be pulled",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "myjob-abcde", OwnerReferences: getJobOwnerReferences("new-uid")},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodPending,
+					ContainerStatuses: []corev1.ContainerStatus{{
+						Name:  "runtime",
+						State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}},
+					}},
+				},
+			},
+			wantErr: "container runtime of job myjob cannot be started: ImagePullBackOff",
+		},
+		{
+			name: "Case 4: pod and status of a previous Job with the same name",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "myjob-fghij", OwnerReferences: getJobOwnerReferences("old-uid")},
+				Status: corev1.PodStatus{
+					Phase: corev1.PodPending,
+					ContainerStatuses: []corev1.ContainerStatus{{
+						Name:  "runtime",
+						State: corev1.ContainerState{Waiting: &corev1.ContainerStateWaiting{Reason: "ImagePullBackOff", Message: "Back-off pulling image"}},
+					}},
+				},
+			},
+			job: &batchv1.Job{
+				ObjectMeta: metav1.ObjectMeta{Name: "myjob", UID: "old-uid"},
+				Status: batchv1.JobStatus{
+					Conditions: []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+				},
+			},
+			wantErr: "job myjob is not complete after",
+		},
+		{
+			name: "Case 5: Job not complete before the timeout",
+			pod: &corev1.Pod{
+				ObjectMeta: metav1.ObjectMeta{Name: "myjob-abcde", OwnerReferences: getJobOwnerReferences("new-uid")},
+				Status:     corev1.PodStatus{Phase: corev1.PodPending},
+			},
+			wantErr: "job myjob is not complete after",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fkclient, fkclientset := FakeNew()
+			jobWatcher := watch.NewFake()
+			podWatcher := watch.NewFake()
+			fkclientset.Kubernetes.PrependWatchReactor("jobs", ktesting.DefaultWatchReactor(jobWatcher, nil))
+			fkclientset.Kubernetes.PrependWatchReactor("pods", ktesting.DefaultWatchReactor(podWatcher, nil))
+			go func() {
+				if tt.pod != nil {
+					podWatcher.Add(tt.pod)
+				}
+				if tt.job != nil {
+					jobWatcher.Modify(tt.job)
+				}
+			}()
+
+			got, err := fkclient.WaitForJobToComplete(context.Background(), &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: "myjob", UID: "new-uid"}}, 100*time.Millisecond)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Errorf("expected error containing %q, got %v", tt.wantErr, err)
+			}
+			if (got != nil) != tt.wantJob {
+				t.Errorf("expected Job returned: %v, got %v", tt.wantJob, got)
+			}
+		})
+	}
+}
@@ -17,10 +17,11 @@ import (
 	v1alpha10 "github.com/redhat-developer/service-binding-operator/apis/binding/v1alpha1"
 	v1alpha3 "github.com/redhat-developer/service-binding-operator/apis/spec/v1alpha3"
 	v10 "k8s.io/api/apps/v1"
-	v11 "k8s.io/api/core/v1"
-	v12 "k8s.io/api/networking/v1"
+	v11 "k8s.io/api/batch/v1"
+	v12 "k8s.io/api/core/v1"
+	v13 "k8s.io/api/networking/v1"
 	meta "k8s.io/apimachinery/pkg/api/meta"
-	v13 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	v14 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	schema "k8s.io/apimachinery/pkg/runtime/schema"
 	watch "k8s.io/apimachinery/pkg/watch"
@@ -29,6 +30,7 @@ import (
 	kubernetes "k8s.io/client-go/kubernetes"
 	rest "k8s.io/client-go/rest"
 	clientcmd "k8s.io/client-go/tools/clientcmd"
+	remotecommand "k8s.io/client-go/tools/remotecommand"
 )
 
 // MockClientInterface is a mock of ClientInterface interface.
@@ -84,11 +86,26 @@ func (mr *MockClientInterfaceMockRecorder) CreateDeployment(deploy interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateDeployment", reflect.TypeOf((*MockClientInterface)(nil).CreateDeployment), deploy)
 }
 
+// CreateJob mocks base method.
+func (m *MockClientInterface) CreateJob(job v11.Job, namespace string) (*v11.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateJob", job, namespace)
+	ret0, _ := ret[0].(*v11.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateJob indicates an expected call of CreateJob.
+func (mr *MockClientInterfaceMockRecorder) CreateJob(job, namespace interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateJob", reflect.TypeOf((*MockClientInterface)(nil).CreateJob), job, namespace)
+}
+
 // CreateNamespace mocks base method.
-func (m *MockClientInterface) CreateNamespace(name string) (*v11.Namespace, error) {
+func (m *MockClientInterface) CreateNamespace(name string) (*v12.Namespace, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateNamespace", name)
-	ret0, _ := ret[0].(*v11.Namespace)
+	ret0, _ := ret[0].(*v12.Namespace)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -114,10 +131,10 @@ func (mr *MockClientInterfaceMockRecorder) CreateNewProject(projectName, wait in
 }
 
 // CreatePVC mocks base method.
-func (m *MockClientInterface) CreatePVC(pvc v11.PersistentVolumeClaim) (*v11.PersistentVolumeClaim, error) {
+func (m *MockClientInterface) CreatePVC(pvc v12.PersistentVolumeClaim) (*v12.PersistentVolumeClaim, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreatePVC", pvc)
-	ret0, _ := ret[0].(*v11.PersistentVolumeClaim)
+	ret0, _ := ret[0].(*v12.PersistentVolumeClaim)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -129,7 +146,7 @@ func (mr *MockClientInterfaceMockRecorder) CreatePVC(pvc interface{}) *gomock.Ca
 }
 
 // CreateSecret mocks base method.
-func (m *MockClientInterface) CreateSecret(objectMeta v13.ObjectMeta, data map[string]string, ownerReference v13.OwnerReference) error {
+func (m *MockClientInterface) CreateSecret(objectMeta v14.ObjectMeta, data map[string]string, ownerReference v14.OwnerReference) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSecret", objectMeta, data, ownerReference)
 	ret0, _ := ret[0].(error)
@@ -143,7 +160,7 @@ func (mr *MockClientInterfaceMockRecorder) CreateSecret(objectMeta, data, ownerR
 }
 
 // CreateSecrets mocks base method.
-func (m *MockClientInterface) CreateSecrets(componentName string, commonObjectMeta v13.ObjectMeta, svc *v11.Service, ownerReference v13.OwnerReference) error {
+func (m *MockClientInterface) CreateSecrets(componentName string, commonObjectMeta v14.ObjectMeta, svc *v12.Service, ownerReference v14.OwnerReference) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateSecrets", componentName, commonObjectMeta, svc, ownerReference)
 	ret0, _ := ret[0].(error)
@@ -157,10 +174,10 @@ func (mr *MockClientInterfaceMockRecorder) CreateSecrets(componentName, commonOb
 }
 
 // CreateService mocks base method.
-func (m *MockClientInterface) CreateService(svc v11.Service) (*v11.Service, error) {
+func (m *MockClientInterface) CreateService(svc v12.Service) (*v12.Service, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateService", svc)
-	ret0, _ := ret[0].(*v11.Service)
+	ret0, _ := ret[0].(*v12.Service)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -172,10 +189,10 @@ func (mr *MockClientInterfaceMockRecorder) CreateService(svc interface{}) *gomoc
 }
 
 // CreateTLSSecret mocks base method.
-func (m *MockClientInterface) CreateTLSSecret(tlsCertificate, tlsPrivKey []byte, objectMeta v13.ObjectMeta) (*v11.Secret, error) {
+func (m *MockClientInterface) CreateTLSSecret(tlsCertificate, tlsPrivKey []byte, objectMeta v14.ObjectMeta) (*v12.Secret, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "CreateTLSSecret", tlsCertificate, tlsPrivKey, objectMeta)
-	ret0, _ := ret[0].(*v11.Secret)
+	ret0, _ := ret[0].(*v12.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -200,6 +217,20 @@ func (mr *MockClientInterfaceMockRecorder) DeleteDynamicResource(name, gvr, wait
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteDynamicResource", reflect.TypeOf((*MockClientInterface)(nil).DeleteDynamicResource), name, gvr, wait)
 }
 
+// DeleteJob mocks base method.
+func (m *MockClientInterface) DeleteJob(jobName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteJob", jobName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteJob indicates an expected call of DeleteJob.
+func (mr *MockClientInterfaceMockRecorder) DeleteJob(jobName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteJob", reflect.TypeOf((*MockClientInterface)(nil).DeleteJob), jobName)
+}
+
 // DeleteNamespace mocks base method.
 func (m *MockClientInterface) DeleteNamespace(name string, wait bool) error {
 	m.ctrl.T.Helper()
@@ -299,6 +330,20 @@ func (mr *MockClientInterfaceMockRecorder) ExecCMDInContainer(containerName, pod
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecCMDInContainer", reflect.TypeOf((*MockClientInterface)(nil).ExecCMDInContainer), containerName, podName, cmd, stdout, stderr, stdin, tty)
 }
 
+// ExecInteractiveCMDInContainer mocks base method.
+func (m *MockClientInterface) ExecInteractiveCMDInContainer(containerName, podName string, cmd []string, stdin io.Reader, stdout io.Writer, sizeQueue remotecommand.TerminalSizeQueue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecInteractiveCMDInContainer", containerName, podName, cmd, stdin, stdout, sizeQueue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecInteractiveCMDInContainer indicates an expected call of ExecInteractiveCMDInContainer.
+func (mr *MockClientInterfaceMockRecorder) ExecInteractiveCMDInContainer(containerName, podName, cmd, stdin, stdout, sizeQueue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecInteractiveCMDInContainer", reflect.TypeOf((*MockClientInterface)(nil).ExecInteractiveCMDInContainer), containerName, podName, cmd, stdin, stdout, sizeQueue)
+}
+
 // GeneratePortForwardReq mocks base method.
 func (m *MockClientInterface) GeneratePortForwardReq(podName string) *rest.Request {
 	m.ctrl.T.Helper()
@@ -314,10 +359,10 @@ func (mr *MockClientInterfaceMockRecorder) GeneratePortForwardReq(podName interf
 }
 
 // GetAllPodsInNamespaceMatchingSelector mocks base method.
-func (m *MockClientInterface) GetAllPodsInNamespaceMatchingSelector(selector, ns string) (*v11.PodList, error) {
+func (m *MockClientInterface) GetAllPodsInNamespaceMatchingSelector(selector, ns string) (*v12.PodList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetAllPodsInNamespaceMatchingSelector", selector, ns)
-	ret0, _ := ret[0].(*v11.PodList)
+	ret0, _ := ret[0].(*v12.PodList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -591,11 +636,26 @@ func (mr *MockClientInterfaceMockRecorder) GetGVRFromGVK(gvk interface{}) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetGVRFromGVK", reflect.TypeOf((*MockClientInterface)(nil).GetGVRFromGVK), gvk)
 }
 
+// GetJobLogs mocks base method.
+func (m *MockClientInterface) GetJobLogs(ctx context.Context, job *v11.Job, containerName string) (io.ReadCloser, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetJobLogs", ctx, job, containerName)
+	ret0, _ := ret[0].(io.ReadCloser)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetJobLogs indicates an expected call of GetJobLogs.
+func (mr *MockClientInterfaceMockRecorder) GetJobLogs(ctx, job, containerName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetJobLogs", reflect.TypeOf((*MockClientInterface)(nil).GetJobLogs), ctx, job, containerName)
+}
+
 // GetNamespace mocks base method.
-func (m *MockClientInterface) GetNamespace(name string) (*v11.Namespace, error) {
+func (m *MockClientInterface) GetNamespace(name string) (*v12.Namespace, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetNamespace", name)
-	ret0, _ := ret[0].(*v11.Namespace)
+	ret0, _ := ret[0].(*v12.Namespace)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -607,10 +667,10 @@ func (mr *MockClientInterfaceMockRecorder) GetNamespace(name interface{}) *gomoc
 }
 
 // GetNamespaceNormal mocks base method.
-func (m *MockClientInterface) GetNamespaceNormal(name string) (*v11.Namespace, error) {
+func (m *MockClientInterface) GetNamespaceNormal(name string) (*v12.Namespace, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetNamespaceNormal", name)
-	ret0, _ := ret[0].(*v11.Namespace)
+	ret0, _ := ret[0].(*v12.Namespace)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -682,10 +742,10 @@ func (mr *MockClientInterfaceMockRecorder) GetOneDeploymentFromSelector(selector
 }
 
 // GetOneService mocks base method.
-func (m *MockClientInterface) GetOneService(componentName, appName string, isPartOfComponent bool) (*v11.Service, error) {
+func (m *MockClientInterface) GetOneService(componentName, appName string, isPartOfComponent bool) (*v12.Service, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetOneService", componentName, appName, isPartOfComponent)
-	ret0, _ := ret[0].(*v11.Service)
+	ret0, _ := ret[0].(*v12.Service)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -697,10 +757,10 @@ func (mr *MockClientInterfaceMockRecorder) GetOneService(componentName, appName,
 }
 
 // GetOneServiceFromSelector mocks base method.
-func (m *MockClientInterface) GetOneServiceFromSelector(selector string) (*v11.Service, error) {
+func (m *MockClientInterface) GetOneServiceFromSelector(selector string) (*v12.Service, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetOneServiceFromSelector", selector)
-	ret0, _ := ret[0].(*v11.Service)
+	ret0, _ := ret[0].(*v12.Service)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -727,10 +787,10 @@ func (mr *MockClientInterfaceMockRecorder) GetOperatorGVRList() *gomock.Call {
 }
 
 // GetPVCFromName mocks base method.
-func (m *MockClientInterface) GetPVCFromName(pvcName string) (*v11.PersistentVolumeClaim, error) {
+func (m *MockClientInterface) GetPVCFromName(pvcName string) (*v12.PersistentVolumeClaim, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPVCFromName", pvcName)
-	ret0, _ := ret[0].(*v11.PersistentVolumeClaim)
+	ret0, _ := ret[0].(*v12.PersistentVolumeClaim)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -757,10 +817,10 @@ func (mr *MockClientInterfaceMockRecorder) GetPodLogs(podName, containerName, fo
 }
 
 // GetPodUsingComponentName mocks base method.
-func (m *MockClientInterface) GetPodUsingComponentName(componentName string) (*v11.Pod, error) {
+func (m *MockClientInterface) GetPodUsingComponentName(componentName string) (*v12.Pod, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPodUsingComponentName", componentName)
-	ret0, _ := ret[0].(*v11.Pod)
+	ret0, _ := ret[0].(*v12.Pod)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -772,10 +832,10 @@ func (mr *MockClientInterfaceMockRecorder) GetPodUsingComponentName(componentNam
 }
 
 // GetPodsMatchingSelector mocks base method.
-func (m *MockClientInterface) GetPodsMatchingSelector(selector string) (*v11.PodList, error) {
+func (m *MockClientInterface) GetPodsMatchingSelector(selector string) (*v12.PodList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetPodsMatchingSelector", selector)
-	ret0, _ := ret[0].(*v11.PodList)
+	ret0, _ := ret[0].(*v12.PodList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -847,10 +907,10 @@ func (mr *MockClientInterfaceMockRecorder) GetRestMappingFromUnstructured(arg0 i
 }
 
 // GetRunningPodFromSelector mocks base method.
-func (m *MockClientInterface) GetRunningPodFromSelector(selector string) (*v11.Pod, error) {
+func (m *MockClientInterface) GetRunningPodFromSelector(selector string) (*v12.Pod, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetRunningPodFromSelector", selector)
-	ret0, _ := ret[0].(*v11.Pod)
+	ret0, _ := ret[0].(*v12.Pod)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -862,10 +922,10 @@ func (mr *MockClientInterfaceMockRecorder) GetRunningPodFromSelector(selector in
 }
 
 // GetSecret mocks base method.
-func (m *MockClientInterface) GetSecret(name, namespace string) (*v11.Secret, error) {
+func (m *MockClientInterface) GetSecret(name, namespace string) (*v12.Secret, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "GetSecret", name, namespace)
-	ret0, _ := ret[0].(*v11.Secret)
+	ret0, _ := ret[0].(*v12.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1057,10 +1117,10 @@ func (mr *MockClientInterfaceMockRecorder) ListDynamicResources(namespace, gvr,
 }
 
 // ListIngresses mocks base method.
-func (m *MockClientInterface) ListIngresses(namespace, selector string) (*v12.IngressList, error) {
+func (m *MockClientInterface) ListIngresses(namespace, selector string) (*v13.IngressList, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListIngresses", namespace, selector)
-	ret0, _ := ret[0].(*v12.IngressList)
+	ret0, _ := ret[0].(*v13.IngressList)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1087,10 +1147,10 @@ func (mr *MockClientInterfaceMockRecorder) ListPVCNames(selector interface{}) *g
 }
 
 // ListPVCs mocks base method.
-func (m *MockClientInterface) ListPVCs(selector string) ([]v11.PersistentVolumeClaim, error) {
+func (m *MockClientInterface) ListPVCs(selector string) ([]v12.PersistentVolumeClaim, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListPVCs", selector)
-	ret0, _ := ret[0].([]v11.PersistentVolumeClaim)
+	ret0, _ := ret[0].([]v12.PersistentVolumeClaim)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1117,10 +1177,10 @@ func (mr *MockClientInterfaceMockRecorder) ListProjectNames() *gomock.Call {
 }
 
 // ListSecrets mocks base method.
-func (m *MockClientInterface) ListSecrets(labelSelector string) ([]v11.Secret, error) {
+func (m *MockClientInterface) ListSecrets(labelSelector string) ([]v12.Secret, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListSecrets", labelSelector)
-	ret0, _ := ret[0].([]v11.Secret)
+	ret0, _ := ret[0].([]v12.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1148,10 +1208,10 @@ func (mr *MockClientInterfaceMockRecorder) ListServiceBindingsFromAllGroups() *g
 }
 
 // ListServices mocks base method.
-func (m *MockClientInterface) ListServices(selector string) ([]v11.Service, error) {
+func (m *MockClientInterface) ListServices(selector string) ([]v12.Service, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "ListServices", selector)
-	ret0, _ := ret[0].([]v11.Service)
+	ret0, _ := ret[0].([]v12.Service)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1291,21 +1351,21 @@ func (mr *MockClientInterfaceMockRecorder) SetNamespace(ns interface{}) *gomock.
 }
 
 // SetupPortForwarding mocks base method.
-func (m *MockClientInterface) SetupPortForwarding(pod *v11.Pod, portPairs []string, out, errOut io.Writer, stopChan chan struct{}) error {
+func (m *MockClientInterface) SetupPortForwarding(pod *v12.Pod, address string, portPairs []string, out, errOut io.Writer, stopChan chan struct{}) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "SetupPortForwarding", pod, portPairs, out, errOut, stopChan)
+	ret := m.ctrl.Call(m, "SetupPortForwarding", pod, address, portPairs, out, errOut, stopChan)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // SetupPortForwarding indicates an expected call of SetupPortForwarding.
-func (mr *MockClientInterfaceMockRecorder) SetupPortForwarding(pod, portPairs, out, errOut, stopChan interface{}) *gomock.Call {
+func (mr *MockClientInterfaceMockRecorder) SetupPortForwarding(pod, address, portPairs, out, errOut, stopChan interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupPortForwarding", reflect.TypeOf((*MockClientInterface)(nil).SetupPortForwarding), pod, portPairs, out, errOut, stopChan)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetupPortForwarding", reflect.TypeOf((*MockClientInterface)(nil).SetupPortForwarding), pod, address, portPairs, out, errOut, stopChan)
 }
 
 // TryWithBlockOwnerDeletion mocks base method.
-func (m *MockClientInterface) TryWithBlockOwnerDeletion(ownerReference v13.OwnerReference, exec func(v13.OwnerReference) error) error {
+func (m *MockClientInterface) TryWithBlockOwnerDeletion(ownerReference v14.OwnerReference, exec func(v14.OwnerReference) error) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "TryWithBlockOwnerDeletion", ownerReference, exec)
 	ret0, _ := ret[0].(error)
@@ -1348,7 +1408,7 @@ func (mr *MockClientInterfaceMockRecorder) UpdateDynamicResource(gvr, name, u in
 }
 
 // UpdatePVCLabels mocks base method.
-func (m *MockClientInterface) UpdatePVCLabels(pvc *v11.PersistentVolumeClaim, labels map[string]string) error {
+func (m *MockClientInterface) UpdatePVCLabels(pvc *v12.PersistentVolumeClaim, labels map[string]string) error {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdatePVCLabels", pvc, labels)
 	ret0, _ := ret[0].(error)
@@ -1362,10 +1422,10 @@ func (mr *MockClientInterfaceMockRecorder) UpdatePVCLabels(pvc, labels interface
 }
 
 // UpdateSecret mocks base method.
-func (m *MockClientInterface) UpdateSecret(secret *v11.Secret, namespace string) (*v11.Secret, error) {
+func (m *MockClientInterface) UpdateSecret(secret *v12.Secret, namespace string) (*v12.Secret, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateSecret", secret, namespace)
-	ret0, _ := ret[0].(*v11.Secret)
+	ret0, _ := ret[0].(*v12.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1377,10 +1437,10 @@ func (mr *MockClientInterfaceMockRecorder) UpdateSecret(secret, namespace interf
 }
 
 // UpdateService mocks base method.
-func (m *MockClientInterface) UpdateService(svc v11.Service) (*v11.Service, error) {
+func (m *MockClientInterface) UpdateService(svc v12.Service) (*v12.Service, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "UpdateService", svc)
-	ret0, _ := ret[0].(*v11.Service)
+	ret0, _ := ret[0].(*v12.Service)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1392,7 +1452,7 @@ func (mr *MockClientInterfaceMockRecorder) UpdateService(svc interface{}) *gomoc
 }
 
 // UpdateStorageOwnerReference mocks base method.
-func (m *MockClientInterface) UpdateStorageOwnerReference(pvc *v11.PersistentVolumeClaim, ownerReference ...v13.OwnerReference) error {
+func (m *MockClientInterface) UpdateStorageOwnerReference(pvc *v12.PersistentVolumeClaim, ownerReference ...v14.OwnerReference) error {
 	m.ctrl.T.Helper()
 	varargs := []interface{}{pvc}
 	for _, a := range ownerReference {
@@ -1411,10 +1471,10 @@ func (mr *MockClientInterfaceMockRecorder) UpdateStorageOwnerReference(pvc inter
 }
 
 // WaitAndGetSecret mocks base method.
-func (m *MockClientInterface) WaitAndGetSecret(name, namespace string) (*v11.Secret, error) {
+func (m *MockClientInterface) WaitAndGetSecret(name, namespace string) (*v12.Secret, error) {
 	m.ctrl.T.Helper()
 	ret := m.ctrl.Call(m, "WaitAndGetSecret", name, namespace)
-	ret0, _ := ret[0].(*v11.Secret)
+	ret0, _ := ret[0].(*v12.Secret)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
@@ -1425,6 +1485,21 @@ func (mr *MockClientInterfaceMockRecorder) WaitAndGetSecret(name, namespace inte
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitAndGetSecret", reflect.TypeOf((*MockClientInterface)(nil).WaitAndGetSecret), name, namespace)
 }
 
+// WaitForJobToComplete mocks base method.
+func (m *MockClientInterface) WaitForJobToComplete(ctx context.Context, job *v11.Job, timeout time.Duration) (*v11.Job, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WaitForJobToComplete", ctx, job, timeout)
+	ret0, _ := ret[0].(*v11.Job)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// WaitForJobToComplete indicates an expected call of WaitForJobToComplete.
+func (mr *MockClientInterfaceMockRecorder) WaitForJobToComplete(ctx, job, timeout interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WaitForJobToComplete", reflect.TypeOf((*MockClientInterface)(nil).WaitForJobToComplete), ctx, job, timeout)
+}
+
 // WaitForServiceAccountInNamespace mocks base method.
 func (m *MockClientInterface) WaitForServiceAccountInNamespace(namespace, serviceAccountName string) error {
 	m.ctrl.T.Helper()
@@ -19,6 +19,16 @@ import (
 
 // ExecCMDInContainer execute command in the container of a pod, pass an empty string for containerName to execute in the first container of the pod
 func (c *Client) ExecCMDInContainer(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+	return c.execCMDInContainer(containerName, podName, cmd, stdout, stderr, stdin, tty, nil)
+}
+
+// ExecInteractiveCMDInContainer executes the command in the container of a pod, in a TTY attached to stdin and stdout,
+// resized each time a new size is returned by sizeQueue
+func (c *Client) ExecInteractiveCMDInContainer(containerName, podName string, cmd []string, stdin io.Reader, stdout io.Writer, sizeQueue remotecommand.TerminalSizeQueue) error {
+	return c.execCMDInContainer(containerName, podName, cmd, stdout, nil, stdin, true, sizeQueue)
+}
+
+func (c *Client) execCMDInContainer(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool, sizeQueue remotecommand.TerminalSizeQueue) error {
 	podExecOptions := corev1.PodExecOptions{
 		Command: cmd,
 		Stdin:   stdin != nil,
@@ -52,10 +62,11 @@ func (c *Client) ExecCMDInContainer(containerName, podName string, cmd []string,
 	}
 	// initialize the transport of the standard shell streams
 	err = exec.Stream(remotecommand.StreamOptions{
-		Stdin:  stdin,
-		Stdout: stdout,
-		Stderr: stderr,
-		Tty:    tty,
+		Stdin:             stdin,
+		Stdout:            stdout,
+		Stderr:            stderr,
+		Tty:               tty,
+		TerminalSizeQueue: sizeQueue,
 	})
 	if err != nil {
 		return fmt.Errorf("error while streaming command: %w", err)
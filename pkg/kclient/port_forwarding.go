@@ -9,7 +9,7 @@ import (
 	"k8s.io/client-go/transport/spdy"
 )
 
-func (c *Client) SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}) error {
+func (c *Client) SetupPortForwarding(pod *corev1.Pod, address string, portPairs []string, out io.Writer, errOut io.Writer, stopChan chan struct{}) error {
 	transport, upgrader, err := spdy.RoundTripperFor(c.GetClientConfig())
 	if err != nil {
 		return err
@@ -20,7 +20,12 @@ func (c *Client) SetupPortForwarding(pod *corev1.Pod, portPairs []string, out io
 	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", req.URL())
 	// passing nil for readyChan because it's eventually being closed if it's not nil
 	// passing nil for out because we only care for error, not for output messages; we want to print our own messages
-	fw, err := portforward.New(dialer, portPairs, stopChan, nil, out, errOut)
+	var fw *portforward.PortForwarder
+	if address == "" {
+		fw, err = portforward.New(dialer, portPairs, stopChan, nil, out, errOut)
+	} else {
+		fw, err = portforward.NewOnAddresses(dialer, []string{address}, portPairs, stopChan, nil, out, errOut)
+	}
 	if err != nil {
 		return err
 	}
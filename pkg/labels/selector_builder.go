@@ -24,6 +24,16 @@ func (o selectorBuilder) WithComponent(name string) selectorBuilder {
 	return o
 }
 
+// WithAnyComponent selects the resources having a component name, whatever its value
+func (o selectorBuilder) WithAnyComponent() selectorBuilder {
+	req, err := labels.NewRequirement(kubernetesInstanceLabel, selection.Exists, nil)
+	if err != nil {
+		panic(err)
+	}
+	o.selector = o.selector.Add(*req)
+	return o
+}
+
 func (o selectorBuilder) WithoutSourcePVC(s string) selectorBuilder {
 	req, err := labels.NewRequirement(sourcePVCLabel, selection.NotEquals, []string{s})
 	if err != nil {
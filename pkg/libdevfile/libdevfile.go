@@ -19,6 +19,10 @@ import (
 
 const DebugEndpointNamePrefix = "debug"
 
+// SyncBackAttribute is the name of the top-level Devfile attribute listing the files and directories
+// to copy back from the container into the directory of the component
+const SyncBackAttribute = "dev.odo.sync-back"
+
 type Handler interface {
 	ApplyImage(image v1alpha2.Component) error
 	ApplyKubernetes(kubernetes v1alpha2.Component) error
@@ -69,6 +73,25 @@ func ExecuteCommandByNameAndKind(
 	return executeCommand(devfileObj, cmd, handler)
 }
 
+// ExecuteCommandByName executes the command cmdName of the Devfile, whatever its kind.
+// If ignoreCommandNotFound is true, nothing is executed if the command is not found and no error is returned.
+func ExecuteCommandByName(
+	devfileObj parser.DevfileObj,
+	cmdName string,
+	handler Handler,
+	ignoreCommandNotFound bool,
+) error {
+	cmd, err := getCommandByName(devfileObj, "", cmdName)
+	if err != nil {
+		if _, isNotFound := err.(NoCommandFoundError); isNotFound && ignoreCommandNotFound {
+			klog.V(3).Infof("ignoring command not found: %v", cmdName)
+			return nil
+		}
+		return err
+	}
+	return executeCommand(devfileObj, cmd, handler)
+}
+
 // executeCommand executes a specific command of a devfile using handler as backend
 func executeCommand(devfileObj parser.DevfileObj, command v1alpha2.Command, handler Handler) error {
 	cmd, err := newCommand(devfileObj, command)
@@ -247,6 +270,24 @@ func HasDebugCommand(devfileData data.DevfileData) bool {
 	return hasCommand(devfileData, v1alpha2.DebugCommandGroupKind)
 }
 
+// GetSyncBackPaths returns the paths listed in the SyncBackAttribute top-level attribute of the Devfile
+func GetSyncBackPaths(devfileData data.DevfileData) ([]string, error) {
+	attributes, err := devfileData.GetAttributes()
+	if err != nil {
+		// top-level attributes are not supported by this schema version
+		return nil, nil
+	}
+	if !attributes.Exists(SyncBackAttribute) {
+		return nil, nil
+	}
+	var paths []string
+	err = attributes.GetInto(SyncBackAttribute, &paths)
+	if err != nil {
+		return nil, fmt.Errorf("invalid value for the %q attribute, expected a list of paths: %w", SyncBackAttribute, err)
+	}
+	return paths, nil
+}
+
 // execDevfileEvent receives a Devfile Event (PostStart, PreStop etc.) and loops through them
 // Each Devfile Command associated with the given event is retrieved, and executed in the container specified
 // in the command
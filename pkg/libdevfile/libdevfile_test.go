@@ -6,9 +6,11 @@ import (
 	"testing"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
 	devfilepkg "github.com/devfile/api/v2/pkg/devfile"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	v2 "github.com/devfile/library/v2/pkg/devfile/parser/data/v2"
 	devfileFileSystem "github.com/devfile/library/v2/pkg/testingutil/filesystem"
 	dfutil "github.com/devfile/library/v2/pkg/util"
 	"github.com/golang/mock/gomock"
@@ -587,6 +589,110 @@ func TestBuild(t *testing.T) {
 	}
 }
 
+func TestExecuteCommandByName(t *testing.T) {
+	containerComp := v1alpha2.Component{
+		Name: "my-container",
+		ComponentUnion: v1alpha2.ComponentUnion{
+			Container: &v1alpha2.ContainerComponent{
+				Container: v1alpha2.Container{
+					Image: "my-image",
+				},
+			},
+		},
+	}
+	testCommand := generator.GetExecCommand(generator.ExecCommandParams{
+		Kind:        v1alpha2.TestCommandGroupKind,
+		Id:          "my-test",
+		CommandLine: "test my-app",
+		Component:   containerComp.Name,
+	})
+	lintCommand := v1alpha2.Command{
+		Id: "my-lint",
+		CommandUnion: v1alpha2.CommandUnion{
+			Exec: &v1alpha2.ExecCommand{
+				CommandLine: "lint my-app",
+				Component:   containerComp.Name,
+			},
+		},
+	}
+	checkCommand := v1alpha2.Command{
+		Id: "my-check",
+		CommandUnion: v1alpha2.CommandUnion{
+			Composite: &v1alpha2.CompositeCommand{
+				Commands: []string{testCommand.Id, lintCommand.Id},
+				Parallel: pointer.BoolPtr(true),
+			},
+		},
+	}
+	devfileObj := func() parser.DevfileObj {
+		dData, _ := data.NewDevfileData(string(data.APISchemaVersion200))
+		_ = dData.AddCommands([]v1alpha2.Command{testCommand, lintCommand, checkCommand})
+		_ = dData.AddComponents([]v1alpha2.Component{containerComp})
+		return parser.DevfileObj{
+			Data: dData,
+		}
+	}
+	for _, tt := range []struct {
+		name                  string
+		cmdName               string
+		ignoreCommandNotFound bool
+		handler               func(ctrl *gomock.Controller) Handler
+		wantErr               bool
+	}{
+		{
+			name:    "command of a group",
+			cmdName: "my-test",
+			handler: func(ctrl *gomock.Controller) Handler {
+				h := NewMockHandler(ctrl)
+				h.EXPECT().Execute(gomock.Eq(testCommand)).Times(1)
+				return h
+			},
+		},
+		{
+			name:    "command without group",
+			cmdName: "my-lint",
+			handler: func(ctrl *gomock.Controller) Handler {
+				h := NewMockHandler(ctrl)
+				h.EXPECT().Execute(gomock.Eq(lintCommand)).Times(1)
+				return h
+			},
+		},
+		{
+			name:    "parallel composite command",
+			cmdName: "my-check",
+			handler: func(ctrl *gomock.Controller) Handler {
+				h := NewMockHandler(ctrl)
+				h.EXPECT().Execute(gomock.Eq(testCommand)).Times(1)
+				h.EXPECT().Execute(gomock.Eq(lintCommand)).Times(1)
+				return h
+			},
+		},
+		{
+			name:    "missing command",
+			cmdName: "my-unknown",
+			handler: func(ctrl *gomock.Controller) Handler {
+				return NewMockHandler(ctrl)
+			},
+			wantErr: true,
+		},
+		{
+			name:                  "missing command ignored",
+			cmdName:               "my-unknown",
+			ignoreCommandNotFound: true,
+			handler: func(ctrl *gomock.Controller) Handler {
+				return NewMockHandler(ctrl)
+			},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ExecuteCommandByName(devfileObj(), tt.cmdName, tt.handler(gomock.NewController(t)), tt.ignoreCommandNotFound)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ExecuteCommandByName() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
 func TestGetContainerEndpointMapping(t *testing.T) {
 	type args struct {
 		containers   []v1alpha2.Component
@@ -2079,3 +2185,56 @@ func getExecCommand(id string, group v1alpha2.CommandGroupKind) v1alpha2.Command
 	}
 
 }
+
+func TestGetSyncBackPaths(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		schemaVersion string
+		value         interface{}
+		want          []string
+		wantErr       bool
+	}{
+		{
+			name:          "no attribute",
+			schemaVersion: string(data.APISchemaVersion220),
+		},
+		{
+			name:          "top-level attributes not supported",
+			schemaVersion: string(data.APISchemaVersion200),
+		},
+		{
+			name:          "list of paths",
+			schemaVersion: string(data.APISchemaVersion220),
+			value:         []string{"package-lock.json", "gen"},
+			want:          []string{"package-lock.json", "gen"},
+		},
+		{
+			name:          "invalid value",
+			schemaVersion: string(data.APISchemaVersion220),
+			value:         1,
+			wantErr:       true,
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, err := data.NewDevfileData(tt.schemaVersion)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.value != nil {
+				// attributes are not initialized in a new devfile
+				devfileData.(*v2.DevfileV2).Attributes = attributes.Attributes{}
+				err = devfileData.AddAttributes(SyncBackAttribute, tt.value)
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			got, err := GetSyncBackPaths(devfileData)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("GetSyncBackPaths() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("GetSyncBackPaths() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
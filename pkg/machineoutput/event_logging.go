@@ -7,6 +7,7 @@ import (
 	"io"
 	"time"
 
+	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/log"
 
 	"k8s.io/klog"
@@ -46,7 +47,7 @@ func (c *NoOpMachineEventLoggingClient) DevFileCommandExecutionBegin(commandID s
 }
 
 // DevFileCommandExecutionComplete ignores the provided event.
-func (c *NoOpMachineEventLoggingClient) DevFileCommandExecutionComplete(commandID string, componentName string, commandLine string, groupKind string, timestamp string, errorVal error) {
+func (c *NoOpMachineEventLoggingClient) DevFileCommandExecutionComplete(commandID string, componentName string, commandLine string, groupKind string, timestamp string, exitCode int, errorVal error) {
 }
 
 // CreateContainerOutputWriter ignores the provided event.
@@ -85,6 +86,23 @@ func (c *NoOpMachineEventLoggingClient) KubernetesPodStatus(pods []KubernetesPod
 
 }
 
+// ReconcileStart ignores the provided event.
+func (c *NoOpMachineEventLoggingClient) ReconcileStart(timestamp string) {}
+
+// ReconcileComplete ignores the provided event.
+func (c *NoOpMachineEventLoggingClient) ReconcileComplete(timestamp string, errorVal error) {}
+
+// FilesSynced ignores the provided event.
+func (c *NoOpMachineEventLoggingClient) FilesSynced(filesChanged []string, filesDeleted []string, timestamp string) {
+}
+
+// FilesSyncedBack ignores the provided event.
+func (c *NoOpMachineEventLoggingClient) FilesSyncedBack(filesChanged []string, conflicts []string, timestamp string) {
+}
+
+// PortsForwarded ignores the provided event.
+func (c *NoOpMachineEventLoggingClient) PortsForwarded(ports []api.ForwardedPort, timestamp string) {}
+
 // NewConsoleMachineEventLoggingClient creates a new instance of ConsoleMachineEventLoggingClient,
 // which will output events as JSON to the console.
 func NewConsoleMachineEventLoggingClient() *ConsoleMachineEventLoggingClient {
@@ -110,7 +128,7 @@ func (c *ConsoleMachineEventLoggingClient) DevFileCommandExecutionBegin(commandI
 }
 
 // DevFileCommandExecutionComplete outputs the provided event as JSON to the console.
-func (c *ConsoleMachineEventLoggingClient) DevFileCommandExecutionComplete(commandID string, componentName string, commandLine string, groupKind string, timestamp string, errorVal error) {
+func (c *ConsoleMachineEventLoggingClient) DevFileCommandExecutionComplete(commandID string, componentName string, commandLine string, groupKind string, timestamp string, exitCode int, errorVal error) {
 
 	errorStr := ""
 
@@ -127,7 +145,8 @@ func (c *ConsoleMachineEventLoggingClient) DevFileCommandExecutionComplete(comma
 				GroupKind:        groupKind,
 				AbstractLogEvent: AbstractLogEvent{Timestamp: timestamp},
 			},
-			Error: errorStr,
+			ExitCode: exitCode,
+			Error:    errorStr,
 		},
 	}
 
@@ -203,7 +222,83 @@ func (c *ConsoleMachineEventLoggingClient) KubernetesPodStatus(pods []Kubernetes
 	c.outputJSON(json)
 }
 
+// ReconcileStart outputs the provided event as JSON to the console.
+func (c *ConsoleMachineEventLoggingClient) ReconcileStart(timestamp string) {
+	json := MachineEventWrapper{
+		ReconcileStart: &ReconcileStart{
+			AbstractLogEvent: AbstractLogEvent{Timestamp: timestamp},
+		},
+	}
+	c.outputJSON(json)
+}
+
+// ReconcileComplete outputs the provided event as JSON to the console.
+func (c *ConsoleMachineEventLoggingClient) ReconcileComplete(timestamp string, errorVal error) {
+	errorStr := ""
+	if errorVal != nil {
+		errorStr = errorVal.Error()
+	}
+	json := MachineEventWrapper{
+		ReconcileComplete: &ReconcileComplete{
+			Error:            errorStr,
+			AbstractLogEvent: AbstractLogEvent{Timestamp: timestamp},
+		},
+	}
+	c.outputJSON(json)
+}
+
+// FilesSynced outputs the provided event as JSON to the console.
+func (c *ConsoleMachineEventLoggingClient) FilesSynced(filesChanged []string, filesDeleted []string, timestamp string) {
+	if filesChanged == nil {
+		filesChanged = []string{}
+	}
+	if filesDeleted == nil {
+		filesDeleted = []string{}
+	}
+	json := MachineEventWrapper{
+		FilesSynced: &FilesSynced{
+			FilesChanged:     filesChanged,
+			FilesDeleted:     filesDeleted,
+			AbstractLogEvent: AbstractLogEvent{Timestamp: timestamp},
+		},
+	}
+	c.outputJSON(json)
+}
+
+// FilesSyncedBack outputs the provided event as JSON to the console.
+func (c *ConsoleMachineEventLoggingClient) FilesSyncedBack(filesChanged []string, conflicts []string, timestamp string) {
+	if filesChanged == nil {
+		filesChanged = []string{}
+	}
+	if conflicts == nil {
+		conflicts = []string{}
+	}
+	json := MachineEventWrapper{
+		FilesSyncedBack: &FilesSyncedBack{
+			FilesChanged:     filesChanged,
+			Conflicts:        conflicts,
+			AbstractLogEvent: AbstractLogEvent{Timestamp: timestamp},
+		},
+	}
+	c.outputJSON(json)
+}
+
+// PortsForwarded outputs the provided event as JSON to the console.
+func (c *ConsoleMachineEventLoggingClient) PortsForwarded(ports []api.ForwardedPort, timestamp string) {
+	if ports == nil {
+		ports = []api.ForwardedPort{}
+	}
+	json := MachineEventWrapper{
+		PortsForwarded: &PortsForwarded{
+			Ports:            ports,
+			AbstractLogEvent: AbstractLogEvent{Timestamp: timestamp},
+		},
+	}
+	c.outputJSON(json)
+}
+
 func (c *ConsoleMachineEventLoggingClient) outputJSON(machineOutput MachineEventWrapper) {
+	machineOutput.SchemaVersion = EventSchemaVersion
 
 	if c.logFunc != nil {
 		c.logFunc(machineOutput)
@@ -244,6 +339,26 @@ func (w MachineEventWrapper) GetEntry() (MachineEventLogEntry, error) {
 		return w.URLReachable, nil
 	}
 
+	if w.ReconcileStart != nil {
+		return w.ReconcileStart, nil
+	}
+
+	if w.ReconcileComplete != nil {
+		return w.ReconcileComplete, nil
+	}
+
+	if w.FilesSynced != nil {
+		return w.FilesSynced, nil
+	}
+
+	if w.PortsForwarded != nil {
+		return w.PortsForwarded, nil
+	}
+
+	if w.FilesSyncedBack != nil {
+		return w.FilesSyncedBack, nil
+	}
+
 	return nil, errors.New("unexpected machine event log entry")
 }
 
@@ -275,6 +390,21 @@ func (c URLReachable) GetType() MachineEventLogEntryType { return TypeURLReachab
 // GetType returns the event type for this event.
 func (c KubernetesPodStatus) GetType() MachineEventLogEntryType { return TypeKubernetesPodStatus }
 
+// GetType returns the event type for this event.
+func (c ReconcileStart) GetType() MachineEventLogEntryType { return TypeReconcileStart }
+
+// GetType returns the event type for this event.
+func (c ReconcileComplete) GetType() MachineEventLogEntryType { return TypeReconcileComplete }
+
+// GetType returns the event type for this event.
+func (c FilesSynced) GetType() MachineEventLogEntryType { return TypeFilesSynced }
+
+// GetType returns the event type for this event.
+func (c PortsForwarded) GetType() MachineEventLogEntryType { return TypePortsForwarded }
+
+// GetType returns the event type for this event.
+func (c FilesSyncedBack) GetType() MachineEventLogEntryType { return TypeFilesSyncedBack }
+
 // MachineEventLogEntryType indicates the machine-readable event type from an ODO operation
 type MachineEventLogEntryType int
 
@@ -293,6 +423,16 @@ const (
 	TypeURLReachable MachineEventLogEntryType = 6
 	// TypeKubernetesPodStatus is the entry type for that event.
 	TypeKubernetesPodStatus MachineEventLogEntryType = 7
+	// TypeReconcileStart is the entry type for that event.
+	TypeReconcileStart MachineEventLogEntryType = 8
+	// TypeReconcileComplete is the entry type for that event.
+	TypeReconcileComplete MachineEventLogEntryType = 9
+	// TypeFilesSynced is the entry type for that event.
+	TypeFilesSynced MachineEventLogEntryType = 10
+	// TypePortsForwarded is the entry type for that event.
+	TypePortsForwarded MachineEventLogEntryType = 11
+	// TypeFilesSyncedBack is the entry type for that event.
+	TypeFilesSyncedBack MachineEventLogEntryType = 12
 )
 
 // createWriterAndChannel is similar to the exec.CreateConsoleOutputWriterAndChannel(); see that function's comment for details.
@@ -320,6 +460,7 @@ func createWriterAndChannel(stderr bool) (*io.PipeWriter, chan interface{}) {
 
 			// Output log text event for each line we receive
 			json := MachineEventWrapper{
+				SchemaVersion: EventSchemaVersion,
 				LogText: &LogText{
 					AbstractLogEvent: AbstractLogEvent{Timestamp: TimestampNow()},
 					Text:             string(line),
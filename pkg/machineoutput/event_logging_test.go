@@ -0,0 +1,123 @@
+package machineoutput
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func TestConsoleMachineEventLoggingClient(t *testing.T) {
+	tests := []struct {
+		name     string
+		emit     func(c *ConsoleMachineEventLoggingClient)
+		want     MachineEventLogEntry
+		wantType MachineEventLogEntryType
+	}{
+		{
+			name: "reconcile start",
+			emit: func(c *ConsoleMachineEventLoggingClient) {
+				c.ReconcileStart("1.000000")
+			},
+			want:     &ReconcileStart{AbstractLogEvent: AbstractLogEvent{Timestamp: "1.000000"}},
+			wantType: TypeReconcileStart,
+		},
+		{
+			name: "reconcile complete with an error",
+			emit: func(c *ConsoleMachineEventLoggingClient) {
+				c.ReconcileComplete("1.000000", errors.New("an error"))
+			},
+			want:     &ReconcileComplete{Error: "an error", AbstractLogEvent: AbstractLogEvent{Timestamp: "1.000000"}},
+			wantType: TypeReconcileComplete,
+		},
+		{
+			name: "files synced without deleted files",
+			emit: func(c *ConsoleMachineEventLoggingClient) {
+				c.FilesSynced([]string{"server.js"}, nil, "1.000000")
+			},
+			want: &FilesSynced{
+				FilesChanged:     []string{"server.js"},
+				FilesDeleted:     []string{},
+				AbstractLogEvent: AbstractLogEvent{Timestamp: "1.000000"},
+			},
+			wantType: TypeFilesSynced,
+		},
+		{
+			name: "files synced back without conflicts",
+			emit: func(c *ConsoleMachineEventLoggingClient) {
+				c.FilesSyncedBack([]string{"package-lock.json"}, nil, "1.000000")
+			},
+			want: &FilesSyncedBack{
+				FilesChanged:     []string{"package-lock.json"},
+				Conflicts:        []string{},
+				AbstractLogEvent: AbstractLogEvent{Timestamp: "1.000000"},
+			},
+			wantType: TypeFilesSyncedBack,
+		},
+		{
+			name: "ports forwarded",
+			emit: func(c *ConsoleMachineEventLoggingClient) {
+				c.PortsForwarded([]api.ForwardedPort{{ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: 20001, ContainerPort: 3000}}, "1.000000")
+			},
+			want: &PortsForwarded{
+				Ports:            []api.ForwardedPort{{ContainerName: "runtime", LocalAddress: "127.0.0.1", LocalPort: 20001, ContainerPort: 3000}},
+				AbstractLogEvent: AbstractLogEvent{Timestamp: "1.000000"},
+			},
+			wantType: TypePortsForwarded,
+		},
+		{
+			name: "command complete with an exit code",
+			emit: func(c *ConsoleMachineEventLoggingClient) {
+				c.DevFileCommandExecutionComplete("build", "runtime", "npm install", "build", "1.000000", 2, errors.New("an error"))
+			},
+			want: &DevFileCommandExecutionComplete{
+				DevFileCommandExecutionBegin: DevFileCommandExecutionBegin{
+					CommandID:        "build",
+					ComponentName:    "runtime",
+					CommandLine:      "npm install",
+					GroupKind:        "build",
+					AbstractLogEvent: AbstractLogEvent{Timestamp: "1.000000"},
+				},
+				ExitCode: 2,
+				Error:    "an error",
+			},
+			wantType: TypeDevFileCommandExecutionComplete,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var outputs []MachineEventWrapper
+			c := &ConsoleMachineEventLoggingClient{
+				logFunc: func(machineOutput MachineEventWrapper) {
+					outputs = append(outputs, machineOutput)
+				},
+			}
+			tt.emit(c)
+
+			if len(outputs) != 1 {
+				t.Fatalf("expected 1 event, got %d", len(outputs))
+			}
+			if outputs[0].SchemaVersion != EventSchemaVersion {
+				t.Errorf("schema version = %q, want %q", outputs[0].SchemaVersion, EventSchemaVersion)
+			}
+			got, err := outputs[0].GetEntry()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("event mismatch (-want +got):\n%s", diff)
+			}
+			typed, ok := got.(interface {
+				GetType() MachineEventLogEntryType
+			})
+			if !ok {
+				t.Fatalf("event does not have a type")
+			}
+			if typed.GetType() != tt.wantType {
+				t.Errorf("type = %d, want %d", typed.GetType(), tt.wantType)
+			}
+		})
+	}
+}
@@ -4,8 +4,14 @@ import (
 	"io"
 
 	corev1 "k8s.io/api/core/v1"
+
+	"github.com/redhat-developer/odo/pkg/api"
 )
 
+// EventSchemaVersion is the version of the schema of the machine-readable events.
+// It must be incremented when the structure of an existing event is changed in an incompatible way.
+const EventSchemaVersion = "1"
+
 // MachineEventLoggingClient is an interface which is used by consuming code to output machine-readable
 // event JSON to the console. Both no-op and non-no-op implementations of this interface exist.
 type MachineEventLoggingClient interface {
@@ -13,9 +19,18 @@ type MachineEventLoggingClient interface {
 	// These functions output the corresponding eponymous JSON event to the console
 
 	DevFileCommandExecutionBegin(commandID string, componentName string, commandLine string, groupKind string, timestamp string)
-	DevFileCommandExecutionComplete(commandID string, componentName string, commandLine string, groupKind string, timestamp string, errorVal error)
+	DevFileCommandExecutionComplete(commandID string, componentName string, commandLine string, groupKind string, timestamp string, exitCode int, errorVal error)
 	ReportError(errorVal error, timestamp string)
 
+	ReconcileStart(timestamp string)
+	ReconcileComplete(timestamp string, errorVal error)
+
+	FilesSynced(filesChanged []string, filesDeleted []string, timestamp string)
+
+	FilesSyncedBack(filesChanged []string, conflicts []string, timestamp string)
+
+	PortsForwarded(ports []api.ForwardedPort, timestamp string)
+
 	ContainerStatus(statuses []ContainerStatusEntry, timestamp string)
 
 	URLReachable(name string, url string, port int, secure bool, kind string, reachable bool, timestamp string)
@@ -29,6 +44,8 @@ type MachineEventLoggingClient interface {
 // MachineEventWrapper - a single line of machine-readable event console output must contain only one
 // of these commands; the MachineEventWrapper is used to create (and parse, for tests) these lines.
 type MachineEventWrapper struct {
+	// SchemaVersion is the version of the schema of the event, set to EventSchemaVersion
+	SchemaVersion                   string                           `json:"schemaVersion,omitempty"`
 	DevFileCommandExecutionBegin    *DevFileCommandExecutionBegin    `json:"devFileCommandExecutionBegin,omitempty"`
 	DevFileCommandExecutionComplete *DevFileCommandExecutionComplete `json:"devFileCommandExecutionComplete,omitempty"`
 	LogText                         *LogText                         `json:"logText,omitempty"`
@@ -36,6 +53,11 @@ type MachineEventWrapper struct {
 	ContainerStatus                 *ContainerStatus                 `json:"containerStatus,omitempty"`
 	URLReachable                    *URLReachable                    `json:"urlReachable,omitempty"`
 	KubernetesPodStatus             *KubernetesPodStatus             `json:"kubernetesPodStatus,omitempty"`
+	ReconcileStart                  *ReconcileStart                  `json:"reconcileStart,omitempty"`
+	ReconcileComplete               *ReconcileComplete               `json:"reconcileComplete,omitempty"`
+	FilesSynced                     *FilesSynced                     `json:"filesSynced,omitempty"`
+	FilesSyncedBack                 *FilesSyncedBack                 `json:"filesSyncedBack,omitempty"`
+	PortsForwarded                  *PortsForwarded                  `json:"portsForwarded,omitempty"`
 }
 
 // DevFileCommandExecutionBegin is the JSON event that is emitted when a dev file command begins execution.
@@ -50,7 +72,9 @@ type DevFileCommandExecutionBegin struct {
 // DevFileCommandExecutionComplete is the JSON event that is emitted when a dev file command completes execution.
 type DevFileCommandExecutionComplete struct {
 	DevFileCommandExecutionBegin
-	Error string `json:"error,omitempty"`
+	// ExitCode is the exit code of the command, or -1 if it is unknown
+	ExitCode int    `json:"exitCode"`
+	Error    string `json:"error,omitempty"`
 }
 
 // ReportError is the JSON event that is emitted when an error occurs during push command
@@ -110,6 +134,42 @@ type KubernetesPodStatusEntry struct {
 	// vast majority are useful.
 }
 
+// ReconcileStart is the JSON event that is emitted when odo starts to update the component on the platform,
+// either at the start of the Dev session or after local changes have been detected.
+type ReconcileStart struct {
+	AbstractLogEvent
+}
+
+// ReconcileComplete is the JSON event that is emitted when odo has finished to update the component on the platform.
+type ReconcileComplete struct {
+	Error string `json:"error,omitempty"`
+	AbstractLogEvent
+}
+
+// FilesSynced is the JSON event that is emitted when local files have been synced into the container of the component.
+// The paths are relative to the directory of the component. A deleted path "*" indicates that all the files
+// have been removed from the container before syncing all the local files.
+type FilesSynced struct {
+	FilesChanged []string `json:"filesChanged"`
+	FilesDeleted []string `json:"filesDeleted"`
+	AbstractLogEvent
+}
+
+// FilesSyncedBack is the JSON event that is emitted when files changed in the container of the component
+// have been copied back into the directory of the component. The paths are relative to the directory of the component.
+// Conflicts are the files changed both locally and in the container, which have not been copied.
+type FilesSyncedBack struct {
+	FilesChanged []string `json:"filesChanged"`
+	Conflicts    []string `json:"conflicts"`
+	AbstractLogEvent
+}
+
+// PortsForwarded is the JSON event that is emitted when the ports forwarded by the Dev session have changed.
+type PortsForwarded struct {
+	Ports []api.ForwardedPort `json:"ports"`
+	AbstractLogEvent
+}
+
 // AbstractLogEvent is the base struct for all events; all events must at a minimum contain a timestamp.
 type AbstractLogEvent struct {
 	Timestamp string `json:"timestamp"`
@@ -123,6 +183,11 @@ var _ MachineEventLogEntry = &ReportError{}
 var _ MachineEventLogEntry = &ContainerStatus{}
 var _ MachineEventLogEntry = &URLReachable{}
 var _ MachineEventLogEntry = &KubernetesPodStatus{}
+var _ MachineEventLogEntry = &ReconcileStart{}
+var _ MachineEventLogEntry = &ReconcileComplete{}
+var _ MachineEventLogEntry = &FilesSynced{}
+var _ MachineEventLogEntry = &FilesSyncedBack{}
+var _ MachineEventLogEntry = &PortsForwarded{}
 
 // MachineEventLogEntry contains the expected methods for every event that is emitted.
 // (This is mainly used for test purposes.)
@@ -2,6 +2,7 @@ package deploy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 
 	"github.com/redhat-developer/odo/pkg/component"
@@ -9,6 +10,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/cli/messages"
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
@@ -52,7 +54,6 @@ func (o *DeployOptions) PreInit() string {
 
 // Complete DeployOptions after they've been created
 func (o *DeployOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) (err error) {
-	scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
 	return nil
 }
 
@@ -62,6 +63,20 @@ func (o *DeployOptions) Validate(ctx context.Context) error {
 	if devfileObj == nil {
 		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
 	}
+
+	platform := fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	switch platform {
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return errors.New("no connection to cluster defined")
+		}
+		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+	case commonflags.PlatformPodman:
+		if o.clientset.PodmanClient == nil {
+			return errors.New("unable to access podman. Do you have podman client installed?")
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+	}
 	return nil
 }
 
@@ -70,16 +85,24 @@ func (o *DeployOptions) Run(ctx context.Context) error {
 	var (
 		devfileObj  = odocontext.GetDevfileObj(ctx)
 		devfileName = odocontext.GetComponentName(ctx)
-		namespace   = odocontext.GetNamespace(ctx)
+		platform    = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
 	)
 
+	var dest string
+	switch platform {
+	case commonflags.PlatformPodman:
+		dest = "Platform: podman"
+	default:
+		dest = "Namespace: " + odocontext.GetNamespace(ctx)
+	}
+
 	scontext.SetComponentType(ctx, component.GetComponentTypeFromDevfileMetadata(devfileObj.Data.GetMetadata()))
 	scontext.SetLanguage(ctx, devfileObj.Data.GetMetadata().Language)
 	scontext.SetProjectType(ctx, devfileObj.Data.GetMetadata().ProjectType)
 	scontext.SetDevfileName(ctx, devfileName)
 	// Output what the command is doing / information
 	log.Title("Running the application in Deploy mode using "+devfileName+" Devfile",
-		"Namespace: "+namespace,
+		dest,
 		"odo version: "+version.VERSION)
 
 	// Run actual deploy command to be used
@@ -105,11 +128,12 @@ func NewCmdDeploy(name, fullName string) *cobra.Command {
 			return genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
-	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES)
+	clientset.Add(deployCmd, clientset.INIT, clientset.DEPLOY, clientset.FILESYSTEM, clientset.KUBERNETES_NULLABLE, clientset.PODMAN_NULLABLE)
 
 	// Add a defined annotation in order to appear in the help menu
 	util.SetCommandGroup(deployCmd, util.MainGroup)
 	deployCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	commonflags.UseVariablesFlags(deployCmd)
+	commonflags.UsePlatformFlag(deployCmd)
 	return deployCmd
 }
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/generator"
@@ -27,6 +28,7 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	"github.com/redhat-developer/odo/pkg/podman"
+	"github.com/redhat-developer/odo/pkg/state"
 )
 
 // ComponentRecommendedCommandName is the recommended component sub-command name
@@ -241,42 +243,44 @@ func (o *ComponentOptions) describeDevfileComponent(ctx context.Context) (result
 		kubeClient = nil
 	}
 
-	allFwdPorts, err := o.clientset.StateClient.GetForwardedPorts()
+	sessions, err := o.clientset.StateClient.GetSessions()
 	if err != nil {
 		return api.Component{}, nil, err
 	}
-	if isPlatformFeatureEnabled {
-		for i := range allFwdPorts {
-			if allFwdPorts[i].Platform == "" {
-				allFwdPorts[i].Platform = commonflags.PlatformCluster
-			}
+	var (
+		devSessions    []api.DevSession
+		forwardedPorts []api.ForwardedPort
+	)
+	for _, session := range sessions {
+		sessionPlatform := session.Platform
+		if sessionPlatform == "" {
+			sessionPlatform = commonflags.PlatformCluster
 		}
-	}
-	var forwardedPorts []api.ForwardedPort
-	switch platform {
-	case "":
-		if isPlatformFeatureEnabled {
-			// Read ports from all platforms
-			forwardedPorts = allFwdPorts
-		} else {
-			// Limit to cluster ports only
-			for _, p := range allFwdPorts {
-				if p.Platform == "" || p.Platform == commonflags.PlatformCluster {
-					forwardedPorts = append(forwardedPorts, p)
-				}
+		switch platform {
+		case "":
+			if !isPlatformFeatureEnabled && sessionPlatform != commonflags.PlatformCluster {
+				// Limit to cluster sessions only
+				continue
 			}
-		}
-	case commonflags.PlatformCluster:
-		for _, p := range allFwdPorts {
-			if p.Platform == "" || p.Platform == commonflags.PlatformCluster {
-				forwardedPorts = append(forwardedPorts, p)
+		default:
+			if sessionPlatform != platform {
+				continue
 			}
 		}
-	case commonflags.PlatformPodman:
-		for _, p := range allFwdPorts {
-			if p.Platform == commonflags.PlatformPodman {
-				forwardedPorts = append(forwardedPorts, p)
+		if session.Command != state.PortForwardCommand {
+			devSessions = append(devSessions, api.DevSession{
+				PID:              session.PID,
+				Platform:         sessionPlatform,
+				Namespace:        session.Namespace,
+				StartTime:        session.StartTime,
+				APIServerAddress: session.APIServerAddress,
+			})
+		}
+		for _, p := range session.ForwardedPorts {
+			if isPlatformFeatureEnabled && p.Platform == "" {
+				p.Platform = sessionPlatform
 			}
+			forwardedPorts = append(forwardedPorts, p)
 		}
 	}
 
@@ -299,6 +303,7 @@ func (o *ComponentOptions) describeDevfileComponent(ctx context.Context) (result
 		DevfilePath:       devfilePath,
 		DevfileData:       api.GetDevfileData(*devfileObj),
 		DevForwardedPorts: forwardedPorts,
+		DevSessions:       devSessions,
 		RunningIn:         api.MergeRunningModes(runningOn),
 		RunningOn:         runningOn,
 		ManagedBy:         "odo",
@@ -380,6 +385,21 @@ func printHumanReadableOutput(ctx context.Context, cmp api.Component, devfileObj
 		fmt.Println()
 	}
 
+	if len(cmp.DevSessions) > 0 {
+		log.Info("Dev sessions:")
+		for _, session := range cmp.DevSessions {
+			details := fmt.Sprintf("[%s] PID %d, started at %s", session.Platform, session.PID, session.StartTime.Format(time.RFC3339))
+			if session.Namespace != "" {
+				details += "\n    Namespace: " + session.Namespace
+			}
+			if session.APIServerAddress != "" {
+				details += "\n    API server: " + session.APIServerAddress
+			}
+			log.Printf(details)
+		}
+		fmt.Println()
+	}
+
 	if len(cmp.DevForwardedPorts) > 0 {
 		log.Info("Forwarded ports:")
 		for _, port := range cmp.DevForwardedPorts {
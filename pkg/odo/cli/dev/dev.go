@@ -10,6 +10,8 @@ import (
 	"github.com/spf13/cobra"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/apiserver"
 	"github.com/redhat-developer/odo/pkg/component"
 	"github.com/redhat-developer/odo/pkg/dev"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
@@ -24,8 +26,10 @@ import (
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
 	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
 	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/util"
 	"github.com/redhat-developer/odo/pkg/version"
+	"github.com/redhat-developer/odo/pkg/watch"
 )
 
 // RecommendedCommandName is the recommended command name
@@ -41,6 +45,10 @@ type DevOptions struct {
 	ignorePaths []string
 	out         io.Writer
 	errOut      io.Writer
+	// customForwardedPorts are the local ports requested with the --port-forward flag
+	customForwardedPorts []api.ForwardedPort
+	// syncBackPaths are the paths to sync back from the container, from the Devfile and the --sync-back flag
+	syncBackPaths []string
 
 	// ctx is used to communicate with WatchAndPush to stop watching and start cleaning up
 	ctx context.Context
@@ -54,6 +62,14 @@ type DevOptions struct {
 	debugFlag        bool
 	buildCommandFlag string
 	runCommandFlag   string
+	cleanVolumesFlag bool
+	autoRestartFlag  bool
+	portForwardFlag  []string
+	syncBackFlag     []string
+	addressFlag      string
+	apiServerFlag    bool
+	apiServerAddress string
+	showIgnoredFlag  bool
 }
 
 var _ genericclioptions.Runnable = (*DevOptions)(nil)
@@ -75,6 +91,25 @@ var devExample = ktemplates.Examples(`
 
 	# Run your application on the cluster in the Dev mode, without automatically syncing the code upon any file changes
 	%[1]s --no-watch
+
+	# Run your application on the cluster in the Dev mode, forwarding the container port 8080 to the local port 18080,
+	# and the endpoint named 'debug' to the local port 15858, on all the network interfaces
+	%[1]s --port-forward 18080:8080 --port-forward debug=15858 --address 0.0.0.0
+
+	# Run your application on the cluster in the Dev mode, and copy the changes made in the container to package-lock.json into the local directory
+	%[1]s --sync-back package-lock.json
+
+	# Display the files which are not synced with the container, and the rules ignoring them
+	%[1]s --show-ignored
+
+	# Run your application on the cluster in the Dev mode, and restart the run command when it crashes
+	%[1]s --auto-restart
+
+	# Run your application on the cluster in the Dev mode, and output the events of the session in JSON format
+	%[1]s -o json
+
+	# Run your application on the cluster in the Dev mode, and control the session through a local API on a Unix socket
+	%[1]s --api-server --api-server-address unix:/tmp/odo-dev.sock
 `)
 
 func (o *DevOptions) SetClientset(clientset *clientset.Clientset) {
@@ -93,6 +128,11 @@ func (o *DevOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args
 }
 
 func (o *DevOptions) Validate(ctx context.Context) error {
+	if o.showIgnoredFlag {
+		// The ignored files are displayed without running the component
+		return nil
+	}
+
 	devfileObj := *odocontext.GetDevfileObj(ctx)
 	if !o.debugFlag && !libdevfile.HasRunCommand(devfileObj.Data) {
 		return clierrors.NewNoCommandInDevfileError("run")
@@ -100,10 +140,46 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 	if o.debugFlag && !libdevfile.HasDebugCommand(devfileObj.Data) {
 		return clierrors.NewNoCommandInDevfileError("debug")
 	}
+	if o.apiServerAddress != apiserver.DefaultAddress {
+		if !o.apiServerFlag {
+			return errors.New("--api-server-address flag can only be used with --api-server flag")
+		}
+		if _, _, err := apiserver.ParseAddress(o.apiServerAddress); err != nil {
+			return err
+		}
+	}
+
+	if o.addressFlag != "" {
+		if err := validateAddress(o.addressFlag); err != nil {
+			return err
+		}
+	}
+	customForwardedPorts, err := parsePortForwardFlags(devfileObj, o.portForwardFlag)
+	if err != nil {
+		return err
+	}
+	for _, fwPort := range customForwardedPorts {
+		address := o.addressFlag
+		if address == "" {
+			address = "localhost"
+		}
+		if !util.IsPortFreeOnAddress(address, fwPort.LocalPort) {
+			return fmt.Errorf("local port %d requested for endpoint %q is already in use on %s", fwPort.LocalPort, fwPort.PortName, address)
+		}
+	}
+	o.customForwardedPorts = customForwardedPorts
+
+	o.syncBackPaths, err = getSyncBackPaths(devfileObj, o.syncBackFlag)
+	if err != nil {
+		return err
+	}
 
 	platform := fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
 	switch platform {
 	case commonflags.PlatformCluster:
+		if o.cleanVolumesFlag {
+			return errors.New("--clean-volumes flag is not supported on the cluster platform")
+		}
 		if o.clientset.KubernetesClient == nil {
 			return errors.New("no connection to cluster defined")
 		}
@@ -113,6 +189,11 @@ func (o *DevOptions) Validate(ctx context.Context) error {
 			return errors.New("unable to access podman. Do you have podman client installed?")
 		}
 		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+	case commonflags.PlatformDocker:
+		if o.clientset.DockerClient == nil {
+			return errors.New("unable to access docker. Do you have docker client installed and the docker daemon running?")
+		}
+		scontext.SetPlatform(ctx, o.clientset.DockerClient)
 	}
 	return nil
 }
@@ -127,14 +208,23 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		platform      = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
 	)
 
+	if o.showIgnoredFlag {
+		return printIgnored(o.out, path)
+	}
+
 	var dest string
 	var deployingTo string
+	var namespace string
 	switch platform {
 	case commonflags.PlatformPodman:
 		dest = "Platform: podman"
 		deployingTo = "podman"
+	case commonflags.PlatformDocker:
+		dest = "Platform: docker"
+		deployingTo = "docker"
 	case commonflags.PlatformCluster:
-		dest = "Namespace: " + odocontext.GetNamespace(ctx)
+		namespace = odocontext.GetNamespace(ctx)
+		dest = "Namespace: " + namespace
 		deployingTo = "the cluster"
 	default:
 		panic(fmt.Errorf("platform %s is not implemented", platform))
@@ -145,6 +235,15 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 		dest,
 		"odo version: "+version.VERSION)
 
+	err = o.clientset.StateClient.Init(platform, namespace)
+	if err != nil {
+		var alreadyRunningErr *state.SessionAlreadyRunningError
+		if errors.As(err, &alreadyRunningErr) {
+			return err
+		}
+		return fmt.Errorf("unable to save the session to the state file: %w", err)
+	}
+
 	// check for .gitignore file and add odo-file-index.json to .gitignore.
 	// In case the .gitignore was created by odo, it is purposely not reported as candidate for deletion (via a call to files.ReportLocalFileGeneratedByOdo)
 	// because a .gitignore file is more likely to be modified by the user afterward (for another usage).
@@ -160,7 +259,7 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 	}
 
 	var ignores []string
-	err = genericclioptions.ApplyIgnore(&ignores, "")
+	err = genericclioptions.ApplyIgnore(&ignores, path)
 	if err != nil {
 		return err
 	}
@@ -174,18 +273,52 @@ func (o *DevOptions) Run(ctx context.Context) (err error) {
 
 	log.Sectionf("Running on %s in Dev mode", deployingTo)
 
+	if log.IsJSON() {
+		// Only the machine-readable events are displayed
+		o.out = io.Discard
+		o.errOut = io.Discard
+	}
+
+	var controlRequests chan watch.ControlRequest
+	if o.apiServerFlag {
+		controlRequests = make(chan watch.ControlRequest)
+		var token string
+		token, err = apiserver.NewToken()
+		if err != nil {
+			return err
+		}
+		server := apiserver.NewServer(o.clientset.StateClient, token, controlRequests, o.cancel)
+		var address string
+		address, err = server.Start(o.ctx, o.apiServerAddress)
+		if err != nil {
+			return err
+		}
+		err = o.clientset.StateClient.SetAPIServer(address, token)
+		if err != nil {
+			return err
+		}
+		log.Infof("Control API listening on %s", address)
+	}
+
 	return o.clientset.DevClient.Start(
 		o.ctx,
 		o.out,
 		o.errOut,
 		dev.StartOptions{
-			IgnorePaths:  o.ignorePaths,
-			Debug:        o.debugFlag,
-			BuildCommand: o.buildCommandFlag,
-			RunCommand:   o.runCommandFlag,
-			RandomPorts:  o.randomPortsFlag,
-			WatchFiles:   !o.noWatchFlag,
-			Variables:    variables,
+			IgnorePaths:          o.ignorePaths,
+			Debug:                o.debugFlag,
+			BuildCommand:         o.buildCommandFlag,
+			RunCommand:           o.runCommandFlag,
+			RandomPorts:          o.randomPortsFlag,
+			CustomForwardedPorts: o.customForwardedPorts,
+			CustomAddress:        o.addressFlag,
+			WatchFiles:           !o.noWatchFlag,
+			SyncBackPaths:        o.syncBackPaths,
+			Variables:            variables,
+			CleanVolumes:         o.cleanVolumesFlag,
+			AutoRestart:          o.autoRestartFlag,
+			// A nil channel is never ready to receive, when the API server is not enabled
+			ControlRequests: controlRequests,
 		},
 	)
 }
@@ -198,6 +331,15 @@ func (o *DevOptions) HandleSignal() error {
 }
 
 func (o *DevOptions) Cleanup(ctx context.Context, commandError error) {
+	if o.showIgnoredFlag {
+		// No session has been started
+		return
+	}
+	var alreadyRunningErr *state.SessionAlreadyRunningError
+	if errors.As(commandError, &alreadyRunningErr) {
+		// The resources belong to the session already running
+		return
+	}
 	if commandError != nil {
 		_ = o.clientset.DevClient.CleanupResources(ctx, log.GetStdout())
 	}
@@ -225,9 +367,26 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 		"Alternative build command. The default one will be used if this flag is not set.")
 	devCmd.Flags().StringVar(&o.runCommandFlag, "run-command", "",
 		"Alternative run command to execute. The default one will be used if this flag is not set.")
+	devCmd.Flags().BoolVar(&o.cleanVolumesFlag, "clean-volumes", false,
+		"Delete the volumes of the component left by a previous session instead of reusing them (Podman and Docker only)")
+	devCmd.Flags().StringArrayVar(&o.portForwardFlag, "port-forward", nil,
+		"Local port to forward a container port to, either <local-port>:<container-port> or <endpoint-name>=<local-port>. Can be used several times")
+	devCmd.Flags().StringVar(&o.addressFlag, "address", "",
+		"Local IP address to forward the ports on (default: localhost)")
+	devCmd.Flags().StringArrayVar(&o.syncBackFlag, "sync-back", nil,
+		"Path, relative to the directory of the component, whose changes in the container are copied into the local directory. Can be used several times")
+	devCmd.Flags().BoolVar(&o.showIgnoredFlag, "show-ignored", false,
+		"Display the files which are not synced with the container, with the rules ignoring them, and exit")
+	devCmd.Flags().BoolVar(&o.autoRestartFlag, "auto-restart", false,
+		"Restart the run command with an exponential backoff when it terminates with an error")
+	devCmd.Flags().BoolVar(&o.apiServerFlag, "api-server", false,
+		"Expose a local API to get the status of the session and control it")
+	devCmd.Flags().StringVar(&o.apiServerAddress, "api-server-address", apiserver.DefaultAddress,
+		"Address of the local API, either a loopback address (host:port) or the path of a Unix socket prefixed with 'unix:'")
 	clientset.Add(devCmd,
 		clientset.BINDING,
 		clientset.DEV,
+		clientset.DOCKER_PLATFORM,
 		clientset.EXEC,
 		clientset.FILESYSTEM,
 		clientset.INIT,
@@ -243,6 +402,7 @@ It forwards endpoints with any exposure values ('public', 'internal' or 'none')
 	odoutil.SetCommandGroup(devCmd, odoutil.MainGroup)
 	devCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	commonflags.UseVariablesFlags(devCmd)
-	commonflags.UsePlatformFlag(devCmd)
+	commonflags.UsePlatformFlag(devCmd, commonflags.PlatformDocker)
+	commonflags.UseOutputFlag(devCmd)
 	return devCmd
 }
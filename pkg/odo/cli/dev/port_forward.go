@@ -0,0 +1,131 @@
+package dev
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+// parsePortForwardFlags parses the values of the --port-forward flag, either <local-port>:<container-port> or <endpoint-name>=<local-port>,
+// and returns the local ports requested for the endpoints of the container components of the Devfile.
+// An error is returned if a value does not match an endpoint, or if the same local port or endpoint is used several times.
+func parsePortForwardFlags(devfileObj parser.DevfileObj, values []string) ([]api.ForwardedPort, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	containers, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ceMapping := libdevfile.GetContainerEndpointMapping(containers, true)
+
+	var (
+		result []api.ForwardedPort
+		// localPorts and endpoints are used to detect the conflicts between the values
+		localPorts = map[int]string{}
+		endpoints  = map[string]string{}
+	)
+	for _, value := range values {
+		fwPort, err := parsePortForwardFlag(ceMapping, value)
+		if err != nil {
+			return nil, err
+		}
+		if other, ok := localPorts[fwPort.LocalPort]; ok {
+			return nil, fmt.Errorf("invalid --port-forward value %q: local port %d is already used by %q", value, fwPort.LocalPort, other)
+		}
+		localPorts[fwPort.LocalPort] = value
+		if other, ok := endpoints[fwPort.PortName]; ok {
+			return nil, fmt.Errorf("invalid --port-forward value %q: endpoint %q is already forwarded by %q", value, fwPort.PortName, other)
+		}
+		endpoints[fwPort.PortName] = value
+		result = append(result, fwPort)
+	}
+	return result, nil
+}
+
+// parsePortForwardFlag parses a single value of the --port-forward flag, and returns the forwarded port for the matching endpoint in ceMapping
+func parsePortForwardFlag(ceMapping map[string][]v1alpha2.Endpoint, value string) (api.ForwardedPort, error) {
+	var (
+		endpointName  string
+		containerPort int
+		localPort     int
+		err           error
+	)
+	if name, port, ok := strings.Cut(value, "="); ok {
+		endpointName = name
+		localPort, err = parsePort(port)
+	} else if local, container, ok := strings.Cut(value, ":"); ok {
+		localPort, err = parsePort(local)
+		if err == nil {
+			containerPort, err = parsePort(container)
+		}
+	} else {
+		err = fmt.Errorf("expected <local-port>:<container-port> or <endpoint-name>=<local-port>")
+	}
+	if err != nil {
+		return api.ForwardedPort{}, fmt.Errorf("invalid --port-forward value %q: %w", value, err)
+	}
+
+	var matches []api.ForwardedPort
+	// Iterate over the containers in a stable order, for the error messages to be reproducible
+	containerNames := make([]string, 0, len(ceMapping))
+	for containerName := range ceMapping {
+		containerNames = append(containerNames, containerName)
+	}
+	sort.Strings(containerNames)
+	for _, containerName := range containerNames {
+		for _, ep := range ceMapping[containerName] {
+			if (endpointName != "" && ep.Name == endpointName) || (endpointName == "" && ep.TargetPort == containerPort) {
+				matches = append(matches, api.ForwardedPort{
+					ContainerName: containerName,
+					PortName:      ep.Name,
+					IsDebug:       libdevfile.IsDebugPort(ep.Name),
+					LocalPort:     localPort,
+					ContainerPort: ep.TargetPort,
+				})
+			}
+		}
+	}
+
+	switch {
+	case len(matches) == 0 && endpointName != "":
+		return api.ForwardedPort{}, fmt.Errorf("invalid --port-forward value %q: no endpoint named %q in the Devfile", value, endpointName)
+	case len(matches) == 0:
+		return api.ForwardedPort{}, fmt.Errorf("invalid --port-forward value %q: no endpoint with container port %d in the Devfile", value, containerPort)
+	case len(matches) > 1:
+		return api.ForwardedPort{}, fmt.Errorf("invalid --port-forward value %q: container port %d is exposed by several containers, use <endpoint-name>=<local-port> instead", value, containerPort)
+	}
+	return matches[0], nil
+}
+
+// parsePort parses a port number
+func parsePort(s string) (int, error) {
+	port, err := strconv.Atoi(s)
+	if err != nil || port < 1 || port > 65535 {
+		return 0, fmt.Errorf("%q is not a valid port number", s)
+	}
+	return port, nil
+}
+
+// validateAddress checks that address is an IP address of the local host, on which the ports can be forwarded
+func validateAddress(address string) error {
+	if net.ParseIP(address) == nil {
+		return fmt.Errorf("invalid --address value %q: must be an IP address", address)
+	}
+	listener, err := net.Listen("tcp", net.JoinHostPort(address, "0"))
+	if err != nil {
+		return fmt.Errorf("invalid --address value %q: unable to listen on this address: %w", address, err)
+	}
+	return listener.Close()
+}
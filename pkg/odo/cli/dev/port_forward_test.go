@@ -0,0 +1,141 @@
+package dev
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+func Test_parsePortForwardFlags(t *testing.T) {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	runtime := testingutil.GetFakeContainerComponent("runtime")
+	runtime.Container.Endpoints = []v1alpha2.Endpoint{
+		{Name: "http", TargetPort: 8080},
+		{Name: "debug", TargetPort: 5858},
+	}
+	tools := testingutil.GetFakeContainerComponent("tools")
+	tools.Container.Endpoints = []v1alpha2.Endpoint{
+		{Name: "admin", TargetPort: 9000},
+		{Name: "metrics", TargetPort: 8080},
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{runtime, tools})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{Data: devfileData}
+
+	tests := []struct {
+		name    string
+		values  []string
+		want    []api.ForwardedPort
+		wantErr bool
+	}{
+		{
+			name: "no value",
+		},
+		{
+			name:   "container port and endpoint name",
+			values: []string{"19000:9000", "debug=15858"},
+			want: []api.ForwardedPort{
+				{ContainerName: "tools", PortName: "admin", LocalPort: 19000, ContainerPort: 9000},
+				{ContainerName: "runtime", PortName: "debug", IsDebug: true, LocalPort: 15858, ContainerPort: 5858},
+			},
+		},
+		{
+			name:   "endpoint name of a container port exposed by several containers",
+			values: []string{"metrics=18080"},
+			want: []api.ForwardedPort{
+				{ContainerName: "tools", PortName: "metrics", LocalPort: 18080, ContainerPort: 8080},
+			},
+		},
+		{
+			name:    "container port exposed by several containers",
+			values:  []string{"18080:8080"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown container port",
+			values:  []string{"18081:8081"},
+			wantErr: true,
+		},
+		{
+			name:    "unknown endpoint",
+			values:  []string{"unknown=18080"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid format",
+			values:  []string{"18080"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid port",
+			values:  []string{"http=70000"},
+			wantErr: true,
+		},
+		{
+			name:    "same local port used twice",
+			values:  []string{"http=18080", "admin=18080"},
+			wantErr: true,
+		},
+		{
+			name:    "same endpoint forwarded twice",
+			values:  []string{"19000:9000", "admin=19001"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := parsePortForwardFlags(devfileObj, tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parsePortForwardFlags() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("parsePortForwardFlags() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_validateAddress(t *testing.T) {
+	tests := []struct {
+		name    string
+		address string
+		wantErr bool
+	}{
+		{
+			name:    "loopback address",
+			address: "127.0.0.1",
+		},
+		{
+			name:    "all the interfaces",
+			address: "0.0.0.0",
+		},
+		{
+			name:    "host name",
+			address: "localhost",
+			wantErr: true,
+		},
+		{
+			name:    "address not assigned to the host",
+			address: "192.0.2.1",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if err := validateAddress(tt.address); (err != nil) != tt.wantErr {
+				t.Errorf("validateAddress() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
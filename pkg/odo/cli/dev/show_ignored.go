@@ -0,0 +1,52 @@
+package dev
+
+import (
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/redhat-developer/odo/pkg/ignore"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+)
+
+// printIgnored writes the paths of the component directory which are not synced, with the file and the line
+// of the rule ignoring each of them. The content of an ignored directory is not listed.
+func printIgnored(out io.Writer, path string) error {
+	rules, err := genericclioptions.GetIgnoreRules(path)
+	if err != nil {
+		return err
+	}
+	ignored, err := ignore.ListIgnored(path, ignore.NewMatcherFromRules(rules))
+	if err != nil {
+		return err
+	}
+	if len(ignored) == 0 {
+		fmt.Fprintln(out, "No file is ignored")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(out, 0, 0, 2, ' ', 0)
+	for _, p := range ignored {
+		name := p.Path
+		if p.IsDir {
+			name += "/"
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", name, getRuleSource(path, p.Rule), p.Rule.Text)
+	}
+	return w.Flush()
+}
+
+// getRuleSource returns the file and the line defining the rule, the file being relative to the component directory
+// when it is part of it, or "odo" for the rules added by odo
+func getRuleSource(path string, rule ignore.Rule) string {
+	if rule.Source == "" {
+		return "odo"
+	}
+	source := rule.Source
+	if rel, err := filepath.Rel(path, source); err == nil && !strings.HasPrefix(rel, "..") {
+		source = rel
+	}
+	return fmt.Sprintf("%s:%d", source, rule.Line)
+}
@@ -0,0 +1,46 @@
+package dev
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_printIgnored(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	t.Setenv("XDG_CONFIG_HOME", "")
+
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		".gitignore":          "# dependencies\nnode_modules/\n",
+		"node_modules/a/a.js": "",
+		"server.js":           "",
+		"gen/.odoignore":      "*.pb.go\n",
+		"gen/.gitignore":      "*\n",
+		"gen/api.pb.go":       "",
+		".git/HEAD":           "",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0750); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var out bytes.Buffer
+	if err := printIgnored(&out, dir); err != nil {
+		t.Fatal(err)
+	}
+	want := `.git/          odo               .git
+gen/api.pb.go  gen/.odoignore:1  *.pb.go
+node_modules/  .gitignore:2      node_modules/
+`
+	if diff := cmp.Diff(want, out.String()); diff != "" {
+		t.Errorf("printIgnored() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -0,0 +1,36 @@
+package dev
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+// getSyncBackPaths returns the paths to sync back from the container, defined in the Devfile with the dev.odo.sync-back attribute
+// and with the values of the --sync-back flag. An error is returned if a path is not relative to the directory of the component.
+func getSyncBackPaths(devfileObj parser.DevfileObj, values []string) ([]string, error) {
+	paths, err := libdevfile.GetSyncBackPaths(devfileObj.Data)
+	if err != nil {
+		return nil, err
+	}
+	paths = append(paths, values...)
+
+	var result []string
+	seen := map[string]bool{}
+	for _, p := range paths {
+		cleaned := filepath.Clean(p)
+		if filepath.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, ".."+string(filepath.Separator)) {
+			return nil, fmt.Errorf("path %q to sync back must be relative to the directory of the component", p)
+		}
+		if seen[cleaned] {
+			continue
+		}
+		seen[cleaned] = true
+		result = append(result, cleaned)
+	}
+	return result, nil
+}
@@ -0,0 +1,60 @@
+package dev
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	v2 "github.com/devfile/library/v2/pkg/devfile/parser/data/v2"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+)
+
+func Test_getSyncBackPaths(t *testing.T) {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion220))
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileData.(*v2.DevfileV2).Attributes = attributes.Attributes{}
+	err = devfileData.AddAttributes(libdevfile.SyncBackAttribute, []string{"package-lock.json", "gen/"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileObj := parser.DevfileObj{Data: devfileData}
+
+	tests := []struct {
+		name    string
+		values  []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "paths from the Devfile only",
+			want: []string{"package-lock.json", "gen"},
+		},
+		{
+			name:   "paths from the Devfile and the flag",
+			values: []string{"./gen", filepath.Join("db", "migrations")},
+			want:   []string{"package-lock.json", "gen", filepath.Join("db", "migrations")},
+		},
+		{
+			name:    "path outside of the directory",
+			values:  []string{filepath.Join("..", "secrets")},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := getSyncBackPaths(devfileObj, tt.values)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getSyncBackPaths() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getSyncBackPaths() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -43,3 +43,29 @@ func IsWarning(err error) bool {
 func AsWarning(err error) bool {
 	return errors.As(err, &Warning{})
 }
+
+// ExitCodeError is returned by a command requiring odo to terminate with a specific exit code
+type ExitCodeError struct {
+	err      error
+	exitCode int
+}
+
+func NewExitCodeError(err error, exitCode int) ExitCodeError {
+	return ExitCodeError{
+		err:      err,
+		exitCode: exitCode,
+	}
+}
+
+func (o ExitCodeError) Error() string {
+	return o.err.Error()
+}
+
+func (o ExitCodeError) Unwrap() error {
+	return o.err
+}
+
+// ExitCode returns the exit code odo must terminate with
+func (o ExitCodeError) ExitCode() int {
+	return o.exitCode
+}
@@ -0,0 +1,230 @@
+package exec
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/devfile/library/v2/pkg/devfile/generator"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/kubectl/pkg/util/term"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	dev "github.com/redhat-developer/odo/pkg/dev/common"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/log"
+	odoerrors "github.com/redhat-developer/odo/pkg/odo/cli/errors"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/platform"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "exec"
+
+type ExecOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Flags
+	containerFlag string
+
+	// Args
+	command []string
+}
+
+var _ genericclioptions.Runnable = (*ExecOptions)(nil)
+
+var execExample = ktemplates.Examples(`
+	# Open a shell in the container of the component running in Dev mode, in the directory where the sources are synchronized
+	%[1]s
+
+	# Open a shell in a specific container of the component
+	%[1]s --container runtime
+
+	# Execute a command in the container of the component running in Dev mode on Podman
+	%[1]s --platform podman -- ls -l
+`)
+
+func NewExecOptions() *ExecOptions {
+	return &ExecOptions{}
+}
+
+func (o *ExecOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *ExecOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	if len(args) == 0 {
+		return nil
+	}
+	command, err := cmdline.GetArgsAfterDashes(args)
+	if err != nil {
+		return errors.New("the command to execute must be passed after `--`")
+	}
+	o.command = command
+	return nil
+}
+
+func (o *ExecOptions) Validate(ctx context.Context) error {
+	devfileObj := odocontext.GetDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+
+	platform := fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	switch platform {
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return errors.New("no connection to cluster defined")
+		}
+		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+	case commonflags.PlatformPodman:
+		if o.clientset.PodmanClient == nil {
+			return errors.New("unable to access podman. Do you have podman client installed?")
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+	case commonflags.PlatformDocker:
+		if o.clientset.DockerClient == nil {
+			return errors.New("unable to access docker. Do you have docker client installed and the docker daemon running?")
+		}
+		scontext.SetPlatform(ctx, o.clientset.DockerClient)
+	}
+	return nil
+}
+
+func (o *ExecOptions) Run(ctx context.Context) error {
+	var (
+		devfileObj    = odocontext.GetDevfileObj(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+		platformName  = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+
+	var platformClient platform.Client
+	switch platformName {
+	case commonflags.PlatformPodman:
+		platformClient = o.clientset.PodmanClient
+	case commonflags.PlatformDocker:
+		platformClient = o.clientset.DockerClient
+	default:
+		platformClient = o.clientset.KubernetesClient
+	}
+
+	containerName, workingDir, err := getContainer(*devfileObj, o.containerFlag)
+	if err != nil {
+		return err
+	}
+
+	pod, err := platformClient.GetRunningPodFromSelector(odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, false))
+	if err != nil {
+		return fmt.Errorf("unable to find the running component %q, please check that `odo dev` is running on the %s platform: %w", componentName, platformName, err)
+	}
+	cmd := getCommand(o.command, workingDir)
+
+	tty := term.TTY{
+		In:  os.Stdin,
+		Out: os.Stdout,
+		Raw: true,
+	}
+	if tty.IsTerminalIn() {
+		sizeQueue := tty.MonitorSize(tty.GetSize())
+		err = tty.Safe(func() error {
+			return platformClient.ExecInteractiveCMDInContainer(containerName, pod.Name, cmd, os.Stdin, os.Stdout, sizeQueue)
+		})
+	} else {
+		err = platformClient.ExecCMDInContainer(containerName, pod.Name, cmd, log.GetStdout(), log.GetStderr(), os.Stdin, false)
+	}
+	if exitCode, ok := platform.GetExitCode(err); ok {
+		// odo terminates with the exit code of the command, which already displayed its own errors
+		return odoerrors.NewExitCodeError(fmt.Errorf("command exited with code %d", exitCode), exitCode)
+	}
+	return err
+}
+
+// getContainer returns the name of the container in which the command is executed, and the folder where the sources
+// are synchronized in this container, if any.
+// If no container name is given, the first container in which the sources are synchronized is returned,
+// or the first container if none of them has the sources synchronized.
+func getContainer(devfileObj parser.DevfileObj, containerName string) (string, string, error) {
+	containers, err := generator.GetContainers(devfileObj, common.DevfileOptions{})
+	if err != nil {
+		return "", "", err
+	}
+	if len(containers) == 0 {
+		return "", "", errors.New("no container component found in the Devfile")
+	}
+
+	if containerName == "" {
+		name, syncFolder, err := dev.GetFirstContainerWithSourceVolume(containers)
+		if err != nil {
+			return containers[0].Name, "", nil
+		}
+		return name, syncFolder, nil
+	}
+
+	for _, container := range containers {
+		if container.Name != containerName {
+			continue
+		}
+		return container.Name, getSyncFolder(container), nil
+	}
+	return "", "", fmt.Errorf("no container component named %q found in the Devfile", containerName)
+}
+
+// getSyncFolder returns the folder where the sources are synchronized in the container, or an empty string
+// if the sources are not synchronized in this container
+func getSyncFolder(container corev1.Container) string {
+	for _, env := range container.Env {
+		if env.Name == generator.EnvProjectsSrc {
+			return env.Value
+		}
+	}
+	return ""
+}
+
+// getCommand returns the command to execute in the container, from the working directory if not empty.
+// A shell is started if command is empty.
+func getCommand(command []string, workingDir string) []string {
+	if len(command) == 0 {
+		command = []string{component.ShellExecutable}
+	}
+	if workingDir == "" {
+		return command
+	}
+	// The arguments of the command are passed as positional parameters to the shell, so that they don't need to be escaped
+	return append([]string{component.ShellExecutable, "-c", "cd " + workingDir + " && exec \"$@\"", component.ShellExecutable}, command...)
+}
+
+func NewCmdExec(name, fullName string) *cobra.Command {
+	o := NewExecOptions()
+	execCmd := &cobra.Command{
+		Use:   name + " [--container <name>] [-- <command> [args...]]",
+		Short: "Execute a command or open a shell in a container of the component running in Dev mode",
+		Long: `odo exec executes a command in a container of the component running with odo dev, from the directory where the sources are synchronized.
+A shell is opened if no command is given. The standard input, output and error are attached to the command, in a terminal if odo is run from a terminal.`,
+		Example: fmt.Sprintf(execExample, fullName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(execCmd, clientset.FILESYSTEM, clientset.KUBERNETES_NULLABLE, clientset.PODMAN_NULLABLE, clientset.DOCKER_PLATFORM)
+
+	execCmd.Flags().StringVar(&o.containerFlag, "container", "", "Name of the container in which to execute the command. Defaults to the container in which the sources are synchronized")
+
+	odoutil.SetCommandGroup(execCmd, odoutil.MainGroup)
+	execCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UsePlatformFlag(execCmd, commonflags.PlatformDocker)
+	return execCmd
+}
@@ -0,0 +1,125 @@
+package exec
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/pointer"
+
+	"github.com/redhat-developer/odo/pkg/testingutil"
+)
+
+func Test_getContainer(t *testing.T) {
+	tools := testingutil.GetFakeContainerComponent("tools")
+	tools.Container.MountSources = pointer.Bool(false)
+	runtime := testingutil.GetFakeContainerComponent("runtime")
+	runtime.Container.SourceMapping = "/src"
+
+	tests := []struct {
+		name           string
+		components     []v1alpha2.Component
+		containerName  string
+		wantContainer  string
+		wantWorkingDir string
+		wantErr        bool
+	}{
+		{
+			name:           "first container with sources by default",
+			components:     []v1alpha2.Component{tools, runtime},
+			wantContainer:  "runtime",
+			wantWorkingDir: "/src",
+		},
+		{
+			name:          "first container if no container has sources",
+			components:    []v1alpha2.Component{tools},
+			wantContainer: "tools",
+		},
+		{
+			name:          "given container without sources",
+			components:    []v1alpha2.Component{tools, runtime},
+			containerName: "tools",
+			wantContainer: "tools",
+		},
+		{
+			name:           "given container with sources",
+			components:     []v1alpha2.Component{tools, runtime},
+			containerName:  "runtime",
+			wantContainer:  "runtime",
+			wantWorkingDir: "/src",
+		},
+		{
+			name:          "unknown container",
+			components:    []v1alpha2.Component{tools, runtime},
+			containerName: "unknown",
+			wantErr:       true,
+		},
+		{
+			name:    "no container",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = devfileData.AddComponents(tt.components)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			gotContainer, gotWorkingDir, err := getContainer(parser.DevfileObj{Data: devfileData}, tt.containerName)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("getContainer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if gotContainer != tt.wantContainer {
+				t.Errorf("getContainer() container = %q, want %q", gotContainer, tt.wantContainer)
+			}
+			if gotWorkingDir != tt.wantWorkingDir {
+				t.Errorf("getContainer() working dir = %q, want %q", gotWorkingDir, tt.wantWorkingDir)
+			}
+		})
+	}
+}
+
+func Test_getCommand(t *testing.T) {
+	tests := []struct {
+		name       string
+		command    []string
+		workingDir string
+		want       []string
+	}{
+		{
+			name: "shell without working directory",
+			want: []string{"/bin/sh"},
+		},
+		{
+			name:       "shell in working directory",
+			workingDir: "/projects",
+			want:       []string{"/bin/sh", "-c", `cd /projects && exec "$@"`, "/bin/sh", "/bin/sh"},
+		},
+		{
+			name:    "command without working directory",
+			command: []string{"ls", "-l"},
+			want:    []string{"ls", "-l"},
+		},
+		{
+			name:       "command in working directory",
+			command:    []string{"ls", "-l", "my dir"},
+			workingDir: "/projects",
+			want:       []string{"/bin/sh", "-c", `cd /projects && exec "$@"`, "/bin/sh", "ls", "-l", "my dir"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getCommand(tt.command, tt.workingDir)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getCommand() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
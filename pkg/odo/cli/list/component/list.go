@@ -110,17 +110,23 @@ func (lo *ListOptions) run(ctx context.Context) (api.ResourcesList, error) {
 
 		kubeClient   = lo.clientset.KubernetesClient
 		podmanClient = lo.clientset.PodmanClient
+		dockerClient = lo.clientset.DockerClient
 	)
 
 	switch fcontext.GetPlatform(ctx, "") {
 	case commonflags.PlatformCluster:
 		podmanClient = nil
+		dockerClient = nil
 	case commonflags.PlatformPodman:
 		kubeClient = nil
+		dockerClient = nil
+	case commonflags.PlatformDocker:
+		kubeClient = nil
+		podmanClient = nil
 	}
 
 	allComponents, componentInDevfile, err := component.ListAllComponents(
-		kubeClient, podmanClient, lo.namespaceFilter, devfileObj, componentName)
+		kubeClient, podmanClient, dockerClient, lo.namespaceFilter, devfileObj, componentName)
 	if err != nil {
 		return api.ResourcesList{}, err
 	}
@@ -156,13 +162,13 @@ func NewCmdComponentList(ctx context.Context, name, fullName string) *cobra.Comm
 	}
 	clientset.Add(listCmd, clientset.KUBERNETES_NULLABLE, clientset.FILESYSTEM)
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
-		clientset.Add(listCmd, clientset.PODMAN_NULLABLE)
+		clientset.Add(listCmd, clientset.PODMAN_NULLABLE, clientset.DOCKER_NULLABLE)
 	}
 	listCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace for odo to scan for components")
 
 	util.SetCommandGroup(listCmd, util.ManagementGroup)
 	commonflags.UseOutputFlag(listCmd)
-	commonflags.UsePlatformFlag(listCmd)
+	commonflags.UsePlatformFlag(listCmd, commonflags.PlatformDocker)
 
 	return listCmd
 }
@@ -118,17 +118,23 @@ func (lo *ListOptions) run(ctx context.Context) (list api.ResourcesList, err err
 
 		kubeClient   = lo.clientset.KubernetesClient
 		podmanClient = lo.clientset.PodmanClient
+		dockerClient = lo.clientset.DockerClient
 	)
 
 	switch fcontext.GetPlatform(ctx, "") {
 	case commonflags.PlatformCluster:
 		podmanClient = nil
+		dockerClient = nil
 	case commonflags.PlatformPodman:
 		kubeClient = nil
+		dockerClient = nil
+	case commonflags.PlatformDocker:
+		kubeClient = nil
+		podmanClient = nil
 	}
 
 	allComponents, componentInDevfile, err := component.ListAllComponents(
-		kubeClient, podmanClient, lo.namespaceFilter, devfileObj, componentName)
+		kubeClient, podmanClient, dockerClient, lo.namespaceFilter, devfileObj, componentName)
 	if err != nil {
 		return api.ResourcesList{}, err
 	}
@@ -175,7 +181,7 @@ func NewCmdList(ctx context.Context, name, fullName string) *cobra.Command {
 	}
 	clientset.Add(listCmd, clientset.KUBERNETES_NULLABLE, clientset.BINDING, clientset.FILESYSTEM)
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
-		clientset.Add(listCmd, clientset.PODMAN_NULLABLE)
+		clientset.Add(listCmd, clientset.PODMAN_NULLABLE, clientset.DOCKER_NULLABLE)
 	}
 
 	namespaceCmd := namespace.NewCmdNamespaceList(namespace.RecommendedCommandName, odoutil.GetFullName(fullName, namespace.RecommendedCommandName))
@@ -189,7 +195,7 @@ func NewCmdList(ctx context.Context, name, fullName string) *cobra.Command {
 	listCmd.Flags().StringVar(&o.namespaceFlag, "namespace", "", "Namespace for odo to scan for components")
 
 	commonflags.UseOutputFlag(listCmd)
-	commonflags.UsePlatformFlag(listCmd)
+	commonflags.UsePlatformFlag(listCmd, commonflags.PlatformDocker)
 
 	return listCmd
 }
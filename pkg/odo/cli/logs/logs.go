@@ -25,6 +25,8 @@ import (
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
 	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
 	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
 	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
@@ -91,6 +93,18 @@ func (o *LogsOptions) Validate(ctx context.Context) error {
 	if o.devMode && o.deployMode {
 		return errors.New("pass only one of --dev or --deploy flags; pass no flag to see logs for both modes")
 	}
+
+	platform := fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	switch platform {
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return errors.New("no connection to cluster defined")
+		}
+	case commonflags.PlatformPodman:
+		if o.clientset.PodmanClient == nil {
+			return errors.New("unable to access podman. Do you have podman client installed?")
+		}
+	}
 	return nil
 }
 
@@ -143,13 +157,13 @@ func (o *LogsOptions) Run(ctx context.Context) error {
 			if o.follow {
 				atomic.AddInt64(&goroutines.count, 1)
 				go func(out io.Writer) {
-					defer func() {
-						atomic.AddInt64(&goroutines.count, -1)
-					}()
-					err = printLogs(uniqueName, logs, out, colour, &mu)
-					if err != nil {
-						errChan <- err
+					printErr := printLogs(uniqueName, logs, out, colour, &mu)
+					if printErr != nil {
+						errChan <- printErr
 					}
+					// decrement the counter before to signal the end of the logs,
+					// so the last goroutine terminating makes the command exit
+					atomic.AddInt64(&goroutines.count, -1)
 					events.Done <- struct{}{}
 				}(o.out)
 			} else {
@@ -163,7 +177,7 @@ func (o *LogsOptions) Run(ctx context.Context) error {
 		case err = <-events.Err:
 			return err
 		case <-events.Done:
-			if goroutines.count == 0 {
+			if atomic.LoadInt64(&goroutines.count) == 0 {
 				if len(uniqueContainerNames) == 0 {
 					// This will be the case when:
 					// 1. user specifies --dev flag, but the component's running in Deploy mode
@@ -244,6 +258,7 @@ By default it shows logs of all containers running in both Dev and Deploy mode.
 	logsCmd.Flags().BoolVar(&o.follow, "follow", false, "Follow/tail the logs of the pods")
 
 	clientset.Add(logsCmd, clientset.LOGS, clientset.FILESYSTEM)
+	commonflags.UsePlatformFlag(logsCmd)
 	util.SetCommandGroup(logsCmd, util.MainGroup)
 	logsCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 	return logsCmd
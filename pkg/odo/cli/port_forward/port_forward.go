@@ -0,0 +1,170 @@
+package port_forward
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/version"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "port-forward"
+
+var portForwardExample = ktemplates.Examples(`
+	# Forward the ports of the component present in the current directory, running in Dev or Deploy mode
+	%[1]s
+
+	# Forward the ports of the component present in the current directory, running in Deploy mode
+	%[1]s --running-in deploy
+
+	# Forward the ports of the component named 'frontend' in the 'myproject' namespace
+	%[1]s --name frontend --namespace myproject
+`)
+
+type PortForwardOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// ctx is cancelled when the user interrupts the command
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	// name of the component, optional
+	name string
+
+	// namespace in which to find the component, optional, defaults to current namespace
+	namespace string
+
+	// runningInFlag limits the port forwarding to the resources created for the specified running mode
+	runningInFlag string
+
+	// runningIn translates runningInFlag into a usable label that indicates which running mode we should consider.
+	// It can be either Dev, Deploy or Any (using constant labels.Component*Mode).
+	runningIn string
+
+	// devfileObj is the Devfile of the component, used to get the endpoints forwarded in Dev mode.
+	// It is nil if the component is not the one described in the local Devfile
+	devfileObj *parser.DevfileObj
+}
+
+var _ genericclioptions.Runnable = (*PortForwardOptions)(nil)
+var _ genericclioptions.SignalHandler = (*PortForwardOptions)(nil)
+var _ genericclioptions.Cleanuper = (*PortForwardOptions)(nil)
+
+// NewPortForwardOptions returns new instance of PortForwardOptions
+func NewPortForwardOptions() *PortForwardOptions {
+	return &PortForwardOptions{}
+}
+
+func (o *PortForwardOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *PortForwardOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	switch api.RunningMode(o.runningInFlag) {
+	case api.RunningModeDev:
+		o.runningIn = labels.ComponentDevMode
+	case api.RunningModeDeploy:
+		o.runningIn = labels.ComponentDeployMode
+	case "":
+		o.runningIn = labels.ComponentAnyMode
+	default:
+		return fmt.Errorf("invalid value for --running-in: %q. Acceptable values are: %s, %s",
+			o.runningInFlag, api.RunningModeDev, api.RunningModeDeploy)
+	}
+
+	if o.name == "" {
+		if odocontext.GetDevfileObj(ctx) == nil {
+			return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+		}
+		o.name = odocontext.GetComponentName(ctx)
+	}
+	if devfileObj := odocontext.GetDevfileObj(ctx); devfileObj != nil && o.name == odocontext.GetComponentName(ctx) {
+		o.devfileObj = devfileObj
+	}
+
+	if o.namespace != "" {
+		o.clientset.KubernetesClient.SetNamespace(o.namespace)
+	} else {
+		o.namespace = o.clientset.KubernetesClient.GetCurrentNamespace()
+	}
+
+	o.ctx, o.cancel = context.WithCancel(ctx)
+	return nil
+}
+
+func (o *PortForwardOptions) Validate(ctx context.Context) error {
+	return nil
+}
+
+func (o *PortForwardOptions) Run(ctx context.Context) error {
+	log.Title(fmt.Sprintf("Forwarding the ports of the %q component", o.name),
+		"Namespace: "+o.namespace,
+		"odo version: "+version.VERSION)
+
+	err := o.clientset.StateClient.InitPortForward(o.namespace)
+	if err != nil {
+		return fmt.Errorf("unable to save the session to the state file: %w", err)
+	}
+
+	log.Info("Press Ctrl+c to stop forwarding the ports")
+	return o.clientset.PortForwardClient.ForwardComponentPorts(
+		o.ctx,
+		o.devfileObj,
+		o.name,
+		odocontext.GetApplication(ctx),
+		o.runningIn,
+		log.GetStdout(),
+		log.GetStderr(),
+	)
+}
+
+func (o *PortForwardOptions) HandleSignal() error {
+	o.cancel()
+	// At this point, `ctx.Done()` will be raised, and the port forwarding will be stopped
+	// wait for the main thread to finish instead of signal handler go routine from runnable
+	select {}
+}
+
+func (o *PortForwardOptions) Cleanup(ctx context.Context, commandError error) {
+	_ = o.clientset.StateClient.SaveExit()
+}
+
+// NewCmdPortForward implements the odo port-forward command
+func NewCmdPortForward(name, fullName string) *cobra.Command {
+	o := NewPortForwardOptions()
+	portForwardCmd := &cobra.Command{
+		Use:   name,
+		Short: "Forward the ports of a component running on the cluster",
+		Long: `odo port-forward is a long running command that forwards the ports of a component running on the cluster to ports on localhost.
+The ports of the component running in Dev mode are the endpoints of the Devfile, except the endpoints with the exposure none;
+the local Devfile of the component is needed to forward them. All the ports declared by the containers are forwarded
+for the resources created in Deploy mode. The port forwarding is restarted when the pods of the component are restarted.`,
+		Example: fmt.Sprintf(portForwardExample, fullName),
+		Args:    genericclioptions.NoArgsAndSilenceJSON,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	portForwardCmd.Flags().StringVar(&o.name, "name", "", "Name of the component, optional. By default, the component described in the local devfile is used")
+	portForwardCmd.Flags().StringVar(&o.namespace, "namespace", "", "Namespace in which to find the component, optional. By default, the current namespace defined in kubeconfig is used")
+	portForwardCmd.Flags().StringVar(&o.runningInFlag, "running-in", "",
+		"Forward the ports of the resources running in the specified mode, optional. By default, the ports of the resources running in all the modes are forwarded.")
+
+	clientset.Add(portForwardCmd, clientset.FILESYSTEM, clientset.KUBERNETES, clientset.PORT_FORWARD, clientset.STATE)
+	odoutil.SetCommandGroup(portForwardCmd, odoutil.MainGroup)
+	portForwardCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	return portForwardCmd
+}
@@ -0,0 +1,171 @@
+package run
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/redhat-developer/odo/pkg/component"
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/libdevfile"
+	"github.com/redhat-developer/odo/pkg/log"
+	odoerrors "github.com/redhat-developer/odo/pkg/odo/cli/errors"
+	"github.com/redhat-developer/odo/pkg/odo/cmdline"
+	"github.com/redhat-developer/odo/pkg/odo/commonflags"
+	fcontext "github.com/redhat-developer/odo/pkg/odo/commonflags/context"
+	odocontext "github.com/redhat-developer/odo/pkg/odo/context"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions"
+	"github.com/redhat-developer/odo/pkg/odo/genericclioptions/clientset"
+	odoutil "github.com/redhat-developer/odo/pkg/odo/util"
+	"github.com/redhat-developer/odo/pkg/platform"
+	scontext "github.com/redhat-developer/odo/pkg/segment/context"
+)
+
+// RecommendedCommandName is the recommended command name
+const RecommendedCommandName = "run"
+
+type RunOptions struct {
+	// Clients
+	clientset *clientset.Clientset
+
+	// Args
+	commandName string
+}
+
+var _ genericclioptions.Runnable = (*RunOptions)(nil)
+
+var runExample = ktemplates.Examples(`
+	# Run the command "test" defined in the Devfile, in the component running in Dev mode
+	%[1]s test
+
+	# Run the command "test" in the component running in Dev mode on Podman
+	%[1]s test --platform podman
+`)
+
+func NewRunOptions() *RunOptions {
+	return &RunOptions{}
+}
+
+func (o *RunOptions) SetClientset(clientset *clientset.Clientset) {
+	o.clientset = clientset
+}
+
+func (o *RunOptions) Complete(ctx context.Context, cmdline cmdline.Cmdline, args []string) error {
+	o.commandName = args[0]
+	return nil
+}
+
+func (o *RunOptions) Validate(ctx context.Context) error {
+	devfileObj := odocontext.GetDevfileObj(ctx)
+	if devfileObj == nil {
+		return genericclioptions.NewNoDevfileError(odocontext.GetWorkingDirectory(ctx))
+	}
+
+	commands, err := devfileObj.Data.GetCommands(common.DevfileOptions{
+		FilterByName: o.commandName,
+	})
+	if err != nil {
+		return err
+	}
+	if len(commands) == 0 {
+		return fmt.Errorf("no command named %q found in the Devfile", o.commandName)
+	}
+
+	platform := fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	switch platform {
+	case commonflags.PlatformCluster:
+		if o.clientset.KubernetesClient == nil {
+			return errors.New("no connection to cluster defined")
+		}
+		scontext.SetPlatform(ctx, o.clientset.KubernetesClient)
+	case commonflags.PlatformPodman:
+		if o.clientset.PodmanClient == nil {
+			return errors.New("unable to access podman. Do you have podman client installed?")
+		}
+		scontext.SetPlatform(ctx, o.clientset.PodmanClient)
+	case commonflags.PlatformDocker:
+		if o.clientset.DockerClient == nil {
+			return errors.New("unable to access docker. Do you have docker client installed and the docker daemon running?")
+		}
+		scontext.SetPlatform(ctx, o.clientset.DockerClient)
+	}
+	return nil
+}
+
+func (o *RunOptions) Run(ctx context.Context) error {
+	var (
+		devfileObj    = odocontext.GetDevfileObj(ctx)
+		devfilePath   = odocontext.GetDevfilePath(ctx)
+		componentName = odocontext.GetComponentName(ctx)
+		appName       = odocontext.GetApplication(ctx)
+		platformName  = fcontext.GetPlatform(ctx, commonflags.PlatformCluster)
+	)
+
+	var (
+		platformClient platform.Client
+		kubeClient     kclient.ClientInterface
+	)
+	switch platformName {
+	case commonflags.PlatformPodman:
+		platformClient = o.clientset.PodmanClient
+	case commonflags.PlatformDocker:
+		platformClient = o.clientset.DockerClient
+	default:
+		platformClient = o.clientset.KubernetesClient
+		kubeClient = o.clientset.KubernetesClient
+	}
+
+	pod, err := platformClient.GetRunningPodFromSelector(odolabels.GetSelector(componentName, appName, odolabels.ComponentDevMode, false))
+	if err != nil {
+		return fmt.Errorf("unable to find the running component %q, please check that `odo dev` is running on the %s platform: %w", componentName, platformName, err)
+	}
+
+	handler := component.NewRunCommandHandler(
+		ctx,
+		o.clientset.FS,
+		platformClient,
+		kubeClient,
+		*devfileObj,
+		filepath.Dir(devfilePath),
+		appName,
+		componentName,
+		pod.Name,
+		platformName,
+		log.GetStdout(),
+		log.GetStderr(),
+	)
+	err = libdevfile.ExecuteCommandByName(*devfileObj, o.commandName, handler, false)
+	var execErr component.ExecCommandError
+	if errors.As(err, &execErr) {
+		// odo terminates with the exit code of the command
+		return odoerrors.NewExitCodeError(err, execErr.ExitCode())
+	}
+	return err
+}
+
+func NewCmdRun(name, fullName string) *cobra.Command {
+	o := NewRunOptions()
+	runCmd := &cobra.Command{
+		Use:   name + " <command-id>",
+		Short: "Run a specific command of the Devfile in the component running in Dev mode",
+		Long: `odo run executes a specific command of the Devfile, of any kind, in the component running with odo dev.
+The output of the command is displayed and odo run exits with the exit code of the command.`,
+		Example: fmt.Sprintf(runExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	clientset.Add(runCmd, clientset.FILESYSTEM, clientset.KUBERNETES_NULLABLE, clientset.PODMAN_NULLABLE, clientset.DOCKER_PLATFORM)
+
+	odoutil.SetCommandGroup(runCmd, odoutil.MainGroup)
+	runCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
+	commonflags.UsePlatformFlag(runCmd, commonflags.PlatformDocker)
+	return runCmd
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"flag"
 	"fmt"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -18,15 +19,18 @@ const (
 	PlatformFlagName = "platform"
 	PlatformCluster  = "cluster"
 	PlatformPodman   = "podman"
+	PlatformDocker   = "docker"
 	PlatformDefault  = PlatformCluster
 )
 
-// UsePlatformFlag indicates that a command accepts the --platform flag
-func UsePlatformFlag(cmd *cobra.Command) {
+// UsePlatformFlag indicates that a command accepts the --platform flag, for the cluster and podman platforms
+// and for the additional platforms passed as parameter
+func UsePlatformFlag(cmd *cobra.Command, additionalPlatforms ...string) {
 	if cmd.Annotations == nil {
 		cmd.Annotations = map[string]string{}
 	}
-	cmd.Annotations["platform"] = "true"
+	platforms := append([]string{PlatformCluster, PlatformPodman}, additionalPlatforms...)
+	cmd.Annotations["platform"] = strings.Join(platforms, ",")
 }
 
 // AddPlatformFlag adds the --platform output flag to all commands
@@ -35,7 +39,7 @@ func UsePlatformFlag(cmd *cobra.Command) {
 // package
 func AddPlatformFlag(ctx context.Context) {
 	if feature.IsEnabled(ctx, feature.GenericPlatformFlag) {
-		flag.CommandLine.String(PlatformFlagName, "", `Specify target platform, supported platforms: "cluster" (default), "podman" (experimental)`)
+		flag.CommandLine.String(PlatformFlagName, "", `Specify target platform, supported platforms: "cluster" (default), "podman" (experimental), "docker" (experimental)`)
 		_ = pflag.CommandLine.MarkHidden(PlatformFlagName)
 	}
 }
@@ -49,8 +53,8 @@ func CheckPlatformCommand(cmd *cobra.Command) error {
 	platform := cmd.Annotations["platform"]
 
 	// Check the valid output
-	if hasFlagChanged && platformFlag.Value.String() != PlatformPodman && platformFlag.Value.String() != PlatformCluster {
-		return fmt.Errorf(`%s is not a valid target platform for --platform, please select either "cluster" (default) or "podman" (experimental)`, platformFlag.Value.String())
+	if hasFlagChanged && platformFlag.Value.String() != PlatformPodman && platformFlag.Value.String() != PlatformCluster && platformFlag.Value.String() != PlatformDocker {
+		return fmt.Errorf(`%s is not a valid target platform for --platform, please select either "cluster" (default), "podman" (experimental) or "docker" (experimental)`, platformFlag.Value.String())
 	}
 
 	// Check that if -o json has been passed, that the command actually USES json.. if not, error out.
@@ -58,6 +62,20 @@ func CheckPlatformCommand(cmd *cobra.Command) error {
 		return errors.New("--platform flag is not supported for this command")
 	}
 
+	// Check that the command supports the requested platform
+	if hasFlagChanged && platformFlag.Value.String() != "" {
+		supported := false
+		for _, p := range strings.Split(platform, ",") {
+			if p == platformFlag.Value.String() {
+				supported = true
+				break
+			}
+		}
+		if !supported {
+			return fmt.Errorf("platform %q is not supported for this command", platformFlag.Value.String())
+		}
+	}
+
 	return nil
 }
 
@@ -39,7 +39,33 @@ func TestUsePlatformFlagWrongValue(t *testing.T) {
 		t.Errorf("Set error should be nil but is %v", err)
 	}
 	err = CheckPlatformCommand(cmd)
-	if err.Error() != `wrong-value is not a valid target platform for --platform, please select either "cluster" (default) or "podman" (experimental)` {
+	if err.Error() != `wrong-value is not a valid target platform for --platform, please select either "cluster" (default), "podman" (experimental) or "docker" (experimental)` {
 		t.Errorf("Check error is %v", err)
 	}
 }
+
+func TestUsePlatformFlagNotSupportedPlatform(t *testing.T) {
+	cmd := &cobra.Command{}
+	UsePlatformFlag(cmd)
+	err := pflag.CommandLine.Set("platform", "docker")
+	if err != nil {
+		t.Errorf("Set error should be nil but is %v", err)
+	}
+	err = CheckPlatformCommand(cmd)
+	if err == nil || err.Error() != `platform "docker" is not supported for this command` {
+		t.Errorf("Check error is %v", err)
+	}
+}
+
+func TestUsePlatformFlagAdditionalPlatform(t *testing.T) {
+	cmd := &cobra.Command{}
+	UsePlatformFlag(cmd, PlatformDocker)
+	err := pflag.CommandLine.Set("platform", "docker")
+	if err != nil {
+		t.Errorf("Set error should be nil but is %v", err)
+	}
+	err = CheckPlatformCommand(cmd)
+	if err != nil {
+		t.Errorf("Check error should be nil but is %v", err)
+	}
+}
@@ -16,6 +16,7 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/dev/kubedev"
 	"github.com/redhat-developer/odo/pkg/dev/podmandev"
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/logs"
 	"github.com/redhat-developer/odo/pkg/odo/commonflags"
@@ -50,6 +51,12 @@ const (
 	DEPLOY = "DEP_DEPLOY"
 	// DEV instantiates client for pkg/dev
 	DEV = "DEP_DEV"
+	// DOCKER instantiates client for pkg/docker
+	DOCKER = "DEP_DOCKER"
+	// DOCKER_NULLABLE instantiates client for pkg/docker, can be nil
+	DOCKER_NULLABLE = "DEP_DOCKER_NULLABLE"
+	// DOCKER_PLATFORM instantiates client for pkg/docker only when the docker platform is selected, can be nil
+	DOCKER_PLATFORM = "DEP_DOCKER_PLATFORM"
 	// EXEC instantiates client for pkg/exec
 	EXEC = "DEP_EXEC"
 	// FILESYSTEM instantiates client for pkg/testingutil/filesystem
@@ -88,8 +95,8 @@ const (
 var subdeps map[string][]string = map[string][]string{
 	ALIZER:           {REGISTRY},
 	DELETE_COMPONENT: {KUBERNETES_NULLABLE, PODMAN_NULLABLE, EXEC},
-	DEPLOY:           {KUBERNETES, FILESYSTEM},
-	DEV:              {BINDING, DELETE_COMPONENT, EXEC, FILESYSTEM, KUBERNETES_NULLABLE, PODMAN_NULLABLE, PORT_FORWARD, PREFERENCE, STATE, SYNC, WATCH},
+	DEPLOY:           {KUBERNETES_NULLABLE, PODMAN_NULLABLE, FILESYSTEM, PREFERENCE},
+	DEV:              {BINDING, DELETE_COMPONENT, DOCKER_PLATFORM, EXEC, FILESYSTEM, KUBERNETES_NULLABLE, PODMAN_NULLABLE, PORT_FORWARD, PREFERENCE, STATE, SYNC, WATCH},
 	EXEC:             {KUBERNETES_NULLABLE},
 	INIT:             {ALIZER, FILESYSTEM, PREFERENCE, REGISTRY},
 	LOGS:             {KUBERNETES_NULLABLE, PODMAN_NULLABLE},
@@ -97,8 +104,8 @@ var subdeps map[string][]string = map[string][]string{
 	PROJECT:          {KUBERNETES},
 	REGISTRY:         {FILESYSTEM, PREFERENCE},
 	STATE:            {FILESYSTEM},
-	SYNC:             {EXEC},
-	WATCH:            {KUBERNETES_NULLABLE},
+	SYNC:             {EXEC, PREFERENCE},
+	WATCH:            {KUBERNETES_NULLABLE, PREFERENCE, STATE},
 	BINDING:          {PROJECT, KUBERNETES_NULLABLE},
 	/* Add sub-dependencies here, if any */
 }
@@ -109,6 +116,7 @@ type Clientset struct {
 	DeleteClient      _delete.Client
 	DeployClient      deploy.Client
 	DevClient         dev.Client
+	DockerClient      docker.Client
 	ExecClient        exec.Client
 	FS                filesystem.Filesystem
 	InitClient        _init.Client
@@ -167,7 +175,7 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 
 	}
 	if isDefined(command, PODMAN) || isDefined(command, PODMAN_NULLABLE) {
-		dep.PodmanClient, err = podman.NewPodmanCli(ctx)
+		dep.PodmanClient, err = podman.NewPodmanClient(ctx)
 		if err != nil {
 			if isDefined(command, PODMAN) {
 				return nil, err
@@ -175,6 +183,16 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 			dep.PodmanClient = nil
 		}
 	}
+	if isDefined(command, DOCKER) || isDefined(command, DOCKER_NULLABLE) ||
+		(isDefined(command, DOCKER_PLATFORM) && platform == commonflags.PlatformDocker) {
+		dep.DockerClient, err = docker.NewDockerCli(ctx)
+		if err != nil {
+			if isDefined(command, DOCKER) {
+				return nil, err
+			}
+			dep.DockerClient = nil
+		}
+	}
 	if isDefined(command, PREFERENCE) {
 		dep.PreferenceClient, err = preference.NewClient(ctx)
 		if err != nil {
@@ -193,6 +211,8 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 		switch platform {
 		case commonflags.PlatformPodman:
 			dep.ExecClient = exec.NewExecClient(dep.PodmanClient)
+		case commonflags.PlatformDocker:
+			dep.ExecClient = exec.NewExecClient(dep.DockerClient)
 		default:
 			dep.ExecClient = exec.NewExecClient(dep.KubernetesClient)
 		}
@@ -201,7 +221,12 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 		dep.DeleteClient = _delete.NewDeleteComponentClient(dep.KubernetesClient, dep.PodmanClient, dep.ExecClient)
 	}
 	if isDefined(command, DEPLOY) {
-		dep.DeployClient = deploy.NewDeployClient(dep.KubernetesClient, dep.FS)
+		switch platform {
+		case commonflags.PlatformPodman:
+			dep.DeployClient = deploy.NewPodmanDeployClient(dep.PodmanClient, dep.FS)
+		default:
+			dep.DeployClient = deploy.NewDeployClient(dep.KubernetesClient, dep.PreferenceClient, dep.FS)
+		}
 	}
 	if isDefined(command, INIT) {
 		dep.InitClient = _init.NewInitClient(dep.FS, dep.PreferenceClient, dep.RegistryClient, dep.AlizerClient)
@@ -223,13 +248,15 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 	if isDefined(command, SYNC) {
 		switch platform {
 		case commonflags.PlatformPodman:
-			dep.SyncClient = sync.NewSyncClient(dep.PodmanClient, dep.ExecClient)
+			dep.SyncClient = sync.NewSyncClient(dep.PodmanClient, dep.ExecClient, dep.PreferenceClient)
+		case commonflags.PlatformDocker:
+			dep.SyncClient = sync.NewSyncClient(dep.DockerClient, dep.ExecClient, dep.PreferenceClient)
 		default:
-			dep.SyncClient = sync.NewSyncClient(dep.KubernetesClient, dep.ExecClient)
+			dep.SyncClient = sync.NewSyncClient(dep.KubernetesClient, dep.ExecClient, dep.PreferenceClient)
 		}
 	}
 	if isDefined(command, WATCH) {
-		dep.WatchClient = watch.NewWatchClient(dep.KubernetesClient)
+		dep.WatchClient = watch.NewWatchClient(dep.KubernetesClient, dep.PreferenceClient, dep.StateClient)
 	}
 	if isDefined(command, BINDING) {
 		dep.BindingClient = binding.NewBindingClient(dep.ProjectClient, dep.KubernetesClient)
@@ -241,11 +268,23 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 		switch platform {
 		case commonflags.PlatformPodman:
 			dep.DevClient = podmandev.NewDevClient(
+				commonflags.PlatformPodman,
 				dep.PodmanClient,
 				dep.SyncClient,
 				dep.ExecClient,
 				dep.StateClient,
 				dep.WatchClient,
+				dep.FS,
+			)
+		case commonflags.PlatformDocker:
+			dep.DevClient = podmandev.NewDevClient(
+				commonflags.PlatformDocker,
+				dep.DockerClient,
+				dep.SyncClient,
+				dep.ExecClient,
+				dep.StateClient,
+				dep.WatchClient,
+				dep.FS,
 			)
 		default:
 			dep.DevClient = kubedev.NewDevClient(
@@ -258,6 +297,7 @@ func Fetch(command *cobra.Command, platform string) (*Clientset, error) {
 				dep.FS,
 				dep.ExecClient,
 				dep.DeleteClient,
+				dep.StateClient,
 			)
 		}
 	}
@@ -1,6 +1,9 @@
 package genericclioptions
 
 import (
+	"path/filepath"
+
+	"github.com/redhat-developer/odo/pkg/ignore"
 	pkgUtil "github.com/redhat-developer/odo/pkg/util"
 
 	dfutil "github.com/devfile/library/v2/pkg/util"
@@ -11,26 +14,40 @@ const (
 )
 
 // ApplyIgnore will take the current ignores []string and append the mandatory odo-file-index.json and
-// .git ignores; or find the .odoignore/.gitignore file in the directory and use that instead.
+// .git ignores; or load the rules of the .odoignore/.gitignore files of the directory and of its subdirectories,
+// and of the excludes files of git, and use them instead.
 func ApplyIgnore(ignores *[]string, sourcePath string) (err error) {
 	if len(*ignores) == 0 {
-		rules, err := dfutil.GetIgnoreRulesFromDirectory(sourcePath)
+		rules, err := GetIgnoreRules(sourcePath)
 		if err != nil {
 			return err
 		}
-		*ignores = append(*ignores, rules...)
+		*ignores = ignore.Patterns(rules)
+		return nil
 	}
 
-	indexFile := pkgUtil.GetIndexFileRelativeToContext()
-	// check if the ignores flag has the index file
-	if !dfutil.In(*ignores, indexFile) {
-		*ignores = append(*ignores, indexFile)
+	for _, pattern := range getMandatoryIgnores() {
+		// check if the ignores flag has the index file and the git dir
+		if !dfutil.In(*ignores, pattern) {
+			*ignores = append(*ignores, pattern)
+		}
 	}
+	return nil
+}
 
-	// check if the ignores flag has the git dir
-	if !dfutil.In(*ignores, gitDirName) {
-		*ignores = append(*ignores, gitDirName)
+// GetIgnoreRules returns the rules to ignore files in sourcePath, defined by the .odoignore/.gitignore files
+// and by the excludes files of git, followed by the mandatory odo-file-index.json and .git rules
+func GetIgnoreRules(sourcePath string) ([]ignore.Rule, error) {
+	rules, err := ignore.LoadRules(sourcePath)
+	if err != nil {
+		return nil, err
 	}
+	for _, pattern := range getMandatoryIgnores() {
+		rules = append(rules, ignore.Rule{Pattern: pattern, Text: pattern})
+	}
+	return rules, nil
+}
 
-	return nil
+func getMandatoryIgnores() []string {
+	return []string{filepath.ToSlash(pkgUtil.GetIndexFileRelativeToContext()), gitDirName}
 }
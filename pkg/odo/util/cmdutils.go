@@ -1,12 +1,14 @@
 package util
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/log"
 	"github.com/redhat-developer/odo/pkg/machineoutput"
+	odoerrors "github.com/redhat-developer/odo/pkg/odo/cli/errors"
 	"github.com/spf13/cobra"
 	"golang.org/x/text/cases"
 	"golang.org/x/text/language"
@@ -43,7 +45,8 @@ func LogError(err error, context string) {
 	}
 }
 
-// LogErrorAndExit prints the given error and exits the code with an exit code of 1.
+// LogErrorAndExit prints the given error and exits the code with an exit code of 1,
+// or with the exit code of the error if it is an ExitCodeError.
 // If the context is provided, then that is printed alongside the error.
 // *If* we are using the global json parameter, we instead output the json output
 func LogErrorAndExit(err error, context string) {
@@ -51,7 +54,10 @@ func LogErrorAndExit(err error, context string) {
 	LogError(err, context)
 
 	if err != nil {
-		// Always exit 1 anyways
+		var exitCodeErr odoerrors.ExitCodeError
+		if errors.As(err, &exitCodeErr) {
+			os.Exit(exitCodeErr.ExitCode())
+		}
 		os.Exit(1)
 	}
 }
@@ -1,6 +1,12 @@
 package platform
 
-import "fmt"
+import (
+	"errors"
+	"fmt"
+	osexec "os/exec"
+
+	"k8s.io/client-go/util/exec"
+)
 
 // PodNotFoundError returns an error if no pod is found with the selector
 type PodNotFoundError struct {
@@ -10,3 +16,33 @@ type PodNotFoundError struct {
 func (e *PodNotFoundError) Error() string {
 	return fmt.Sprintf("pod not found for the selector: %s", e.Selector)
 }
+
+// ExecExitError is returned when a command executed in a container terminates with a non-zero exit code
+type ExecExitError struct {
+	Command  []string
+	ExitCode int
+}
+
+func (e *ExecExitError) Error() string {
+	return fmt.Sprintf("command %v exited with code %d", e.Command, e.ExitCode)
+}
+
+// GetExitCode returns the exit code of a command executed in a container with ExecCMDInContainer,
+// if err indicates that the command terminated with a non-zero exit code
+func GetExitCode(err error) (int, bool) {
+	var execExitErr *ExecExitError
+	if errors.As(err, &execExitErr) {
+		return execExitErr.ExitCode, true
+	}
+	// returned by the Kubernetes client
+	var kubeExitErr exec.ExitError
+	if errors.As(err, &kubeExitErr) && kubeExitErr.Exited() {
+		return kubeExitErr.ExitStatus(), true
+	}
+	// returned by the clients executing the podman and docker commands
+	var cmdExitErr *osexec.ExitError
+	if errors.As(err, &cmdExitErr) && cmdExitErr.Exited() {
+		return cmdExitErr.ExitCode(), true
+	}
+	return 0, false
+}
@@ -0,0 +1,52 @@
+package platform
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"k8s.io/client-go/util/exec"
+)
+
+func TestGetExitCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantCode int
+		wantOk   bool
+	}{
+		{
+			name:     "ExecExitError",
+			err:      &ExecExitError{Command: []string{"false"}, ExitCode: 3},
+			wantCode: 3,
+			wantOk:   true,
+		},
+		{
+			name:     "wrapped Kubernetes exit error",
+			err:      fmt.Errorf("error while streaming command: %w", exec.CodeExitError{Err: errors.New("exit"), Code: 42}),
+			wantCode: 42,
+			wantOk:   true,
+		},
+		{
+			name:   "other error",
+			err:    errors.New("connection refused"),
+			wantOk: false,
+		},
+		{
+			name:   "no error",
+			err:    nil,
+			wantOk: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotCode, gotOk := GetExitCode(tt.err)
+			if gotOk != tt.wantOk {
+				t.Errorf("GetExitCode() ok = %v, want %v", gotOk, tt.wantOk)
+			}
+			if gotCode != tt.wantCode {
+				t.Errorf("GetExitCode() code = %d, want %d", gotCode, tt.wantCode)
+			}
+		})
+	}
+}
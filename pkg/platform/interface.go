@@ -5,6 +5,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/remotecommand"
 )
 
 // Client is the interface that wraps operations that can be performed on any supported platform.
@@ -14,6 +15,10 @@ type Client interface {
 	// If an empty string is passed as container name, the command will be executed in the first container found in the pod.
 	ExecCMDInContainer(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error
 
+	// ExecInteractiveCMDInContainer executes the specified command in the container of a pod, in a TTY attached to stdin and stdout.
+	// The TTY is resized each time a new size is returned by sizeQueue, if not nil.
+	ExecInteractiveCMDInContainer(containerName, podName string, cmd []string, stdin io.Reader, stdout io.Writer, sizeQueue remotecommand.TerminalSizeQueue) error
+
 	// GetPodLogs returns the logs of the specified pod container.
 	// All logs for all containers part of the pod are returned if an empty string is provided as container name.
 	GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error)
@@ -0,0 +1,481 @@
+package podman
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	"github.com/redhat-developer/odo/pkg/config"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/klog"
+	"k8s.io/kubectl/pkg/scheme"
+)
+
+const (
+	// apiBaseURL is the base URL of the libpod API. The host is ignored, as requests are sent through the Unix socket
+	apiBaseURL = "http://d/v4.0.0/libpod"
+
+	// rootfulSocketPath is the path of the socket of the Podman service running as root,
+	// used when XDG_RUNTIME_DIR is not defined
+	rootfulSocketPath = "/run/podman/podman.sock"
+)
+
+// PodmanAPIClient is a podman client communicating with the Podman service through the libpod REST API,
+// exposed on a local Unix socket
+type PodmanAPIClient struct {
+	socketPath string
+	httpClient *http.Client
+}
+
+var _ Client = (*PodmanAPIClient)(nil)
+
+// NewPodmanAPIClient returns a new podman client using the libpod API exposed on the socket at socketPath,
+// or an error if the API is not accessible
+func NewPodmanAPIClient(socketPath string) (*PodmanAPIClient, error) {
+	client := newPodmanAPIClient(socketPath)
+	version, err := client.Version()
+	if err != nil {
+		return nil, fmt.Errorf("podman API not accessible on socket %q: %w", socketPath, err)
+	}
+	if version.Client == nil {
+		return nil, fmt.Errorf("socket %q not recognized as a podman API socket", socketPath)
+	}
+	return client, nil
+}
+
+func newPodmanAPIClient(socketPath string) *PodmanAPIClient {
+	return &PodmanAPIClient{
+		socketPath: socketPath,
+		httpClient: &http.Client{
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					var dialer net.Dialer
+					return dialer.DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+	}
+}
+
+// getSocketPath returns the path of the socket of the Podman service for the current user
+func getSocketPath(envConfig config.Configuration) string {
+	if envConfig.XdgRuntimeDir != nil && *envConfig.XdgRuntimeDir != "" {
+		return filepath.Join(*envConfig.XdgRuntimeDir, "podman", "podman.sock")
+	}
+	return rootfulSocketPath
+}
+
+// apiError is the body returned by the libpod API when an error occurs
+type apiError struct {
+	Cause    string `json:"cause"`
+	Message  string `json:"message"`
+	Response int    `json:"response"`
+}
+
+// do sends a request to the libpod API and returns the response if its status is successful.
+// The caller is responsible for closing the body of the response.
+func (o *PodmanAPIClient) do(method string, path string, query url.Values, contentType string, body io.Reader) (*http.Response, error) {
+	u := apiBaseURL + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+	req, err := http.NewRequest(method, u, body)
+	if err != nil {
+		return nil, err
+	}
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+	klog.V(3).Infof("calling podman API: %s %s", method, u)
+	resp, err := o.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode >= http.StatusBadRequest {
+		defer resp.Body.Close()
+		return nil, readAPIError(method, path, resp)
+	}
+	return resp, nil
+}
+
+// readAPIError returns an error built from the error response of the libpod API
+func readAPIError(method string, path string, resp *http.Response) error {
+	content, _ := io.ReadAll(resp.Body)
+	var apiErr apiError
+	if err := json.Unmarshal(content, &apiErr); err == nil && apiErr.Message != "" {
+		return fmt.Errorf("%s %s: %s", method, path, apiErr.Message)
+	}
+	return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, strings.TrimSpace(string(content)))
+}
+
+// call sends a request to the libpod API, with the JSON encoding of in as body if not nil,
+// and decodes the JSON response in out if not nil
+func (o *PodmanAPIClient) call(method string, path string, query url.Values, in interface{}, out interface{}) error {
+	var (
+		body        io.Reader
+		contentType string
+	)
+	if in != nil {
+		content, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(content)
+		contentType = "application/json"
+	}
+	resp, err := o.do(method, path, query, contentType, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if out == nil {
+		_, err = io.Copy(io.Discard, resp.Body)
+		return err
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+// apiPath returns the path of an endpoint of the API, from its escaped segments
+func apiPath(segments ...string) string {
+	var sb strings.Builder
+	for _, segment := range segments {
+		sb.WriteString("/")
+		sb.WriteString(url.PathEscape(segment))
+	}
+	return sb.String()
+}
+
+// filtersQuery returns the query parameters to filter lists of objects.
+// Filters without values are ignored.
+func filtersQuery(filters map[string][]string) (url.Values, error) {
+	nonEmpty := make(map[string][]string, len(filters))
+	for k, v := range filters {
+		if len(v) > 0 {
+			nonEmpty[k] = v
+		}
+	}
+	if len(nonEmpty) == 0 {
+		return nil, nil
+	}
+	content, err := json.Marshal(nonEmpty)
+	if err != nil {
+		return nil, err
+	}
+	return url.Values{"filters": []string{string(content)}}, nil
+}
+
+// playKubeReport contains the result of the play kube endpoint
+type playKubeReport struct {
+	Pods []struct {
+		ID              string
+		ContainerErrors []string
+	}
+}
+
+func (o *PodmanAPIClient) PlayKube(pod *corev1.Pod, resources []unstructured.Unstructured) error {
+	var buf bytes.Buffer
+	err := encodePodWithResources(pod, resources, &buf)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Pod spec to play: \n%s---\n", buf.String())
+
+	return o.playKube(nil, &buf)
+}
+
+func (o *PodmanAPIClient) PlayKubeResources(resources []unstructured.Unstructured) error {
+	var buf bytes.Buffer
+	for _, resource := range resources {
+		content, err := yaml.Marshal(resource.Object)
+		if err != nil {
+			return err
+		}
+		buf.WriteString("---\n")
+		buf.Write(content)
+	}
+
+	klog.V(4).Infof("Resources to play: \n%s---\n", buf.String())
+
+	return o.playKube(url.Values{"replace": []string{"true"}}, &buf)
+}
+
+// playKube sends the YAML definitions of resources to the play kube endpoint
+func (o *PodmanAPIClient) playKube(query url.Values, definitions io.Reader) error {
+	resp, err := o.do(http.MethodPost, "/play/kube", query, "application/yaml", definitions)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	var report playKubeReport
+	err = json.NewDecoder(resp.Body).Decode(&report)
+	if err != nil {
+		return err
+	}
+	var errs []string
+	for _, pod := range report.Pods {
+		errs = append(errs, pod.ContainerErrors...)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("errors creating the containers of the pod:\n%s", strings.Join(errs, "\n"))
+	}
+	return nil
+}
+
+func (o *PodmanAPIClient) KubeGenerate(name string) (*corev1.Pod, error) {
+	serializer := jsonserializer.NewSerializerWithOptions(
+		jsonserializer.SimpleMetaFactory{},
+		scheme.Scheme,
+		scheme.Scheme,
+		jsonserializer.SerializerOptions{
+			Yaml: true,
+		},
+	)
+
+	resp, err := o.do(http.MethodGet, "/generate/kube", url.Values{"names": []string{name}}, "", nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	resultBytes, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var pod corev1.Pod
+	_, _, err = serializer.Decode(resultBytes, nil, &pod)
+	if err != nil {
+		return nil, err
+	}
+	return &pod, nil
+}
+
+func (o *PodmanAPIClient) PodStop(podname string) error {
+	err := o.call(http.MethodPost, apiPath("pods", podname, "stop"), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Stopped pod %s", podname)
+	return nil
+}
+
+func (o *PodmanAPIClient) PodRm(podname string) error {
+	err := o.call(http.MethodDelete, apiPath("pods", podname), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Deleted pod %s", podname)
+	return nil
+}
+
+func (o *PodmanAPIClient) PodLs(selector string) (map[string]bool, error) {
+	filters, err := LabelFilters(selector)
+	if err != nil {
+		return nil, err
+	}
+	list, err := o.listPods(map[string][]string{
+		"label": filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool, len(list))
+	for _, pod := range list {
+		result[pod.Name] = true
+	}
+	return result, nil
+}
+
+func (o *PodmanAPIClient) PodInspect(podname string) (PodInspectData, error) {
+	var result PodInspectData
+	err := o.call(http.MethodGet, apiPath("pods", podname, "json"), nil, nil, &result)
+	return result, err
+}
+
+func (o *PodmanAPIClient) VolumeRm(volumeName string) error {
+	err := o.call(http.MethodDelete, apiPath("volumes", volumeName), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Deleted volume %s", volumeName)
+	return nil
+}
+
+func (o *PodmanAPIClient) VolumeInspect(volumeName string) (VolumeInspectData, error) {
+	var result VolumeInspectData
+	err := o.call(http.MethodGet, apiPath("volumes", volumeName, "json"), nil, nil, &result)
+	return result, err
+}
+
+func (o *PodmanAPIClient) VolumeLs(selector string) (map[string]bool, error) {
+	filters, err := LabelFilters(selector)
+	if err != nil {
+		return nil, err
+	}
+	query, err := filtersQuery(map[string][]string{
+		"label": filters,
+	})
+	if err != nil {
+		return nil, err
+	}
+	var list []struct {
+		Name string
+	}
+	err = o.call(http.MethodGet, "/volumes/json", query, nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool, len(list))
+	for _, volume := range list {
+		result[volume.Name] = true
+	}
+	return result, nil
+}
+
+func (o *PodmanAPIClient) SecretLs() (map[string]bool, error) {
+	var list []struct {
+		Spec struct {
+			Name string
+		}
+	}
+	err := o.call(http.MethodGet, "/secrets/json", nil, nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[string]bool, len(list))
+	for _, secret := range list {
+		result[secret.Spec.Name] = true
+	}
+	return result, nil
+}
+
+func (o *PodmanAPIClient) SecretRm(secretName string) error {
+	err := o.call(http.MethodDelete, apiPath("secrets", secretName), nil, nil, nil)
+	if err != nil {
+		return err
+	}
+	klog.V(4).Infof("Deleted secret %s", secretName)
+	return nil
+}
+
+func (o *PodmanAPIClient) CleanupPodResources(pod *corev1.Pod) error {
+	return cleanupPodResources(o, pod)
+}
+
+// GetPodsMatchingSelector returns all pods matching the given label selector.
+// Only the names of the containers are set in the specs of the returned pods.
+func (o *PodmanAPIClient) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
+	list, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	return toPodList(list), nil
+}
+
+// GetAllResourcesFromSelector returns all resources of any kind matching the given label selector.
+func (o *PodmanAPIClient) GetAllResourcesFromSelector(selector string, _ string) ([]unstructured.Unstructured, error) {
+	list, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	return toUnstructuredList(list), nil
+}
+
+// GetAllPodsInNamespaceMatchingSelector returns all pods matching the given label selector and in the specified namespace.
+// As there is no namespace on Podman, the namespace is ignored.
+func (o *PodmanAPIClient) GetAllPodsInNamespaceMatchingSelector(selector string, _ string) (*corev1.PodList, error) {
+	return o.GetPodsMatchingSelector(selector)
+}
+
+// GetRunningPodFromSelector returns any pod matching the given label selector.
+// If multiple pods are found, implementations might have different behavior, by either returning an error or returning any element.
+func (o *PodmanAPIClient) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	list, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	return getRunningPod(selector, list, o.PodInspect)
+}
+
+func (o *PodmanAPIClient) ListAllComponents() ([]api.ComponentAbstract, error) {
+	list, err := o.listPods(map[string][]string{
+		"status": {"running"},
+		"label":  {odolabels.SelectorBuilder().WithAnyComponent().Selector()},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toComponents(list), nil
+}
+
+func (o *PodmanAPIClient) getPodsFromSelector(selector string) ([]ListPodsReport, error) {
+	filters, err := LabelFilters(selector)
+	if err != nil {
+		return nil, err
+	}
+	return o.listPods(map[string][]string{
+		"label": filters,
+	})
+}
+
+// listPods returns the pods matching the filters
+func (o *PodmanAPIClient) listPods(filters map[string][]string) ([]ListPodsReport, error) {
+	query, err := filtersQuery(filters)
+	if err != nil {
+		return nil, err
+	}
+	var list []ListPodsReport
+	err = o.call(http.MethodGet, "/pods/json", query, nil, &list)
+	if err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// versionReport contains the result of the version endpoint
+type versionReport struct {
+	Version    string
+	APIVersion string `json:"ApiVersion"`
+	GoVersion  string
+	GitCommit  string
+	BuildTime  string
+	Os         string
+	Arch       string
+}
+
+// Version returns the version of the Podman service, as the client version,
+// as the API is versioned with the service
+func (o *PodmanAPIClient) Version() (SystemVersionReport, error) {
+	var report versionReport
+	err := o.call(http.MethodGet, "/version", nil, nil, &report)
+	if err != nil {
+		return SystemVersionReport{}, err
+	}
+	if report.Version == "" {
+		return SystemVersionReport{}, nil
+	}
+	return SystemVersionReport{
+		Client: &Version{
+			APIVersion: report.APIVersion,
+			Version:    report.Version,
+			GoVersion:  report.GoVersion,
+			GitCommit:  report.GitCommit,
+			BuiltTime:  report.BuildTime,
+			OsArch:     report.Os + "/" + report.Arch,
+			Os:         report.Os,
+		},
+	}, nil
+}
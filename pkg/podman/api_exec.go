@@ -0,0 +1,293 @@
+package podman
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/tools/remotecommand"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/platform"
+)
+
+// streamStderr identifies the frames of the error stream, in the multiplexed streams returned by the libpod API
+// for commands and containers without TTY
+const streamStderr = 2
+
+const (
+	// execInspectInterval is the interval between two inspections of an exec session whose command is still running
+	execInspectInterval = 100 * time.Millisecond
+	// execInspectTimeout is the maximum duration to wait for the command of an exec session to be reported as exited,
+	// after its output streams are closed
+	execInspectTimeout = 10 * time.Second
+)
+
+// execCreateConfig is the configuration of an exec session, sent to the exec endpoint of a container
+type execCreateConfig struct {
+	AttachStdin  bool
+	AttachStdout bool
+	AttachStderr bool
+	Tty          bool
+	Cmd          []string
+}
+
+// execStartConfig is the configuration sent to start an exec session
+type execStartConfig struct {
+	Detach bool
+	Tty    bool
+}
+
+// execInspectReport contains the state of an exec session
+type execInspectReport struct {
+	Running  bool
+	ExitCode int
+}
+
+func (o *PodmanAPIClient) ExecCMDInContainer(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+	return o.execCMDInContainer(containerName, podName, cmd, stdout, stderr, stdin, tty, nil)
+}
+
+func (o *PodmanAPIClient) ExecInteractiveCMDInContainer(containerName, podName string, cmd []string, stdin io.Reader, stdout io.Writer, sizeQueue remotecommand.TerminalSizeQueue) error {
+	return o.execCMDInContainer(containerName, podName, cmd, stdout, nil, stdin, true, sizeQueue)
+}
+
+func (o *PodmanAPIClient) execCMDInContainer(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool, sizeQueue remotecommand.TerminalSizeQueue) error {
+	name := fmt.Sprintf("%s-%s", podName, containerName)
+
+	var created struct {
+		ID string `json:"Id"`
+	}
+	err := o.call(http.MethodPost, apiPath("containers", name, "exec"), nil, execCreateConfig{
+		AttachStdin:  stdin != nil,
+		AttachStdout: true,
+		AttachStderr: true,
+		Tty:          tty,
+		Cmd:          cmd,
+	}, &created)
+	if err != nil {
+		return err
+	}
+
+	conn, reader, err := o.hijack(apiPath("exec", created.ID, "start"), execStartConfig{Tty: tty})
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if sizeQueue != nil {
+		go o.resizeExec(created.ID, sizeQueue)
+	}
+
+	if stdin != nil {
+		go func() {
+			if _, copyErr := io.Copy(conn, stdin); copyErr != nil {
+				klog.V(4).Infof("error sending input to exec session %s: %v", created.ID, copyErr)
+			}
+			// signal the end of the input to the command
+			if closer, ok := conn.(interface{ CloseWrite() error }); ok {
+				_ = closer.CloseWrite()
+			}
+		}()
+	}
+
+	if stderr == nil {
+		stderr = io.Discard
+	}
+	if tty {
+		_, err = io.Copy(stdout, reader)
+	} else {
+		err = demuxStream(reader, stdout, stderr)
+	}
+	if err != nil {
+		return err
+	}
+
+	report, err := o.waitExecExit(created.ID)
+	if err != nil {
+		return err
+	}
+	if report.ExitCode != 0 {
+		return &platform.ExecExitError{Command: cmd, ExitCode: report.ExitCode}
+	}
+	return nil
+}
+
+// waitExecExit returns the state of the exec session once its command has exited.
+// The output streams may be closed before the command is reported as exited, the session is inspected
+// until it is not running anymore, or until execInspectTimeout.
+func (o *PodmanAPIClient) waitExecExit(id string) (execInspectReport, error) {
+	deadline := time.Now().Add(execInspectTimeout)
+	for {
+		var report execInspectReport
+		err := o.call(http.MethodGet, apiPath("exec", id, "json"), nil, nil, &report)
+		if err != nil {
+			return report, err
+		}
+		if !report.Running {
+			return report, nil
+		}
+		if time.Now().After(deadline) {
+			return report, fmt.Errorf("the command of exec session %s is still running after %s", id, execInspectTimeout)
+		}
+		time.Sleep(execInspectInterval)
+	}
+}
+
+// resizeExec resizes the TTY of the exec session each time a new size is returned by sizeQueue,
+// until the queue returns nil
+func (o *PodmanAPIClient) resizeExec(id string, sizeQueue remotecommand.TerminalSizeQueue) {
+	for size := sizeQueue.Next(); size != nil; size = sizeQueue.Next() {
+		query := url.Values{
+			"h": []string{strconv.Itoa(int(size.Height))},
+			"w": []string{strconv.Itoa(int(size.Width))},
+		}
+		if err := o.call(http.MethodPost, apiPath("exec", id, "resize"), query, nil, nil); err != nil {
+			klog.V(4).Infof("unable to resize the TTY of exec session %s: %v", id, err)
+		}
+	}
+}
+
+// hijack sends a POST request with the JSON encoding of in as body to the libpod API, and returns the connection
+// and a reader of the response, for the endpoints taking over the connection to stream data in both directions
+func (o *PodmanAPIClient) hijack(path string, in interface{}) (net.Conn, *bufio.Reader, error) {
+	content, err := json.Marshal(in)
+	if err != nil {
+		return nil, nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, apiBaseURL+path, bytes.NewReader(content))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", "tcp")
+
+	klog.V(3).Infof("calling podman API: %s %s", req.Method, req.URL)
+	conn, err := net.Dial("unix", o.socketPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	err = req.Write(conn)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		conn.Close()
+		return nil, nil, err
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols && resp.StatusCode != http.StatusOK {
+		defer conn.Close()
+		return nil, nil, readAPIError(req.Method, path, resp)
+	}
+	return conn, reader, nil
+}
+
+// demuxStream copies the frames of a multiplexed stream to stdout or stderr, depending on the stream they belong to.
+// Each frame starts with a header of 8 bytes: the stream identifier, 3 empty bytes, and the size of the frame (big endian).
+func demuxStream(r io.Reader, stdout io.Writer, stderr io.Writer) error {
+	header := make([]byte, 8)
+	for {
+		_, err := io.ReadFull(r, header)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		w := stdout
+		if header[0] == streamStderr {
+			w = stderr
+		}
+		size := binary.BigEndian.Uint32(header[4:])
+		_, err = io.CopyN(w, r, int64(size))
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetPodLogs returns the logs of the specified pod container.
+// All logs for all containers part of the pod are returned if an empty string is provided as container name.
+func (o *PodmanAPIClient) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
+	var containers []string
+	if containerName != "" {
+		containers = []string{podName + "-" + containerName}
+	} else {
+		inspect, err := o.PodInspect(podName)
+		if err != nil {
+			return nil, err
+		}
+		for _, container := range inspect.Containers {
+			if container.ID == inspect.InfraContainerID {
+				continue
+			}
+			containers = append(containers, container.Name)
+		}
+	}
+
+	query := url.Values{
+		"stdout": []string{"true"},
+		"stderr": []string{"true"},
+		"follow": []string{fmt.Sprint(followLog)},
+	}
+	result := &apiLogsReadCloser{}
+	for _, container := range containers {
+		resp, err := o.do(http.MethodGet, apiPath("containers", container, "logs"), query, "", nil)
+		if err != nil {
+			result.closeBodies()
+			return nil, err
+		}
+		result.bodies = append(result.bodies, resp.Body)
+	}
+
+	// Logs of all the containers are written to the same pipe, which is closed when all the logs have been read
+	pr, pw := io.Pipe()
+	result.PipeReader = pr
+	var wg sync.WaitGroup
+	for _, body := range result.bodies {
+		wg.Add(1)
+		go func(body io.Reader) {
+			defer wg.Done()
+			if err := demuxStream(body, pw, pw); err != nil {
+				klog.V(4).Infof("error reading logs of pod %s: %v", podName, err)
+			}
+		}(body)
+	}
+	go func() {
+		wg.Wait()
+		pw.Close()
+	}()
+	return result, nil
+}
+
+// apiLogsReadCloser reads the logs of one or several containers,
+// and closes the connections to the API when closed
+type apiLogsReadCloser struct {
+	*io.PipeReader
+	bodies []io.ReadCloser
+}
+
+func (o *apiLogsReadCloser) Close() error {
+	o.closeBodies()
+	return o.PipeReader.Close()
+}
+
+func (o *apiLogsReadCloser) closeBodies() {
+	for _, body := range o.bodies {
+		body.Close()
+	}
+}
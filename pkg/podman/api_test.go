@@ -0,0 +1,425 @@
+package podman
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/remotecommand"
+)
+
+// newTestAPIClient starts an HTTP server standing in for the Podman service, listening on a Unix socket,
+// and returns a client communicating with it
+func newTestAPIClient(t *testing.T, handler http.Handler) *PodmanAPIClient {
+	socketPath := filepath.Join(t.TempDir(), "podman.sock")
+	listener, err := net.Listen("unix", socketPath)
+	if err != nil {
+		t.Fatalf("unable to listen on socket: %v", err)
+	}
+	server := httptest.NewUnstartedServer(handler)
+	server.Listener = listener
+	server.Start()
+	t.Cleanup(server.Close)
+	return newPodmanAPIClient(socketPath)
+}
+
+// writeFrame writes data as a frame of a multiplexed stream
+func writeFrame(w io.Writer, stream byte, data string) {
+	header := make([]byte, 8)
+	header[0] = stream
+	binary.BigEndian.PutUint32(header[4:], uint32(len(data)))
+	_, _ = w.Write(header)
+	_, _ = io.WriteString(w, data)
+}
+
+func TestNewPodmanAPIClient(t *testing.T) {
+	tests := []struct {
+		name        string
+		handler     http.HandlerFunc
+		wantErr     bool
+		wantVersion string
+	}{
+		{
+			name: "podman service",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != "/v4.0.0/libpod/version" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				_, _ = io.WriteString(w, `{"Version":"4.5.0","ApiVersion":"4.5.0","Os":"linux","Arch":"amd64"}`)
+			},
+			wantVersion: "4.5.0",
+		},
+		{
+			name: "not a podman service",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.WriteString(w, `{}`)
+			},
+			wantErr: true,
+		},
+		{
+			name: "service in error",
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(http.StatusInternalServerError)
+				_, _ = io.WriteString(w, `{"cause":"unknown","message":"something went wrong","response":500}`)
+			},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := newTestAPIClient(t, tt.handler)
+			got, err := NewPodmanAPIClient(client.socketPath)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPodmanAPIClient() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			version, err := got.Version()
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if version.Client.Version != tt.wantVersion {
+				t.Errorf("expected version %q, got %q", tt.wantVersion, version.Client.Version)
+			}
+			if version.Client.OsArch != "linux/amd64" {
+				t.Errorf("expected os/arch %q, got %q", "linux/amd64", version.Client.OsArch)
+			}
+		})
+	}
+}
+
+func TestPodmanAPIClient_GetPodsMatchingSelector(t *testing.T) {
+	var gotFilters map[string][]string
+	client := newTestAPIClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v4.0.0/libpod/pods/json" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_ = json.Unmarshal([]byte(r.URL.Query().Get("filters")), &gotFilters)
+		_, _ = io.WriteString(w, `[{
+			"Name": "mycmp-app",
+			"Labels": {"app.kubernetes.io/instance": "mycmp"},
+			"Status": "Running",
+			"InfraId": "infra",
+			"Containers": [
+				{"Id": "infra", "Names": "abcdef-infra", "Status": "running"},
+				{"Id": "runtime", "Names": "mycmp-app-runtime", "Status": "running"}
+			]
+		}]`)
+	}))
+
+	got, err := client.GetPodsMatchingSelector("app.kubernetes.io/instance=mycmp,odo.dev/mode=Dev")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	wantFilters := map[string][]string{
+		"label": {"app.kubernetes.io/instance=mycmp", "odo.dev/mode=Dev"},
+	}
+	if diff := cmp.Diff(wantFilters, gotFilters); diff != "" {
+		t.Errorf("filters mismatch (-want +got):\n%s", diff)
+	}
+
+	if len(got.Items) != 1 {
+		t.Fatalf("expected 1 pod, got %d", len(got.Items))
+	}
+	pod := got.Items[0]
+	if pod.GetName() != "mycmp-app" {
+		t.Errorf("expected pod name %q, got %q", "mycmp-app", pod.GetName())
+	}
+	if pod.Status.Phase != corev1.PodRunning {
+		t.Errorf("expected pod phase %q, got %q", corev1.PodRunning, pod.Status.Phase)
+	}
+	wantContainers := []corev1.Container{{Name: "runtime"}}
+	if diff := cmp.Diff(wantContainers, pod.Spec.Containers); diff != "" {
+		t.Errorf("containers mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPodmanAPIClient_VolumeLs(t *testing.T) {
+	client := newTestAPIClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `[{"Name":"vol1"},{"Name":"vol2"}]`)
+	}))
+	got, err := client.VolumeLs("")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]bool{"vol1": true, "vol2": true}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("VolumeLs() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPodmanAPIClient_SecretLs(t *testing.T) {
+	client := newTestAPIClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, `[{"ID":"abcdef","Spec":{"Name":"secret1"}},{"ID":"012345","Spec":{"Name":"secret2"}}]`)
+	}))
+	got, err := client.SecretLs()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	want := map[string]bool{"secret1": true, "secret2": true}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SecretLs() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestPodmanAPIClient_PodRm(t *testing.T) {
+	client := newTestAPIClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodDelete || r.URL.Path != "/v4.0.0/libpod/pods/mycmp-app" {
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = io.WriteString(w, `{"cause":"no such pod","message":"no pod with name or ID mycmp-app found: no such pod","response":404}`)
+			return
+		}
+		_, _ = io.WriteString(w, `{}`)
+	}))
+	err := client.PodRm("mycmp-app")
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	err = client.PodRm("other-app")
+	if err == nil || !strings.Contains(err.Error(), "no such pod") {
+		t.Errorf("expected error containing %q, got %v", "no such pod", err)
+	}
+}
+
+func TestPodmanAPIClient_PlayKube(t *testing.T) {
+	tests := []struct {
+		name    string
+		report  string
+		wantErr bool
+	}{
+		{
+			name:   "pod created",
+			report: `{"Pods":[{"ID":"abcdef","ContainerErrors":[]}]}`,
+		},
+		{
+			name:    "containers in error",
+			report:  `{"Pods":[{"ID":"abcdef","ContainerErrors":["image not found"]}]}`,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotBody string
+			client := newTestAPIClient(t, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodPost || r.URL.Path != "/v4.0.0/libpod/play/kube" {
+					w.WriteHeader(http.StatusNotFound)
+					return
+				}
+				content, _ := io.ReadAll(r.Body)
+				gotBody = string(content)
+				_, _ = io.WriteString(w, tt.report)
+			}))
+			pod := &corev1.Pod{}
+			pod.SetName("mycmp-app")
+			configMap := unstructured.Unstructured{}
+			configMap.SetKind("ConfigMap")
+			configMap.SetName("myconfig")
+			err := client.PlayKube(pod, []unstructured.Unstructured{configMap})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("PlayKube() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !strings.Contains(gotBody, "name: mycmp-app") {
+				t.Errorf("expected the pod definition to be sent, got %q", gotBody)
+			}
+			if !strings.Contains(gotBody, "kind: ConfigMap") {
+				t.Errorf("expected the resources to be sent, got %q", gotBody)
+			}
+		})
+	}
+}
+
+func TestPodmanAPIClient_ExecCMDInContainer(t *testing.T) {
+	tests := []struct {
+		name     string
+		exitCode int
+		// runningInspections is the number of inspections reporting the command as running, before it is reported as exited
+		runningInspections int
+		wantErr            bool
+		wantStdout         string
+		wantStderr         string
+	}{
+		{
+			name:       "command succeeds",
+			wantStdout: "out: some input",
+			wantStderr: "an error message",
+		},
+		{
+			name:       "command fails",
+			exitCode:   1,
+			wantErr:    true,
+			wantStdout: "out: some input",
+			wantStderr: "an error message",
+		},
+		{
+			name:               "command fails, still running when the output is closed",
+			exitCode:           1,
+			runningInspections: 1,
+			wantErr:            true,
+			wantStdout:         "out: some input",
+			wantStderr:         "an error message",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotConfig execCreateConfig
+			inspections := 0
+			mux := http.NewServeMux()
+			mux.HandleFunc("/v4.0.0/libpod/containers/mycmp-app-runtime/exec", func(w http.ResponseWriter, r *http.Request) {
+				_ = json.NewDecoder(r.Body).Decode(&gotConfig)
+				w.WriteHeader(http.StatusCreated)
+				_, _ = io.WriteString(w, `{"Id":"exec1"}`)
+			})
+			mux.HandleFunc("/v4.0.0/libpod/exec/exec1/start", func(w http.ResponseWriter, r *http.Request) {
+				_, _ = io.ReadAll(r.Body)
+				conn, buf, err := w.(http.Hijacker).Hijack()
+				if err != nil {
+					t.Errorf("unable to hijack connection: %v", err)
+					return
+				}
+				defer conn.Close()
+				_, _ = io.WriteString(conn, "HTTP/1.1 101 UPGRADED\r\nContent-Type: application/vnd.docker.multiplexed-stream\r\nConnection: Upgrade\r\nUpgrade: tcp\r\n\r\n")
+				input, _ := io.ReadAll(buf)
+				writeFrame(conn, 1, "out: "+string(input))
+				writeFrame(conn, streamStderr, "an error message")
+			})
+			mux.HandleFunc("/v4.0.0/libpod/exec/exec1/json", func(w http.ResponseWriter, r *http.Request) {
+				inspections++
+				if inspections <= tt.runningInspections {
+					_ = json.NewEncoder(w).Encode(execInspectReport{Running: true})
+					return
+				}
+				_ = json.NewEncoder(w).Encode(execInspectReport{ExitCode: tt.exitCode})
+			})
+			client := newTestAPIClient(t, mux)
+
+			var stdout, stderr strings.Builder
+			err := client.ExecCMDInContainer("runtime", "mycmp-app", []string{"cat"}, &stdout, &stderr, strings.NewReader("some input"), false)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ExecCMDInContainer() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if inspections != tt.runningInspections+1 {
+				t.Errorf("exec session inspected %d times, want %d", inspections, tt.runningInspections+1)
+			}
+			if diff := cmp.Diff([]string{"cat"}, gotConfig.Cmd); diff != "" {
+				t.Errorf("command mismatch (-want +got):\n%s", diff)
+			}
+			if !gotConfig.AttachStdin {
+				t.Errorf("expected stdin to be attached")
+			}
+			if stdout.String() != tt.wantStdout {
+				t.Errorf("expected stdout %q, got %q", tt.wantStdout, stdout.String())
+			}
+			if stderr.String() != tt.wantStderr {
+				t.Errorf("expected stderr %q, got %q", tt.wantStderr, stderr.String())
+			}
+		})
+	}
+}
+
+// fakeSizeQueue returns the sizes it contains, then nil
+type fakeSizeQueue struct {
+	sizes []remotecommand.TerminalSize
+}
+
+func (o *fakeSizeQueue) Next() *remotecommand.TerminalSize {
+	if len(o.sizes) == 0 {
+		return nil
+	}
+	size := o.sizes[0]
+	o.sizes = o.sizes[1:]
+	return &size
+}
+
+func TestPodmanAPIClient_ExecInteractiveCMDInContainer(t *testing.T) {
+	var gotConfig execCreateConfig
+	resized := make(chan url.Values, 1)
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/containers/mycmp-app-runtime/exec", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewDecoder(r.Body).Decode(&gotConfig)
+		w.WriteHeader(http.StatusCreated)
+		_, _ = io.WriteString(w, `{"Id":"exec1"}`)
+	})
+	mux.HandleFunc("/v4.0.0/libpod/exec/exec1/resize", func(w http.ResponseWriter, r *http.Request) {
+		resized <- r.URL.Query()
+	})
+	mux.HandleFunc("/v4.0.0/libpod/exec/exec1/start", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.ReadAll(r.Body)
+		conn, buf, err := w.(http.Hijacker).Hijack()
+		if err != nil {
+			t.Errorf("unable to hijack connection: %v", err)
+			return
+		}
+		defer conn.Close()
+		_, _ = io.WriteString(conn, "HTTP/1.1 101 UPGRADED\r\nContent-Type: application/vnd.docker.raw-stream\r\nConnection: Upgrade\r\nUpgrade: tcp\r\n\r\n")
+		input, _ := io.ReadAll(buf)
+		// the output of a TTY is not multiplexed
+		_, _ = io.WriteString(conn, "out: "+string(input))
+	})
+	mux.HandleFunc("/v4.0.0/libpod/exec/exec1/json", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(execInspectReport{})
+	})
+	client := newTestAPIClient(t, mux)
+
+	var stdout strings.Builder
+	sizeQueue := &fakeSizeQueue{sizes: []remotecommand.TerminalSize{{Width: 80, Height: 24}}}
+	err := client.ExecInteractiveCMDInContainer("runtime", "mycmp-app", []string{"/bin/sh"}, strings.NewReader("some input"), &stdout, sizeQueue)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !gotConfig.Tty {
+		t.Errorf("expected a TTY to be allocated")
+	}
+	if stdout.String() != "out: some input" {
+		t.Errorf("expected stdout %q, got %q", "out: some input", stdout.String())
+	}
+	select {
+	case query := <-resized:
+		if query.Get("w") != "80" || query.Get("h") != "24" {
+			t.Errorf("expected TTY to be resized to 80x24, got %sx%s", query.Get("w"), query.Get("h"))
+		}
+	case <-time.After(5 * time.Second):
+		t.Errorf("expected TTY to be resized")
+	}
+}
+
+func TestPodmanAPIClient_GetPodLogs(t *testing.T) {
+	var gotFollow string
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v4.0.0/libpod/containers/mycmp-app-runtime/logs", func(w http.ResponseWriter, r *http.Request) {
+		gotFollow = r.URL.Query().Get("follow")
+		writeFrame(w, 1, "line 1\n")
+		writeFrame(w, streamStderr, "line 2\n")
+	})
+	client := newTestAPIClient(t, mux)
+
+	logs, err := client.GetPodLogs("mycmp-app", "runtime", true)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	defer logs.Close()
+	content, err := io.ReadAll(logs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if string(content) != "line 1\nline 2\n" {
+		t.Errorf("expected logs %q, got %q", "line 1\nline 2\n", string(content))
+	}
+	if gotFollow != "true" {
+		t.Errorf("expected logs to be followed, got follow=%q", gotFollow)
+	}
+}
@@ -14,12 +14,23 @@ import (
 
 // ListPodsReport contains the result of the `podman pod ps --format json` command
 type ListPodsReport struct {
-	Name   string
-	Labels map[string]string
+	Name       string
+	Labels     map[string]string
+	Status     string
+	InfraId    string
+	Containers []ListPodContainer
+}
+
+// ListPodContainer contains information about a container of a pod, as part of the ListPodsReport
+type ListPodContainer struct {
+	Id     string
+	Names  string
+	Status string
 }
 
 func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
-	cmd := exec.Command(o.podmanCmd, "pod", "ps", "--format", "json", "--filter", "status=running")
+	cmd := exec.Command(o.podmanCmd, "pod", "ps", "--format", "json", "--filter", "status=running",
+		"--filter", "label="+odolabels.SelectorBuilder().WithAnyComponent().Selector())
 	klog.V(3).Infof("executing %v", cmd.Args)
 	out, err := cmd.Output()
 	if err != nil {
@@ -34,6 +45,12 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 		return nil, err
 	}
 
+	return toComponents(list), nil
+}
+
+// toComponents returns the odo components running in the pods of the pod reports.
+// Pods without an instance label are not part of the result, and the pods of a same component are merged.
+func toComponents(list []ListPodsReport) []api.ComponentAbstract {
 	for _, pod := range list {
 		klog.V(5).Infof("\npod name: %s", pod.Name)
 		klog.V(5).Infof("labels:")
@@ -76,12 +93,26 @@ func (o *PodmanCli) ListAllComponents() ([]api.ComponentAbstract, error) {
 			Platform:  commonflags.PlatformPodman,
 		}
 		mode := odolabels.GetMode(labels)
+
+		// A component running in Dev and Deploy modes has a pod for each mode
+		existing := -1
+		for i := range components {
+			if components[i].Name == name {
+				existing = i
+				break
+			}
+		}
+		if existing == -1 {
+			components = append(components, component)
+			existing = len(components) - 1
+		}
 		if mode != "" {
-			component.RunningIn = api.NewRunningModes()
-			component.RunningIn.AddRunningMode(api.RunningMode(strings.ToLower(mode)))
+			if components[existing].RunningIn == nil {
+				components[existing].RunningIn = api.NewRunningModes()
+			}
+			components[existing].RunningIn.AddRunningMode(api.RunningMode(strings.ToLower(mode)))
 		}
-		components = append(components, component)
 	}
 
-	return components, nil
+	return components
 }
@@ -0,0 +1,55 @@
+package podman
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/api"
+)
+
+func Test_toComponents(t *testing.T) {
+	list := []ListPodsReport{
+		{
+			Name: "mycmp-app",
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":   "mycmp",
+				"app.kubernetes.io/managed-by": "odo",
+				"odo.dev/mode":                 "Dev",
+				"odo.dev/project-type":         "nodejs",
+			},
+		},
+		{
+			Name: "mycmp-deploy",
+			Labels: map[string]string{
+				"app.kubernetes.io/instance":   "mycmp",
+				"app.kubernetes.io/managed-by": "odo",
+				"odo.dev/mode":                 "Deploy",
+			},
+		},
+		{
+			Name:   "not-a-component",
+			Labels: map[string]string{},
+		},
+	}
+
+	got := toComponents(list)
+
+	want := []api.ComponentAbstract{
+		{
+			Name:      "mycmp",
+			ManagedBy: "odo",
+			Type:      "nodejs",
+			RunningIn: api.RunningModes{
+				api.RunningModeDev:    true,
+				api.RunningModeDeploy: true,
+			},
+			//lint:ignore SA1019 we need to output the deprecated value, before to remove it in a future release
+			RunningOn: "podman",
+			Platform:  "podman",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("toComponents() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"io"
 	"os/exec"
 
+	"k8s.io/client-go/tools/remotecommand"
 	"k8s.io/klog"
 )
 
@@ -24,11 +25,14 @@ func (o *PodmanCli) ExecCMDInContainer(containerName, podName string, cmd []stri
 	command := exec.Command(o.podmanCmd, args...)
 	klog.V(3).Infof("executing %v", command.Args)
 	command.Stdin = stdin
+	command.Stdout = stdout
+	command.Stderr = stderr
 
-	out, err := command.Output()
-	if err != nil {
-		return err
-	}
-	_, err = stdout.Write(out)
-	return err
+	return command.Run()
+}
+
+// ExecInteractiveCMDInContainer executes the command in the container of a pod, in a TTY attached to stdin and stdout.
+// The podman command being attached to the terminal of odo, it resizes the TTY itself, and sizeQueue is not used.
+func (o *PodmanCli) ExecInteractiveCMDInContainer(containerName, podName string, cmd []string, stdin io.Reader, stdout io.Writer, sizeQueue remotecommand.TerminalSizeQueue) error {
+	return o.ExecCMDInContainer(containerName, podName, cmd, stdout, nil, stdin, true)
 }
@@ -21,6 +21,22 @@ type PodInspectData struct {
 	// Labels is a set of key-value labels that have been applied to the
 	// pod.
 	Labels map[string]string `json:"Labels,omitempty"`
+	// InfraContainerID is the ID of the pod's infra container, if one is
+	// present.
+	InfraContainerID string `json:"InfraContainerID,omitempty"`
+	// Containers gives a brief summary of all containers in the pod and
+	// their current status.
+	Containers []InspectPodContainerInfo `json:"Containers,omitempty"`
+}
+
+// InspectPodContainerInfo contains information on a container in a pod.
+type InspectPodContainerInfo struct {
+	// ID is the ID of the container.
+	ID string `json:"Id"`
+	// Name is the name of the container.
+	Name string
+	// State is the current status of the container.
+	State string
 }
 
 func (o *PodmanCli) PodInspect(podname string) (PodInspectData, error) {
@@ -39,3 +55,36 @@ func (o *PodmanCli) PodInspect(podname string) (PodInspectData, error) {
 	err = json.Unmarshal(out, &result)
 	return result, err
 }
+
+// VolumeInspectData originates from https://github.com/containers/podman/blob/main/libpod/define/volume_inspect.go
+type VolumeInspectData struct {
+	// Name is the name of the volume.
+	Name string `json:"Name"`
+	// Labels includes the volume's configured labels, key:value pairs that
+	// can be passed during volume creation to provide information for third
+	// party tools.
+	Labels map[string]string `json:"Labels"`
+}
+
+func (o *PodmanCli) VolumeInspect(volumeName string) (VolumeInspectData, error) {
+	cmd := exec.Command(o.podmanCmd, "volume", "inspect", volumeName, "--format", "json")
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+		return VolumeInspectData{}, err
+	}
+
+	// the result of podman volume inspect is a list, even if a single volume is requested
+	var result []VolumeInspectData
+	err = json.Unmarshal(out, &result)
+	if err != nil {
+		return VolumeInspectData{}, err
+	}
+	if len(result) == 0 {
+		return VolumeInspectData{}, fmt.Errorf("no volume with name %q found", volumeName)
+	}
+	return result[0], nil
+}
@@ -6,11 +6,17 @@ import (
 	"github.com/redhat-developer/odo/pkg/api"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/client-go/tools/remotecommand"
 )
 
 type Client interface {
-	// PlayKube creates the Pod with Podman
-	PlayKube(pod *corev1.Pod) error
+	// PlayKube creates the Pod with Podman, along with the resources it uses.
+	// Supported kinds of resources are ConfigMap, Secret and PersistentVolumeClaim
+	PlayKube(pod *corev1.Pod, resources []unstructured.Unstructured) error
+
+	// PlayKubeResources creates the Kubernetes resources with Podman, replacing the ones already existing.
+	// Supported kinds are the ones supported by `podman play kube` (Pod, Deployment, Service, ConfigMap, Secret, PersistentVolumeClaim)
+	PlayKubeResources(resources []unstructured.Unstructured) error
 
 	// KubeGenerate returns a Kubernetes Pod definition of an existing Pod
 	KubeGenerate(name string) (*corev1.Pod, error)
@@ -21,20 +27,35 @@ type Client interface {
 	// PodRm deletes the pod with given podname
 	PodRm(podname string) error
 
-	// PodLs lists the names of existing pods
-	PodLs() (map[string]bool, error)
+	// PodLs lists the names of existing pods matching the given label selector.
+	// All pods are listed if the selector is empty.
+	PodLs(selector string) (map[string]bool, error)
+
+	// VolumeLs lists the names of existing volumes matching the given label selector.
+	// All volumes are listed if the selector is empty.
+	VolumeLs(selector string) (map[string]bool, error)
 
-	// VolumeLs lists the names of existing volumes
-	VolumeLs() (map[string]bool, error)
+	// VolumeInspect returns the name and labels of the volume with given volumeName
+	VolumeInspect(volumeName string) (VolumeInspectData, error)
 
 	// VolumeRm deletes the volume with given volumeName
 	VolumeRm(volumeName string) error
 
-	// CleanupResources stops and removes a pod and its associated resources (volumes)
+	// SecretLs lists the names of existing secrets
+	SecretLs() (map[string]bool, error)
+
+	// SecretRm deletes the secret with given secretName
+	SecretRm(secretName string) error
+
+	// CleanupResources stops and removes a pod and its associated resources (volumes and secrets)
 	CleanupPodResources(pod *corev1.Pod) error
 
 	ExecCMDInContainer(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error
 
+	// ExecInteractiveCMDInContainer executes the specified command in the container of a pod, in a TTY attached to stdin and stdout.
+	// The TTY is resized each time a new size is returned by sizeQueue, if not nil.
+	ExecInteractiveCMDInContainer(containerName, podName string, cmd []string, stdin io.Reader, stdout io.Writer, sizeQueue remotecommand.TerminalSizeQueue) error
+
 	// GetPodLogs returns the logs of the specified pod container.
 	// All logs for all containers part of the pod are returned if an empty string is provided as container name.
 	GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error)
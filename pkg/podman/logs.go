@@ -3,6 +3,7 @@ package podman
 import (
 	"io"
 	"os/exec"
+	"sync"
 
 	"k8s.io/klog"
 )
@@ -10,8 +11,10 @@ import (
 // GetPodLogs returns the logs of the specified pod container.
 // All logs for all containers part of the pod are returned if an empty string is provided as container name.
 func (o *PodmanCli) GetPodLogs(podName, containerName string, followLog bool) (io.ReadCloser, error) {
-	// TODO(feloy) implement followLog = true
 	args := []string{"pod", "logs"}
+	if followLog {
+		args = append(args, "--follow")
+	}
 	if containerName != "" {
 		args = append(args, "--container", podName+"-"+containerName)
 	}
@@ -26,5 +29,38 @@ func (o *PodmanCli) GetPodLogs(podName, containerName string, followLog bool) (i
 	if err := cmd.Start(); err != nil {
 		return nil, err
 	}
-	return out, nil
+	return &cmdReadCloser{ReadCloser: out, cmd: cmd}, nil
+}
+
+// cmdReadCloser reads the output of a command, and waits for the command to terminate
+// when the end of the output is reached or when the reader is closed.
+// When following logs, the end of the output is reached when the container is stopped or removed.
+type cmdReadCloser struct {
+	io.ReadCloser
+	cmd  *exec.Cmd
+	once sync.Once
+}
+
+func (o *cmdReadCloser) Read(p []byte) (int, error) {
+	n, err := o.ReadCloser.Read(p)
+	if err == io.EOF {
+		o.wait()
+	}
+	return n, err
+}
+
+func (o *cmdReadCloser) Close() error {
+	if o.cmd.Process != nil {
+		_ = o.cmd.Process.Kill()
+	}
+	o.wait()
+	return nil
+}
+
+func (o *cmdReadCloser) wait() {
+	o.once.Do(func() {
+		if err := o.cmd.Wait(); err != nil {
+			klog.V(4).Infof("command %v terminated: %v", o.cmd.Args, err)
+		}
+	})
 }
@@ -12,6 +12,7 @@ import (
 	api "github.com/redhat-developer/odo/pkg/api"
 	v1 "k8s.io/api/core/v1"
 	unstructured "k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	remotecommand "k8s.io/client-go/tools/remotecommand"
 )
 
 // MockClient is a mock of Client interface.
@@ -65,6 +66,20 @@ func (mr *MockClientMockRecorder) ExecCMDInContainer(containerName, podName, cmd
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecCMDInContainer", reflect.TypeOf((*MockClient)(nil).ExecCMDInContainer), containerName, podName, cmd, stdout, stderr, stdin, tty)
 }
 
+// ExecInteractiveCMDInContainer mocks base method.
+func (m *MockClient) ExecInteractiveCMDInContainer(containerName, podName string, cmd []string, stdin io.Reader, stdout io.Writer, sizeQueue remotecommand.TerminalSizeQueue) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ExecInteractiveCMDInContainer", containerName, podName, cmd, stdin, stdout, sizeQueue)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ExecInteractiveCMDInContainer indicates an expected call of ExecInteractiveCMDInContainer.
+func (mr *MockClientMockRecorder) ExecInteractiveCMDInContainer(containerName, podName, cmd, stdin, stdout, sizeQueue interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ExecInteractiveCMDInContainer", reflect.TypeOf((*MockClient)(nil).ExecInteractiveCMDInContainer), containerName, podName, cmd, stdin, stdout, sizeQueue)
+}
+
 // GetAllPodsInNamespaceMatchingSelector mocks base method.
 func (m *MockClient) GetAllPodsInNamespaceMatchingSelector(selector, ns string) (*v1.PodList, error) {
 	m.ctrl.T.Helper()
@@ -171,32 +186,46 @@ func (mr *MockClientMockRecorder) ListAllComponents() *gomock.Call {
 }
 
 // PlayKube mocks base method.
-func (m *MockClient) PlayKube(pod *v1.Pod) error {
+func (m *MockClient) PlayKube(pod *v1.Pod, resources []unstructured.Unstructured) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PlayKube", pod)
+	ret := m.ctrl.Call(m, "PlayKube", pod, resources)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
 // PlayKube indicates an expected call of PlayKube.
-func (mr *MockClientMockRecorder) PlayKube(pod interface{}) *gomock.Call {
+func (mr *MockClientMockRecorder) PlayKube(pod, resources interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayKube", reflect.TypeOf((*MockClient)(nil).PlayKube), pod)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayKube", reflect.TypeOf((*MockClient)(nil).PlayKube), pod, resources)
+}
+
+// PlayKubeResources mocks base method.
+func (m *MockClient) PlayKubeResources(resources []unstructured.Unstructured) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PlayKubeResources", resources)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// PlayKubeResources indicates an expected call of PlayKubeResources.
+func (mr *MockClientMockRecorder) PlayKubeResources(resources interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PlayKubeResources", reflect.TypeOf((*MockClient)(nil).PlayKubeResources), resources)
 }
 
 // PodLs mocks base method.
-func (m *MockClient) PodLs() (map[string]bool, error) {
+func (m *MockClient) PodLs(selector string) (map[string]bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "PodLs")
+	ret := m.ctrl.Call(m, "PodLs", selector)
 	ret0, _ := ret[0].(map[string]bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // PodLs indicates an expected call of PodLs.
-func (mr *MockClientMockRecorder) PodLs() *gomock.Call {
+func (mr *MockClientMockRecorder) PodLs(selector interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodLs", reflect.TypeOf((*MockClient)(nil).PodLs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodLs", reflect.TypeOf((*MockClient)(nil).PodLs), selector)
 }
 
 // PodRm mocks base method.
@@ -227,6 +256,35 @@ func (mr *MockClientMockRecorder) PodStop(podname interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PodStop", reflect.TypeOf((*MockClient)(nil).PodStop), podname)
 }
 
+// SecretLs mocks base method.
+func (m *MockClient) SecretLs() (map[string]bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretLs")
+	ret0, _ := ret[0].(map[string]bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SecretLs indicates an expected call of SecretLs.
+func (mr *MockClientMockRecorder) SecretLs() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretLs", reflect.TypeOf((*MockClient)(nil).SecretLs))
+}
+
+// SecretRm mocks base method.
+func (m *MockClient) SecretRm(secretName string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SecretRm", secretName)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SecretRm indicates an expected call of SecretRm.
+func (mr *MockClientMockRecorder) SecretRm(secretName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SecretRm", reflect.TypeOf((*MockClient)(nil).SecretRm), secretName)
+}
+
 // Version mocks base method.
 func (m *MockClient) Version() (SystemVersionReport, error) {
 	m.ctrl.T.Helper()
@@ -242,19 +300,34 @@ func (mr *MockClientMockRecorder) Version() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Version", reflect.TypeOf((*MockClient)(nil).Version))
 }
 
+// VolumeInspect mocks base method.
+func (m *MockClient) VolumeInspect(volumeName string) (VolumeInspectData, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "VolumeInspect", volumeName)
+	ret0, _ := ret[0].(VolumeInspectData)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// VolumeInspect indicates an expected call of VolumeInspect.
+func (mr *MockClientMockRecorder) VolumeInspect(volumeName interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeInspect", reflect.TypeOf((*MockClient)(nil).VolumeInspect), volumeName)
+}
+
 // VolumeLs mocks base method.
-func (m *MockClient) VolumeLs() (map[string]bool, error) {
+func (m *MockClient) VolumeLs(selector string) (map[string]bool, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "VolumeLs")
+	ret := m.ctrl.Call(m, "VolumeLs", selector)
 	ret0, _ := ret[0].(map[string]bool)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // VolumeLs indicates an expected call of VolumeLs.
-func (mr *MockClientMockRecorder) VolumeLs() *gomock.Call {
+func (mr *MockClientMockRecorder) VolumeLs(selector interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeLs", reflect.TypeOf((*MockClient)(nil).VolumeLs))
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "VolumeLs", reflect.TypeOf((*MockClient)(nil).VolumeLs), selector)
 }
 
 // VolumeRm mocks base method.
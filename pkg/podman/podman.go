@@ -4,21 +4,56 @@ import (
 	"bufio"
 	"context"
 	"fmt"
+	"io"
 	"os/exec"
 	"strings"
 
+	"github.com/ghodss/yaml"
+
 	envcontext "github.com/redhat-developer/odo/pkg/config/context"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	jsonserializer "k8s.io/apimachinery/pkg/runtime/serializer/json"
+	"k8s.io/apimachinery/pkg/selection"
 	"k8s.io/klog"
 	"k8s.io/kubectl/pkg/scheme"
 )
 
+// SupportedKinds are the kinds of Kubernetes resources that can be created with `podman play kube`
+var SupportedKinds = map[string]bool{
+	"Pod":                   true,
+	"Deployment":            true,
+	"Service":               true,
+	"ConfigMap":             true,
+	"Secret":                true,
+	"PersistentVolumeClaim": true,
+}
+
 type PodmanCli struct {
 	podmanCmd string
 }
 
+// NewPodmanClient returns a new podman client.
+// If ODO_PODMAN_USE_API is enabled, the client communicates with the Podman service through the libpod API exposed on its local socket.
+// The client executing the podman command is returned otherwise, or if the API is not accessible.
+func NewPodmanClient(ctx context.Context) (Client, error) {
+	envConfig := envcontext.GetEnvConfig(ctx)
+	if envConfig.OdoPodmanUseAPI {
+		apiClient, err := NewPodmanAPIClient(getSocketPath(envConfig))
+		if err == nil {
+			return apiClient, nil
+		}
+		klog.V(2).Infof("unable to use the podman API, falling back to the podman command: %v", err)
+	}
+	cli, err := NewPodmanCli(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return cli, nil
+}
+
 // NewPodmanCli returns a new podman client, or nil if the podman command is not accessible in the system
 func NewPodmanCli(ctx context.Context) (*PodmanCli, error) {
 	// Check if podman is available in the system
@@ -36,7 +71,20 @@ func NewPodmanCli(ctx context.Context) (*PodmanCli, error) {
 	return cli, nil
 }
 
-func (o *PodmanCli) PlayKube(pod *corev1.Pod) error {
+func (o *PodmanCli) PlayKube(pod *corev1.Pod, resources []unstructured.Unstructured) error {
+	if klog.V(4) {
+		var sb strings.Builder
+		_ = encodePodWithResources(pod, resources, &sb)
+		klog.Infof("Pod spec to play: \n%s---\n", sb.String())
+	}
+
+	return o.playKube(nil, func(w io.Writer) error {
+		return encodePodWithResources(pod, resources, w)
+	})
+}
+
+// encodePodWithResources writes the YAML definitions of the resources, followed by the definition of the pod
+func encodePodWithResources(pod *corev1.Pod, resources []unstructured.Unstructured, w io.Writer) error {
 	serializer := jsonserializer.NewSerializerWithOptions(
 		jsonserializer.SimpleMetaFactory{},
 		scheme.Scheme,
@@ -46,7 +94,45 @@ func (o *PodmanCli) PlayKube(pod *corev1.Pod) error {
 		},
 	)
 
-	cmd := exec.Command(o.podmanCmd, "play", "kube", "-")
+	for _, resource := range resources {
+		content, err := yaml.Marshal(resource.Object)
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, "---\n"+string(content))
+		if err != nil {
+			return err
+		}
+	}
+	_, err := io.WriteString(w, "---\n")
+	if err != nil {
+		return err
+	}
+	return serializer.Encode(pod, w)
+}
+
+func (o *PodmanCli) PlayKubeResources(resources []unstructured.Unstructured) error {
+	var sb strings.Builder
+	for _, resource := range resources {
+		content, err := yaml.Marshal(resource.Object)
+		if err != nil {
+			return err
+		}
+		sb.WriteString("---\n")
+		sb.Write(content)
+	}
+
+	klog.V(4).Infof("Resources to play: \n%s---\n", sb.String())
+
+	return o.playKube([]string{"--replace"}, func(w io.Writer) error {
+		_, err := io.WriteString(w, sb.String())
+		return err
+	})
+}
+
+// playKube executes `podman play kube` with the additional args, with the YAML definition written by encode as input
+func (o *PodmanCli) playKube(args []string, encode func(w io.Writer) error) error {
+	cmd := exec.Command(o.podmanCmd, append(append([]string{"play", "kube"}, args...), "-")...)
 	klog.V(3).Infof("executing %v", cmd.Args)
 	stdin, err := cmd.StdinPipe()
 	if err != nil {
@@ -63,13 +149,7 @@ func (o *PodmanCli) PlayKube(pod *corev1.Pod) error {
 		return err
 	}
 
-	if klog.V(4) {
-		var sb strings.Builder
-		_ = serializer.Encode(pod, &sb)
-		klog.Infof("Pod spec to play: \n---\n%s\n---\n", sb.String())
-	}
-
-	err = serializer.Encode(pod, stdin)
+	err = encode(stdin)
 	if err != nil {
 		return err
 	}
@@ -151,8 +231,16 @@ func (o *PodmanCli) PodRm(podname string) error {
 	return nil
 }
 
-func (o *PodmanCli) PodLs() (map[string]bool, error) {
-	cmd := exec.Command(o.podmanCmd, "pod", "list", "--format", "{{.Name}}", "--noheading")
+func (o *PodmanCli) PodLs(selector string) (map[string]bool, error) {
+	args := []string{"pod", "list", "--format", "{{.Name}}", "--noheading"}
+	filters, err := LabelFilters(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range filters {
+		args = append(args, "--filter=label="+label)
+	}
+	cmd := exec.Command(o.podmanCmd, args...)
 	klog.V(3).Infof("executing %v", cmd.Args)
 	out, err := cmd.Output()
 	if err != nil {
@@ -178,8 +266,16 @@ func (o *PodmanCli) VolumeRm(volumeName string) error {
 	return nil
 }
 
-func (o *PodmanCli) VolumeLs() (map[string]bool, error) {
-	cmd := exec.Command(o.podmanCmd, "volume", "ls", "--format", "{{.Name}}", "--noheading")
+func (o *PodmanCli) VolumeLs(selector string) (map[string]bool, error) {
+	args := []string{"volume", "ls", "--format", "{{.Name}}", "--noheading"}
+	filters, err := LabelFilters(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range filters {
+		args = append(args, "--filter=label="+label)
+	}
+	cmd := exec.Command(o.podmanCmd, args...)
 	klog.V(3).Infof("executing %v", cmd.Args)
 	out, err := cmd.Output()
 	if err != nil {
@@ -191,12 +287,50 @@ func (o *PodmanCli) VolumeLs() (map[string]bool, error) {
 	return SplitLinesAsSet(string(out)), nil
 }
 
+func (o *PodmanCli) SecretLs() (map[string]bool, error) {
+	cmd := exec.Command(o.podmanCmd, "secret", "ls", "--format", "{{.Name}}", "--noheading")
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+		return nil, err
+	}
+	return SplitLinesAsSet(string(out)), nil
+}
+
+func (o *PodmanCli) SecretRm(secretName string) error {
+	cmd := exec.Command(o.podmanCmd, "secret", "rm", secretName)
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+		return err
+	}
+	klog.V(4).Infof("Deleted secret %s", string(out))
+	return nil
+}
+
 func (o *PodmanCli) CleanupPodResources(pod *corev1.Pod) error {
-	err := o.PodStop(pod.GetName())
+	return cleanupPodResources(o, pod)
+}
+
+// cleanupPodResources stops and removes the pod, its volumes and the resources created for its ConfigMap and Secret volumes,
+// using the client
+func cleanupPodResources(client Client, pod *corev1.Pod) error {
+	err := client.PodStop(pod.GetName())
 	if err != nil {
 		return err
 	}
-	err = o.PodRm(pod.GetName())
+	err = client.PodRm(pod.GetName())
+	if err != nil {
+		return err
+	}
+
+	err = RemoveConfigResources(client, pod)
 	if err != nil {
 		return err
 	}
@@ -207,7 +341,7 @@ func (o *PodmanCli) CleanupPodResources(pod *corev1.Pod) error {
 		}
 		volumeName := volume.PersistentVolumeClaim.ClaimName
 		klog.V(3).Infof("deleting podman volume %q", volumeName)
-		err = o.VolumeRm(volumeName)
+		err = client.VolumeRm(volumeName)
 		if err != nil {
 			return err
 		}
@@ -215,6 +349,85 @@ func (o *PodmanCli) CleanupPodResources(pod *corev1.Pod) error {
 	return nil
 }
 
+// RemoveConfigResources removes the volumes created by Podman for the ConfigMap and Secret volumes of the pod,
+// and the secrets referenced by the pod. The pod must not be running.
+func RemoveConfigResources(client Client, pod *corev1.Pod) error {
+	var (
+		volumes []string
+		secrets []string
+	)
+	for _, volume := range pod.Spec.Volumes {
+		switch {
+		case volume.ConfigMap != nil:
+			volumes = append(volumes, volume.ConfigMap.Name)
+		case volume.Secret != nil:
+			volumes = append(volumes, volume.Secret.SecretName)
+			secrets = append(secrets, volume.Secret.SecretName)
+		}
+	}
+	if len(volumes) == 0 {
+		return nil
+	}
+
+	existingVolumes, err := client.VolumeLs("")
+	if err != nil {
+		return err
+	}
+	for _, volumeName := range volumes {
+		if !existingVolumes[volumeName] {
+			continue
+		}
+		klog.V(3).Infof("deleting podman volume %q", volumeName)
+		err = client.VolumeRm(volumeName)
+		if err != nil {
+			return err
+		}
+	}
+	if len(secrets) == 0 {
+		return nil
+	}
+	existingSecrets, err := client.SecretLs()
+	if err != nil {
+		return err
+	}
+	for _, secretName := range secrets {
+		if !existingSecrets[secretName] {
+			continue
+		}
+		klog.V(3).Infof("deleting podman secret %q", secretName)
+		err = client.SecretRm(secretName)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// LabelFilters returns the requirements of the label selector, as used by the label filters of Podman and Docker.
+// It returns an error for requirements other than equality and existence, which are not supported by the label filters.
+func LabelFilters(selector string) ([]string, error) {
+	if selector == "" {
+		return nil, nil
+	}
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return nil, err
+	}
+	requirements, _ := parsed.Requirements()
+	result := make([]string, 0, len(requirements))
+	for _, requirement := range requirements {
+		switch requirement.Operator() {
+		case selection.Equals, selection.DoubleEquals:
+			result = append(result, requirement.Key()+"="+requirement.Values().List()[0])
+		case selection.Exists:
+			result = append(result, requirement.Key())
+		default:
+			return nil, fmt.Errorf("unsupported requirement %q in label selector %q: only equality and existence requirements are supported", requirement.String(), selector)
+		}
+	}
+	return result, nil
+}
+
 func SplitLinesAsSet(s string) map[string]bool {
 	lines := map[string]bool{}
 	sc := bufio.NewScanner(strings.NewReader(s))
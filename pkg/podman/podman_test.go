@@ -0,0 +1,78 @@
+package podman
+
+import (
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestRemoveConfigResources(t *testing.T) {
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{
+			Volumes: []corev1.Volume{
+				{Name: "config", VolumeSource: corev1.VolumeSource{ConfigMap: &corev1.ConfigMapVolumeSource{LocalObjectReference: corev1.LocalObjectReference{Name: "my-config"}}}},
+				{Name: "secret", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "my-secret"}}},
+				{Name: "removed", VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "removed-secret"}}},
+				{Name: "storage", VolumeSource: corev1.VolumeSource{PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "my-storage"}}},
+			},
+		},
+	}
+	ctrl := gomock.NewController(t)
+	client := NewMockClient(ctrl)
+	client.EXPECT().VolumeLs("").Return(map[string]bool{"my-config": true, "my-secret": true, "my-storage": true}, nil)
+	client.EXPECT().VolumeRm("my-config").Return(nil)
+	client.EXPECT().VolumeRm("my-secret").Return(nil)
+	// the secrets which do not exist are not removed
+	client.EXPECT().SecretLs().Return(map[string]bool{"my-secret": true, "other-secret": true}, nil)
+	client.EXPECT().SecretRm("my-secret").Return(nil)
+
+	if err := RemoveConfigResources(client, pod); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestLabelFilters(t *testing.T) {
+	tests := []struct {
+		name     string
+		selector string
+		want     []string
+		wantErr  bool
+	}{
+		{
+			name: "empty selector",
+		},
+		{
+			name:     "equality and existence requirements",
+			selector: "odo.dev/mode=Dev,app.kubernetes.io/instance==mycmp,odo.dev/managed",
+			want:     []string{"app.kubernetes.io/instance=mycmp", "odo.dev/managed", "odo.dev/mode=Dev"},
+		},
+		{
+			name:     "set-based requirement",
+			selector: "app.kubernetes.io/instance=mycmp,odo.dev/mode in (Dev,Deploy)",
+			wantErr:  true,
+		},
+		{
+			name:     "inequality requirement",
+			selector: "odo.dev/mode!=Dev",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid selector",
+			selector: "odo.dev/mode in (Dev",
+			wantErr:  true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := LabelFilters(tt.selector)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("LabelFilters() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("LabelFilters() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -13,9 +13,13 @@ import (
 )
 
 // GetPodsMatchingSelector returns all pods matching the given label selector.
+// Only the names of the containers are set in the specs of the returned pods.
 func (o *PodmanCli) GetPodsMatchingSelector(selector string) (*corev1.PodList, error) {
-	// TODO(feloy) when pod is created with labels
-	return nil, nil
+	list, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	return toPodList(list), nil
 }
 
 // GetAllResourcesFromSelector returns all resources of any kind matching the given label selector.
@@ -24,6 +28,61 @@ func (o *PodmanCli) GetAllResourcesFromSelector(selector string, _ string) ([]un
 	if err != nil {
 		return nil, err
 	}
+	return toUnstructuredList(list), nil
+}
+
+// GetAllPodsInNamespaceMatchingSelector returns all pods matching the given label selector and in the specified namespace.
+// As there is no namespace on Podman, the namespace is ignored.
+func (o *PodmanCli) GetAllPodsInNamespaceMatchingSelector(selector string, _ string) (*corev1.PodList, error) {
+	return o.GetPodsMatchingSelector(selector)
+}
+
+// GetRunningPodFromSelector returns any pod matching the given label selector.
+// If multiple pods are found, implementations might have different behavior, by either returning an error or returning any element.
+func (o *PodmanCli) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
+	list, err := o.getPodsFromSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	return getRunningPod(selector, list, o.PodInspect)
+}
+
+func (o *PodmanCli) getPodsFromSelector(selector string) ([]ListPodsReport, error) {
+	args := []string{"pod", "ps", "--format", "json"}
+	filters, err := LabelFilters(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, label := range filters {
+		args = append(args, "--filter=label="+label)
+	}
+	cmd := exec.Command(o.podmanCmd, args...)
+	klog.V(3).Infof("executing %v", cmd.Args)
+	out, err := cmd.Output()
+	if err != nil {
+		if exiterr, ok := err.(*exec.ExitError); ok {
+			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+		}
+		return nil, err
+	}
+	var list []ListPodsReport
+	if err = json.Unmarshal(out, &list); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// toPodList returns the pods of the pod reports, as returned by toPod
+func toPodList(list []ListPodsReport) *corev1.PodList {
+	result := &corev1.PodList{}
+	for _, podReport := range list {
+		result.Items = append(result.Items, podReport.toPod())
+	}
+	return result
+}
+
+// toUnstructuredList returns the pods of the pod reports as unstructured resources, with only their names and labels set
+func toUnstructuredList(list []ListPodsReport) []unstructured.Unstructured {
 	for _, pod := range list {
 		klog.V(5).Infof("\npod name: %s", pod.Name)
 		klog.V(5).Infof("labels:")
@@ -39,23 +98,12 @@ func (o *PodmanCli) GetAllResourcesFromSelector(selector string, _ string) ([]un
 		u.SetLabels(pod.Labels)
 		result = append(result, u)
 	}
-
-	return result, nil
+	return result
 }
 
-// GetAllPodsInNamespaceMatchingSelector returns all pods matching the given label selector and in the specified namespace.
-func (o *PodmanCli) GetAllPodsInNamespaceMatchingSelector(selector string, ns string) (*corev1.PodList, error) {
-	// TODO(feloy) when pod is created with labels
-	return nil, nil
-}
-
-// GetRunningPodFromSelector returns any pod matching the given label selector.
-// If multiple pods are found, implementations might have different behavior, by either returning an error or returning any element.
-func (o *PodmanCli) GetRunningPodFromSelector(selector string) (*corev1.Pod, error) {
-	list, err := o.getPodsFromSelector(selector)
-	if err != nil {
-		return nil, err
-	}
+// getRunningPod returns the only pod of the list of pods matching selector, with its phase
+// set from the state returned by inspect
+func getRunningPod(selector string, list []ListPodsReport, inspect func(podname string) (PodInspectData, error)) (*corev1.Pod, error) {
 	numPods := len(list)
 	if numPods == 0 {
 		return nil, &platform.PodNotFoundError{Selector: selector}
@@ -68,34 +116,32 @@ func (o *PodmanCli) GetRunningPodFromSelector(selector string) (*corev1.Pod, err
 	pod.SetName(podReport.Name)
 	pod.SetLabels(podReport.Labels)
 
-	inspect, err := o.PodInspect(podReport.Name)
+	inspectData, err := inspect(podReport.Name)
 	if err != nil {
 		return nil, err
 	}
-	if inspect.State == "Running" {
+	if inspectData.State == "Running" {
 		pod.Status.Phase = corev1.PodRunning
 	}
 	return &pod, nil
 }
 
-func (o *PodmanCli) getPodsFromSelector(selector string) ([]ListPodsReport, error) {
-	args := []string{"pod", "ps", "--format", "json"}
-	selectorAsList := strings.Split(selector, ",")
-	for _, s := range selectorAsList {
-		args = append(args, "--filter=label="+s)
+// toPod returns a Pod with the name, labels, phase and container names of the pod report.
+// The infra container of the pod is not part of the returned containers.
+func (o ListPodsReport) toPod() corev1.Pod {
+	var pod corev1.Pod
+	pod.SetName(o.Name)
+	pod.SetLabels(o.Labels)
+	if o.Status == "Running" {
+		pod.Status.Phase = corev1.PodRunning
 	}
-	cmd := exec.Command(o.podmanCmd, args...)
-	klog.V(3).Infof("executing %v", cmd.Args)
-	out, err := cmd.Output()
-	if err != nil {
-		if exiterr, ok := err.(*exec.ExitError); ok {
-			err = fmt.Errorf("%s: %s", err, string(exiterr.Stderr))
+	for _, container := range o.Containers {
+		if container.Id == o.InfraId {
+			continue
 		}
-		return nil, err
-	}
-	var list []ListPodsReport
-	if err = json.Unmarshal(out, &list); err != nil {
-		return nil, err
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{
+			Name: strings.TrimPrefix(container.Names, o.Name+"-"),
+		})
 	}
-	return list, nil
+	return pod
 }
@@ -0,0 +1,390 @@
+package portForward
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/v2/pkg/devfile/parser/data/v2/common"
+	corev1 "k8s.io/api/core/v1"
+	k8slabels "k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/api"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/watch"
+)
+
+// componentPod is a pod of the component whose container ports are forwarded.
+// When the pod is restarted, the port forwarding is restarted on a running pod of the component
+// in the same mode, having the same containers and ports.
+type componentPod struct {
+	mode string
+	// endpoints are the endpoints of the container components of the Devfile, by container name, for a pod in Dev mode
+	endpoints map[string][]v1alpha2.Endpoint
+	// signature identifies the containers and ports of the pod, to find the pod replacing it after a restart
+	signature string
+	// ceMapping contains the ports of the containers of the pod, by container name
+	ceMapping map[string][]v1alpha2.Endpoint
+	// portPairs are the ports to forward, in the form <local-port>:<container-port>
+	portPairs []string
+	pod       *corev1.Pod
+	stopChan  chan struct{}
+}
+
+// forwardedPorts are the ports forwarded for the componentPod at index
+type forwardedPorts struct {
+	index int
+	ports []api.ForwardedPort
+}
+
+func (o *PFClient) ForwardComponentPorts(
+	ctx context.Context,
+	devfileObj *parser.DevfileObj,
+	componentName string,
+	appName string,
+	mode string,
+	out io.Writer,
+	errOut io.Writer,
+) error {
+	var (
+		cPods        []*componentPod
+		devEndpoints map[string][]v1alpha2.Endpoint
+	)
+	if mode == odolabels.ComponentDevMode || mode == odolabels.ComponentAnyMode {
+		if devfileObj == nil {
+			if mode == odolabels.ComponentDevMode {
+				return fmt.Errorf("the Devfile of the component %q is needed to forward its ports in Dev mode", componentName)
+			}
+			fmt.Fprintf(errOut, "The Devfile of the component %q is not available, the ports of the component running in Dev mode are not forwarded\n", componentName)
+		} else {
+			var err error
+			devEndpoints, err = getDevEndpoints(*devfileObj)
+			if err != nil {
+				return err
+			}
+			pods, err := o.getComponentPods(componentName, appName, odolabels.ComponentDevMode, devEndpoints)
+			if err != nil {
+				return err
+			}
+			cPods = append(cPods, pods...)
+		}
+	}
+	if mode == odolabels.ComponentDeployMode || mode == odolabels.ComponentAnyMode {
+		pods, err := o.getComponentPods(componentName, appName, odolabels.ComponentDeployMode, nil)
+		if err != nil {
+			return err
+		}
+		cPods = append(cPods, pods...)
+	}
+	if len(cPods) == 0 {
+		return fmt.Errorf("no pod running for the component %q", componentName)
+	}
+
+	err := assignLocalPorts(cPods)
+	if err != nil {
+		return err
+	}
+
+	originalErrorHandlers := append([]func(error){}, runtime.ErrorHandlers...)
+	runtime.ErrorHandlers = append(runtime.ErrorHandlers, func(err error) {
+		if err.Error() == "lost connection to pod" {
+			// The error does not indicate the pod, stop the low-level port forwarding of all the pods;
+			// the loops will restart it on the pods still running, and on the pods replacing the others
+			stopAll(cPods)
+		}
+	})
+	defer func() {
+		runtime.ErrorHandlers = originalErrorHandlers
+	}()
+
+	if log.IsJSON() {
+		// The forwarded ports are reported with a machine-readable event
+		out = io.Discard
+	}
+
+	portsChan := make(chan forwardedPorts)
+	var wg sync.WaitGroup
+	for i := range cPods {
+		wg.Add(1)
+		go func(index int) {
+			defer wg.Done()
+			o.forwardPodPorts(ctx, componentName, appName, index, cPods[index], out, errOut, portsChan)
+		}(i)
+	}
+
+	allPorts := make([][]api.ForwardedPort, len(cPods))
+	for {
+		select {
+		case <-ctx.Done():
+			stopAll(cPods)
+			wg.Wait()
+			return nil
+		case fwPorts := <-portsChan:
+			allPorts[fwPorts.index] = fwPorts.ports
+			var ports []api.ForwardedPort
+			for _, p := range allPorts {
+				ports = append(ports, p...)
+			}
+			err = o.stateClient.SetForwardedPorts(ports)
+			if err != nil {
+				fmt.Fprintf(errOut, "unable to save forwarded ports to state file: %v\n", err)
+				continue
+			}
+			machineoutput.NewMachineEventLoggingClient().PortsForwarded(ports, machineoutput.TimestampNow())
+		}
+	}
+}
+
+// forwardPodPorts forwards the ports of the componentPod until ctx is done,
+// and restarts the port forwarding on the pod replacing it when the pod is restarted.
+// The ports are sent to portsChan each time they are forwarded.
+func (o *PFClient) forwardPodPorts(
+	ctx context.Context,
+	componentName string,
+	appName string,
+	index int,
+	cPod *componentPod,
+	out io.Writer,
+	errOut io.Writer,
+	portsChan chan<- forwardedPorts,
+) {
+	backo := watch.NewExpBackoff()
+	wait := func() {
+		select {
+		case <-ctx.Done():
+		case <-time.After(backo.Delay()):
+		}
+	}
+	for {
+		// Ignore a stop request received while the port forwarding was not running
+		select {
+		case <-cPod.stopChan:
+		default:
+		}
+		if ctx.Err() != nil {
+			return
+		}
+
+		if cPod.pod == nil {
+			pod, err := o.findComponentPod(componentName, appName, cPod)
+			if err != nil {
+				klog.V(4).Infof("unable to find a pod to forward ports %v in %s mode: %v", cPod.portPairs, cPod.mode, err)
+				wait()
+				continue
+			}
+			cPod.pod = pod
+		}
+
+		portsBuf := NewPortWriter(out, len(cPod.portPairs), cPod.ceMapping, "")
+		// setupDone is closed when the port forwarding returns, before the ports are forwarded if it fails
+		setupDone := make(chan struct{})
+		go func() {
+			if !portsBuf.WaitUntil(setupDone) {
+				return
+			}
+			select {
+			case portsChan <- forwardedPorts{index: index, ports: portsBuf.GetForwardedPorts()}:
+			case <-ctx.Done():
+			}
+		}()
+
+		err := o.kubernetesClient.SetupPortForwarding(cPod.pod, "", cPod.portPairs, portsBuf, errOut, cPod.stopChan)
+		close(setupDone)
+		if err != nil {
+			fmt.Fprintf(errOut, "Failed to setup port-forwarding: %v\n", err)
+			wait()
+		} else {
+			backo.Reset()
+		}
+		// The pod may have been restarted, look for it again
+		cPod.pod = nil
+	}
+}
+
+// getComponentPods returns the running pods of the component in the mode, discovered with the odo labels
+// of the pods, of the resources owning the pods, and of the services selecting the pods.
+// A single pod is returned for the pods having the same containers and ports (replicas of a Deployment for example).
+// For the Dev mode, endpoints are the endpoints of the container components of the Devfile, by container name.
+func (o *PFClient) getComponentPods(componentName string, appName string, mode string, endpoints map[string][]v1alpha2.Endpoint) ([]*componentPod, error) {
+	selector := odolabels.GetSelector(componentName, appName, mode, false)
+
+	podList, err := o.kubernetesClient.GetPodsMatchingSelector(selector)
+	if err != nil {
+		return nil, err
+	}
+	pods := podList.Items
+
+	ownedPods, err := o.kubernetesClient.GetAllPodsInNamespaceMatchingSelector(selector, o.kubernetesClient.GetCurrentNamespace())
+	if err != nil {
+		return nil, err
+	}
+	pods = append(pods, ownedPods.Items...)
+
+	services, err := o.kubernetesClient.ListServices(selector)
+	if err != nil {
+		return nil, err
+	}
+	for _, svc := range services {
+		if len(svc.Spec.Selector) == 0 {
+			continue
+		}
+		podList, err = o.kubernetesClient.GetPodsMatchingSelector(k8slabels.SelectorFromSet(svc.Spec.Selector).String())
+		if err != nil {
+			return nil, err
+		}
+		pods = append(pods, podList.Items...)
+	}
+
+	sort.SliceStable(pods, func(i, j int) bool {
+		return pods[i].Name < pods[j].Name
+	})
+
+	var result []*componentPod
+	signatures := map[string]struct{}{}
+	for i := range pods {
+		pod := &pods[i]
+		if pod.Status.Phase != corev1.PodRunning || pod.DeletionTimestamp != nil {
+			continue
+		}
+		cPod := newComponentPod(mode, pod, endpoints)
+		if len(cPod.ceMapping) == 0 {
+			continue
+		}
+		if _, found := signatures[cPod.signature]; found {
+			continue
+		}
+		signatures[cPod.signature] = struct{}{}
+		result = append(result, cPod)
+	}
+	return result, nil
+}
+
+// findComponentPod returns a running pod of the component, in the mode of cPod and having the same containers and ports
+func (o *PFClient) findComponentPod(componentName string, appName string, cPod *componentPod) (*corev1.Pod, error) {
+	cPods, err := o.getComponentPods(componentName, appName, cPod.mode, cPod.endpoints)
+	if err != nil {
+		return nil, err
+	}
+	for _, p := range cPods {
+		if p.signature == cPod.signature {
+			return p.pod, nil
+		}
+	}
+	return nil, errors.New("no pod running")
+}
+
+// newComponentPod returns a componentPod for the TCP ports declared by the containers of the pod.
+// For a pod running in Dev mode, only the ports declared as endpoints in the Devfile are forwarded.
+func newComponentPod(mode string, pod *corev1.Pod, endpoints map[string][]v1alpha2.Endpoint) *componentPod {
+	ceMapping := map[string][]v1alpha2.Endpoint{}
+	var signature []string
+	for _, container := range pod.Spec.Containers {
+		for _, port := range container.Ports {
+			if port.Protocol != "" && port.Protocol != corev1.ProtocolTCP {
+				continue
+			}
+			endpoint := v1alpha2.Endpoint{
+				Name:       port.Name,
+				TargetPort: int(port.ContainerPort),
+			}
+			if mode == odolabels.ComponentDevMode {
+				var found bool
+				endpoint, found = getEndpoint(endpoints[container.Name], int(port.ContainerPort))
+				if !found {
+					continue
+				}
+			}
+			ceMapping[container.Name] = append(ceMapping[container.Name], endpoint)
+			signature = append(signature, fmt.Sprintf("%s:%d", container.Name, port.ContainerPort))
+		}
+	}
+	sort.Strings(signature)
+	return &componentPod{
+		mode:      mode,
+		endpoints: endpoints,
+		signature: strings.Join(signature, ","),
+		ceMapping: ceMapping,
+		pod:       pod,
+		stopChan:  make(chan struct{}, 1),
+	}
+}
+
+// getDevEndpoints returns the endpoints of the container components of the Devfile, by container name,
+// except the endpoints with the exposure none
+func getDevEndpoints(devfileObj parser.DevfileObj) (map[string][]v1alpha2.Endpoint, error) {
+	containers, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: v1alpha2.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+	endpoints := map[string][]v1alpha2.Endpoint{}
+	for _, container := range containers {
+		if container.Container == nil {
+			continue
+		}
+		for _, ep := range container.Container.Endpoints {
+			if ep.Exposure == v1alpha2.NoneEndpointExposure {
+				continue
+			}
+			endpoints[container.Name] = append(endpoints[container.Name], ep)
+		}
+	}
+	return endpoints, nil
+}
+
+// getEndpoint returns the endpoint with the target port
+func getEndpoint(endpoints []v1alpha2.Endpoint, targetPort int) (v1alpha2.Endpoint, bool) {
+	for _, ep := range endpoints {
+		if ep.TargetPort == targetPort {
+			return ep, true
+		}
+	}
+	return v1alpha2.Endpoint{}, false
+}
+
+// assignLocalPorts assigns the next free ports on localhost starting at 20001 to the container ports of the pods
+func assignLocalPorts(cPods []*componentPod) error {
+	startPort := 20001
+	endPort := startPort + 10000
+	for _, cPod := range cPods {
+		containerNames := make([]string, 0, len(cPod.ceMapping))
+		for name := range cPod.ceMapping {
+			containerNames = append(containerNames, name)
+		}
+		sort.Strings(containerNames)
+		for _, name := range containerNames {
+			for _, ep := range cPod.ceMapping[name] {
+				freePort, err := nextFreePort(startPort, endPort, "", nil)
+				if err != nil {
+					return err
+				}
+				cPod.portPairs = append(cPod.portPairs, fmt.Sprintf("%d:%d", freePort, ep.TargetPort))
+				startPort = freePort + 1
+			}
+		}
+	}
+	return nil
+}
+
+// stopAll asks the low-level port forwarding of the pods to stop, without waiting for them to be stopped
+func stopAll(cPods []*componentPod) {
+	for _, cPod := range cPods {
+		select {
+		case cPod.stopChan <- struct{}{}:
+		default:
+			// a stop request is already pending
+		}
+	}
+}
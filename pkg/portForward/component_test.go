@@ -0,0 +1,140 @@
+package portForward
+
+import (
+	"testing"
+
+	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/v2/pkg/devfile/parser"
+	"github.com/devfile/library/v2/pkg/devfile/parser/data"
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	odolabels "github.com/redhat-developer/odo/pkg/labels"
+)
+
+func newPod(name string, phase corev1.PodPhase, ports map[string][]corev1.ContainerPort) corev1.Pod {
+	pod := corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status:     corev1.PodStatus{Phase: phase},
+	}
+	for container, containerPorts := range ports {
+		pod.Spec.Containers = append(pod.Spec.Containers, corev1.Container{
+			Name:  container,
+			Ports: containerPorts,
+		})
+	}
+	return pod
+}
+
+func TestPFClient_getComponentPods(t *testing.T) {
+	selector := odolabels.GetSelector("my-component", "app", odolabels.ComponentDeployMode, false)
+	webPorts := map[string][]corev1.ContainerPort{
+		"web": {
+			{Name: "http", ContainerPort: 8080},
+			{Name: "dns", ContainerPort: 53, Protocol: corev1.ProtocolUDP},
+		},
+	}
+	dbPorts := map[string][]corev1.ContainerPort{
+		"db": {{ContainerPort: 5432, Protocol: corev1.ProtocolTCP}},
+	}
+
+	terminating := newPod("web-3", corev1.PodRunning, webPorts)
+	terminating.DeletionTimestamp = &metav1.Time{}
+
+	ctrl := gomock.NewController(t)
+	kubeClient := kclient.NewMockClientInterface(ctrl)
+	kubeClient.EXPECT().GetCurrentNamespace().Return("ns").AnyTimes()
+	kubeClient.EXPECT().GetPodsMatchingSelector(selector).Return(&corev1.PodList{Items: []corev1.Pod{
+		newPod("sidecar", corev1.PodRunning, nil),
+	}}, nil)
+	// replicas of a Deployment owned by a labeled resource
+	kubeClient.EXPECT().GetAllPodsInNamespaceMatchingSelector(selector, "ns").Return(&corev1.PodList{Items: []corev1.Pod{
+		newPod("web-2", corev1.PodRunning, webPorts),
+		newPod("web-1", corev1.PodRunning, webPorts),
+		newPod("web-0", corev1.PodPending, webPorts),
+		terminating,
+	}}, nil)
+	kubeClient.EXPECT().ListServices(selector).Return([]corev1.Service{
+		{Spec: corev1.ServiceSpec{Selector: map[string]string{"app": "db"}}},
+		{Spec: corev1.ServiceSpec{ExternalName: "example.com"}},
+	}, nil)
+	kubeClient.EXPECT().GetPodsMatchingSelector("app=db").Return(&corev1.PodList{Items: []corev1.Pod{
+		newPod("db-0", corev1.PodRunning, dbPorts),
+	}}, nil)
+
+	o := NewPFClient(kubeClient, nil)
+	got, err := o.getComponentPods("my-component", "app", odolabels.ComponentDeployMode, nil)
+	if err != nil {
+		t.Fatalf("getComponentPods() error = %v", err)
+	}
+
+	var gotNames, gotSignatures []string
+	for _, cPod := range got {
+		gotNames = append(gotNames, cPod.pod.Name)
+		gotSignatures = append(gotSignatures, cPod.signature)
+	}
+	if diff := cmp.Diff([]string{"db-0", "web-1"}, gotNames); diff != "" {
+		t.Errorf("getComponentPods() pods mismatch (-want +got):\n%s", diff)
+	}
+	if diff := cmp.Diff([]string{"db:5432", "web:8080"}, gotSignatures); diff != "" {
+		t.Errorf("getComponentPods() signatures mismatch (-want +got):\n%s", diff)
+	}
+
+	err = assignLocalPorts(got)
+	if err != nil {
+		t.Fatalf("assignLocalPorts() error = %v", err)
+	}
+	for _, cPod := range got {
+		if len(cPod.portPairs) != 1 {
+			t.Errorf("expected a single port to be forwarded for pod %q, got %v", cPod.pod.Name, cPod.portPairs)
+		}
+	}
+}
+
+func Test_newComponentPod_devMode(t *testing.T) {
+	pod := newPod("dev", corev1.PodRunning, map[string][]corev1.ContainerPort{
+		"runtime": {
+			{Name: "http", ContainerPort: 3000},
+			{Name: "internal", ContainerPort: 4000},
+			{Name: "undeclared", ContainerPort: 5000},
+		},
+	})
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{
+		{
+			Name: "runtime",
+			ComponentUnion: v1alpha2.ComponentUnion{
+				Container: &v1alpha2.ContainerComponent{
+					Endpoints: []v1alpha2.Endpoint{
+						{Name: "http", TargetPort: 3000, Exposure: v1alpha2.PublicEndpointExposure},
+						{Name: "internal", TargetPort: 4000, Exposure: v1alpha2.NoneEndpointExposure},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	endpoints, err := getDevEndpoints(parser.DevfileObj{Data: devfileData})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cPod := newComponentPod(odolabels.ComponentDevMode, &pod, endpoints)
+	want := map[string][]v1alpha2.Endpoint{
+		"runtime": {{Name: "http", TargetPort: 3000, Exposure: v1alpha2.PublicEndpointExposure}},
+	}
+	if diff := cmp.Diff(want, cPod.ceMapping); diff != "" {
+		t.Errorf("newComponentPod() ceMapping mismatch (-want +got):\n%s", diff)
+	}
+	if cPod.signature != "runtime:3000" {
+		t.Errorf("newComponentPod() signature = %q, want %q", cPod.signature, "runtime:3000")
+	}
+}
@@ -1,25 +1,42 @@
 package portForward
 
 import (
+	"context"
 	"io"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/v2/pkg/devfile/parser"
+
+	"github.com/redhat-developer/odo/pkg/api"
 )
 
 type Client interface {
 	// StartPortForwarding starts port forwarding for the endpoints defined in the containers of the devfile
 	// componentName indicates the name of component in the Devfile
 	// randomPorts indicates to affect random ports, instead of stable ports starting at 20001
+	// customForwardedPorts are the local ports requested by the user for some container ports, the other ports being assigned automatically
+	// customAddress is the local address to listen on, localhost is used if empty
 	// output will be written to errOut writer
 	StartPortForwarding(
 		devFileObj parser.DevfileObj,
 		componentName string,
 		debug bool,
 		randomPorts bool,
+		customForwardedPorts []api.ForwardedPort,
+		customAddress string,
 		errOut io.Writer,
 	) error
 
+	// ForwardComponentPorts forwards the TCP ports declared by the containers of the running pods of the component in the mode
+	// (dev, deploy or any mode), until ctx is done. The pods are discovered with the odo labels of the pods, of the resources owning them,
+	// and of the services selecting them. For the pods running in Dev mode, only the endpoints of the Devfile, except the endpoints
+	// with the exposure none, are forwarded. If devfileObj is nil, an error is returned for the dev mode,
+	// and only the ports of the pods in Deploy mode are forwarded for any mode.
+	// Local ports are assigned on localhost starting at 20001, and saved in the state file.
+	// When a pod is restarted, the port forwarding is restarted on the new pod, using the same local ports.
+	// The forwarded ports are written to out, and the errors to errOut.
+	ForwardComponentPorts(ctx context.Context, devfileObj *parser.DevfileObj, componentName string, appName string, mode string, out io.Writer, errOut io.Writer) error
+
 	// StopPortForwarding stops the port forwarding
 	StopPortForwarding()
 
@@ -13,9 +13,11 @@ import (
 	"k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/klog"
 
+	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	"github.com/redhat-developer/odo/pkg/state"
 	"github.com/redhat-developer/odo/pkg/util"
 	"github.com/redhat-developer/odo/pkg/watch"
@@ -52,6 +54,8 @@ func (o *PFClient) StartPortForwarding(
 	componentName string,
 	debug bool,
 	randomPorts bool,
+	customForwardedPorts []api.ForwardedPort,
+	customAddress string,
 	errOut io.Writer,
 ) error {
 
@@ -77,9 +81,9 @@ func (o *PFClient) StartPortForwarding(
 
 	var portPairs map[string][]string
 	if randomPorts {
-		portPairs = randomPortPairsFromContainerEndpoints(ceMapping)
+		portPairs = randomPortPairsFromContainerEndpoints(ceMapping, customForwardedPorts)
 	} else {
-		portPairs = portPairsFromContainerEndpoints(ceMapping)
+		portPairs = portPairsFromContainerEndpoints(ceMapping, customForwardedPorts, customAddress)
 	}
 	var portPairsSlice []string
 	for _, v1 := range portPairs {
@@ -111,18 +115,25 @@ func (o *PFClient) StartPortForwarding(
 		backo := watch.NewExpBackoff()
 		for {
 			o.finishedChan = make(chan struct{}, 1)
-			portsBuf := NewPortWriter(log.GetStdout(), len(portPairsSlice), ceMapping)
+			portsOut := log.GetStdout()
+			if log.IsJSON() {
+				// The forwarded ports are reported with a machine-readable event
+				portsOut = io.Discard
+			}
+			portsBuf := NewPortWriter(portsOut, len(portPairsSlice), ceMapping, customAddress)
 
 			go func() {
 				portsBuf.Wait()
 				err = o.stateClient.SetForwardedPorts(portsBuf.GetForwardedPorts())
 				if err != nil {
 					err = fmt.Errorf("unable to save forwarded ports to state file: %v", err)
+				} else {
+					machineoutput.NewMachineEventLoggingClient().PortsForwarded(portsBuf.GetForwardedPorts(), machineoutput.TimestampNow())
 				}
 				devstateChan <- err
 			}()
 
-			err = o.kubernetesClient.SetupPortForwarding(pod, portPairsSlice, portsBuf, errOut, o.stopChan)
+			err = o.kubernetesClient.SetupPortForwarding(pod, customAddress, portPairsSlice, portsBuf, errOut, o.stopChan)
 			if err != nil {
 				fmt.Fprintf(errOut, "Failed to setup port-forwarding: %v\n", err)
 				d := backo.Delay()
@@ -182,31 +193,51 @@ func (o *PFClient) GetPortsToForward(devFileObj parser.DevfileObj, includeDebug
 	return ceMapping, nil
 }
 
-// randomPortPairsFromContainerEndpoints assigns a random (empty) port on localhost to each port in the provided containerEndpoints map
+// GetCustomLocalPort returns the local port requested in customForwardedPorts for the port of the container,
+// or 0 if no local port is requested for this port
+func GetCustomLocalPort(customForwardedPorts []api.ForwardedPort, containerName string, containerPort int) int {
+	for _, fwPort := range customForwardedPorts {
+		if fwPort.ContainerName == containerName && fwPort.ContainerPort == containerPort {
+			return fwPort.LocalPort
+		}
+	}
+	return 0
+}
+
+// randomPortPairsFromContainerEndpoints assigns a random (empty) port on localhost to each port in the provided containerEndpoints map,
+// except for the ports with a local port requested in customForwardedPorts
 // it returns a map of the format "<container-name>":{"<local-port-1>:<remote-port-1>", "<local-port-2>:<remote-port-2>"}
 // "container1": {":3000", ":3001"}
-func randomPortPairsFromContainerEndpoints(ceMap map[string][]v1alpha2.Endpoint) map[string][]string {
+func randomPortPairsFromContainerEndpoints(ceMap map[string][]v1alpha2.Endpoint, customForwardedPorts []api.ForwardedPort) map[string][]string {
 	portPairs := make(map[string][]string)
 
 	for name, ports := range ceMap {
 		for _, p := range ports {
 			pair := fmt.Sprintf(":%d", p.TargetPort)
+			if localPort := GetCustomLocalPort(customForwardedPorts, name, p.TargetPort); localPort != 0 {
+				pair = fmt.Sprintf("%d:%d", localPort, p.TargetPort)
+			}
 			portPairs[name] = append(portPairs[name], pair)
 		}
 	}
 	return portPairs
 }
 
-// portPairsFromContainerEndpoints assigns a port on localhost to each port in the provided containerEndpoints map
+// portPairsFromContainerEndpoints assigns a port on localhost to each port in the provided containerEndpoints map,
+// using the local ports requested in customForwardedPorts, and the next free ports on customAddress starting at 20001 for the other ports
 // it returns a map of the format "<container-name>":{"<local-port-1>:<remote-port-1>", "<local-port-2>:<remote-port-2>"}
 // "container1": {"20001:3000", "20002:3001"}
-func portPairsFromContainerEndpoints(ceMap map[string][]v1alpha2.Endpoint) map[string][]string {
+func portPairsFromContainerEndpoints(ceMap map[string][]v1alpha2.Endpoint, customForwardedPorts []api.ForwardedPort, customAddress string) map[string][]string {
 	portPairs := make(map[string][]string)
 	startPort := 20001
 	endPort := startPort + 10000
 	for name, ports := range ceMap {
 		for _, p := range ports {
-			freePort, err := util.NextFreePort(startPort, endPort, nil)
+			if localPort := GetCustomLocalPort(customForwardedPorts, name, p.TargetPort); localPort != 0 {
+				portPairs[name] = append(portPairs[name], fmt.Sprintf("%d:%d", localPort, p.TargetPort))
+				continue
+			}
+			freePort, err := nextFreePort(startPort, endPort, customAddress, customForwardedPorts)
 			if err != nil {
 				klog.Infof("%s", err)
 				continue
@@ -218,3 +249,26 @@ func portPairsFromContainerEndpoints(ceMap map[string][]v1alpha2.Endpoint) map[s
 	}
 	return portPairs
 }
+
+// nextFreePort returns the next free port on address in the range [start, end], which is not requested in customForwardedPorts
+func nextFreePort(start, end int, address string, customForwardedPorts []api.ForwardedPort) (int, error) {
+	if address == "" {
+		address = "localhost"
+	}
+	for port := start; port <= end; port++ {
+		if !IsCustomLocalPort(customForwardedPorts, port) && util.IsPortFreeOnAddress(address, port) {
+			return port, nil
+		}
+	}
+	return 0, fmt.Errorf("no free port in range [%d-%d]", start, end)
+}
+
+// IsCustomLocalPort returns true if the local port is requested in customForwardedPorts
+func IsCustomLocalPort(customForwardedPorts []api.ForwardedPort, localPort int) bool {
+	for _, fwPort := range customForwardedPorts {
+		if fwPort.LocalPort == localPort {
+			return true
+		}
+	}
+	return false
+}
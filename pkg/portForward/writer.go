@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"net"
 	"regexp"
 	"strconv"
 	"strings"
@@ -25,23 +26,30 @@ type PortWriter struct {
 	// mapping indicates the list of endpoints open by containers
 	mapping map[string][]v1alpha2.Endpoint
 	fwPorts []api.ForwardedPort
+	// prefix is the beginning of the messages written for the ports forwarded on the local address
+	prefix string
 }
 
 // NewPortWriter creates a writer that will write the content in buffer,
-// and Wait will return after strings "Forwarding from 127.0.0.1:" has been written "len" times
-func NewPortWriter(buffer io.Writer, len int, mapping map[string][]v1alpha2.Endpoint) *PortWriter {
+// and Wait will return after strings "Forwarding from <address>:" has been written "len" times.
+// If address is empty, the ports are expected to be forwarded on 127.0.0.1
+func NewPortWriter(buffer io.Writer, len int, mapping map[string][]v1alpha2.Endpoint, address string) *PortWriter {
+	if address == "" {
+		address = "127.0.0.1"
+	}
 	return &PortWriter{
 		buffer:  buffer,
 		len:     len,
 		end:     make(chan bool),
 		mapping: mapping,
+		prefix:  "Forwarding from " + net.JoinHostPort(address, ""),
 	}
 }
 
 func (o *PortWriter) Write(buf []byte) (n int, err error) {
 
 	s := string(buf)
-	if strings.HasPrefix(s, "Forwarding from 127.0.0.1") {
+	if strings.HasPrefix(s, o.prefix) {
 
 		fwPort, err := getForwardedPort(o.mapping, s)
 		if err == nil {
@@ -54,7 +62,7 @@ func (o *PortWriter) Write(buf []byte) (n int, err error) {
 		fmt.Fprintf(o.buffer, " -  %s", log.SboldColor(color.FgGreen, s))
 		o.len--
 		if o.len == 0 {
-			o.end <- true
+			close(o.end)
 		}
 	}
 	return len(buf), nil
@@ -64,26 +72,44 @@ func (o *PortWriter) Wait() {
 	<-o.end
 }
 
+// WaitUntil waits like Wait, until done is closed. It returns true if all the ports have been forwarded,
+// false if done has been closed before.
+func (o *PortWriter) WaitUntil(done <-chan struct{}) bool {
+	select {
+	case <-o.end:
+		return true
+	case <-done:
+		// The ports may have been forwarded just before done was closed
+		select {
+		case <-o.end:
+			return true
+		default:
+			return false
+		}
+	}
+}
+
 func (o *PortWriter) GetForwardedPorts() []api.ForwardedPort {
 	return o.fwPorts
 }
 
 func getForwardedPort(mapping map[string][]v1alpha2.Endpoint, s string) (api.ForwardedPort, error) {
-	regex := regexp.MustCompile(`Forwarding from 127.0.0.1:([0-9]+) -> ([0-9]+)`)
+	regex := regexp.MustCompile(`Forwarding from (\S+):([0-9]+) -> ([0-9]+)`)
 	matches := regex.FindStringSubmatch(s)
-	if len(matches) < 3 {
+	if len(matches) < 4 {
 		return api.ForwardedPort{}, errors.New("unable to analyze port forwarding string")
 	}
-	localPort, err := strconv.Atoi(matches[1])
+	localPort, err := strconv.Atoi(matches[2])
 	if err != nil {
 		return api.ForwardedPort{}, err
 	}
-	remotePort, err := strconv.Atoi(matches[2])
+	remotePort, err := strconv.Atoi(matches[3])
 	if err != nil {
 		return api.ForwardedPort{}, err
 	}
 	fp := api.ForwardedPort{
-		LocalAddress:  "127.0.0.1",
+		// IPv6 addresses are enclosed in brackets
+		LocalAddress:  strings.Trim(matches[1], "[]"),
 		LocalPort:     localPort,
 		ContainerPort: remotePort,
 	}
@@ -1,6 +1,7 @@
 package portForward
 
 import (
+	"io"
 	"testing"
 
 	"github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -88,6 +89,42 @@ func Test_getForwardedPort(t *testing.T) {
 			},
 			wantErr: false,
 		},
+		{
+			name: "find port forwarded on a custom address",
+			args: args{
+				mapping: map[string][]v1alpha2.Endpoint{
+					"container1": {
+						v1alpha2.Endpoint{Name: "port-11", TargetPort: 3000},
+					},
+				},
+				s: "Forwarding from 0.0.0.0:18080 -> 3000",
+			},
+			want: api.ForwardedPort{
+				ContainerName: "container1",
+				PortName:      "port-11",
+				LocalAddress:  "0.0.0.0",
+				LocalPort:     18080,
+				ContainerPort: 3000,
+			},
+		},
+		{
+			name: "find port forwarded on an IPv6 address",
+			args: args{
+				mapping: map[string][]v1alpha2.Endpoint{
+					"container1": {
+						v1alpha2.Endpoint{Name: "port-11", TargetPort: 3000},
+					},
+				},
+				s: "Forwarding from [::1]:18080 -> 3000",
+			},
+			want: api.ForwardedPort{
+				ContainerName: "container1",
+				PortName:      "port-11",
+				LocalAddress:  "::1",
+				LocalPort:     18080,
+				ContainerPort: 3000,
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -102,3 +139,22 @@ func Test_getForwardedPort(t *testing.T) {
 		})
 	}
 }
+
+func TestPortWriter_WaitUntil(t *testing.T) {
+	t.Run("ports forwarded", func(t *testing.T) {
+		w := NewPortWriter(io.Discard, 1, nil, "")
+		_, _ = w.Write([]byte("Forwarding from 127.0.0.1:20001 -> 3000\n"))
+		if !w.WaitUntil(make(chan struct{})) {
+			t.Error("WaitUntil() = false, want true")
+		}
+	})
+	t.Run("done before the ports are forwarded", func(t *testing.T) {
+		w := NewPortWriter(io.Discard, 2, nil, "")
+		_, _ = w.Write([]byte("Forwarding from 127.0.0.1:20001 -> 3000\n"))
+		done := make(chan struct{})
+		close(done)
+		if w.WaitUntil(done) {
+			t.Error("WaitUntil() = true, want false")
+		}
+	})
+}
@@ -45,6 +45,18 @@ type odoSettings struct {
 
 	// ConsentTelemetry if true collects telemetry for odo
 	ConsentTelemetry *bool `yaml:"ConsentTelemetry,omitempty"`
+
+	// SyncMode how the changed files are transferred to the containers
+	SyncMode *string `yaml:"SyncMode,omitempty"`
+
+	// SyncCompression if true compresses the files transferred to the containers
+	SyncCompression *bool `yaml:"SyncCompression,omitempty"`
+
+	// WatchMode how the changes of the source files are detected
+	WatchMode *string `yaml:"WatchMode,omitempty"`
+
+	// WatchPollingInterval interval between two polls of the source files
+	WatchPollingInterval *time.Duration `yaml:"WatchPollingInterval,omitempty"`
 }
 
 // Registry includes the registry metadata
@@ -148,6 +160,9 @@ func newPreferenceInfo(ctx context.Context) (*preferenceInfo, error) {
 	if c.OdoSettings.RegistryCacheTime != nil && *c.OdoSettings.RegistryCacheTime < minimumDurationValue {
 		requiresChange = append(requiresChange, RegistryCacheTimeSetting)
 	}
+	if c.OdoSettings.WatchPollingInterval != nil && *c.OdoSettings.WatchPollingInterval < minimumDurationValue {
+		requiresChange = append(requiresChange, WatchPollingIntervalSetting)
+	}
 	if len(requiresChange) != 0 {
 		log.Warningf("Please change the preference value for %s, the value does not comply with the minimum value of %s; e.g. of acceptable formats: 4s, 5m, 1h", strings.Join(requiresChange, ", "), minimumDurationValue)
 	}
@@ -305,6 +320,34 @@ func (c *preferenceInfo) SetConfiguration(parameter string, value string) error
 				return fmt.Errorf("unable to set %q to %q, value must be a boolean", parameter, value)
 			}
 			c.OdoSettings.ConsentTelemetry = &val
+
+		case "syncmode":
+			val := strings.ToLower(value)
+			if val != SyncModeFull && val != SyncModeDelta {
+				return fmt.Errorf("unable to set %q to %q, value must be one of %q or %q", parameter, value, SyncModeFull, SyncModeDelta)
+			}
+			c.OdoSettings.SyncMode = &val
+
+		case "synccompression":
+			val, err := strconv.ParseBool(strings.ToLower(value))
+			if err != nil {
+				return fmt.Errorf("unable to set %q to %q, value must be a boolean", parameter, value)
+			}
+			c.OdoSettings.SyncCompression = &val
+
+		case "watchmode":
+			val := strings.ToLower(value)
+			if val != WatchModeAuto && val != WatchModePolling {
+				return fmt.Errorf("unable to set %q to %q, value must be one of %q or %q", parameter, value, WatchModeAuto, WatchModePolling)
+			}
+			c.OdoSettings.WatchMode = &val
+
+		case "watchpollinginterval":
+			typedval, err := parseDuration(value, parameter)
+			if err != nil {
+				return err
+			}
+			c.OdoSettings.WatchPollingInterval = &typedval
 		}
 	} else {
 		return fmt.Errorf("unknown parameter : %q is not a parameter in odo preference, run `odo preference -h` to see list of available parameters", parameter)
@@ -392,6 +435,34 @@ func (c *preferenceInfo) GetConsentTelemetry() bool {
 	return kpointer.BoolDeref(c.OdoSettings.ConsentTelemetry, DefaultConsentTelemetrySetting)
 }
 
+// GetSyncMode returns the value of SyncMode from preferences
+// and if absent then returns default
+func (c *preferenceInfo) GetSyncMode() string {
+	return kpointer.StringDeref(c.OdoSettings.SyncMode, DefaultSyncModeSetting)
+}
+
+// GetSyncCompression returns the value of SyncCompression from preferences
+// and if absent then returns default
+func (c *preferenceInfo) GetSyncCompression() bool {
+	return kpointer.BoolDeref(c.OdoSettings.SyncCompression, DefaultSyncCompressionSetting)
+}
+
+// GetWatchMode returns the value of WatchMode from preferences
+// and if absent then returns default
+func (c *preferenceInfo) GetWatchMode() string {
+	return kpointer.StringDeref(c.OdoSettings.WatchMode, DefaultWatchModeSetting)
+}
+
+// GetWatchPollingInterval returns the value of WatchPollingInterval from preferences
+// and if absent then returns default. A value lower than the minimum value is raised to the minimum value.
+func (c *preferenceInfo) GetWatchPollingInterval() time.Duration {
+	interval := kpointer.DurationDeref(c.OdoSettings.WatchPollingInterval, DefaultWatchPollingInterval)
+	if interval < minimumDurationValue {
+		return minimumDurationValue
+	}
+	return interval
+}
+
 // GetEphemeral returns the value of Ephemeral from preferences
 // and if absent then returns default
 // default value: true, ephemeral is enabled by default
@@ -427,6 +498,22 @@ func (c *preferenceInfo) ConsentTelemetry() *bool {
 	return c.OdoSettings.ConsentTelemetry
 }
 
+func (c *preferenceInfo) SyncMode() *string {
+	return c.OdoSettings.SyncMode
+}
+
+func (c *preferenceInfo) SyncCompression() *bool {
+	return c.OdoSettings.SyncCompression
+}
+
+func (c *preferenceInfo) WatchMode() *string {
+	return c.OdoSettings.WatchMode
+}
+
+func (c *preferenceInfo) WatchPollingInterval() *time.Duration {
+	return c.OdoSettings.WatchPollingInterval
+}
+
 // RegistryList returns the list of registries,
 // in reverse order compared to what is declared in the preferences file.
 //
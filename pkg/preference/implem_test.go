@@ -121,6 +121,68 @@ func TestGetPushTimeout(t *testing.T) {
 	}
 }
 
+func TestGetWatchPollingInterval(t *testing.T) {
+
+	fiveSeconds := 5 * time.Second
+	zeroValue := time.Duration(0)
+	negativeValue := -1 * time.Second
+
+	tests := []struct {
+		name           string
+		existingConfig Preference
+		want           time.Duration
+	}{
+		{
+			name:           "Validating default value from test case",
+			existingConfig: Preference{},
+			want:           DefaultWatchPollingInterval,
+		},
+		{
+			name: "Validating value 5s from configuration",
+			existingConfig: Preference{
+				OdoSettings: odoSettings{
+					WatchPollingInterval: &fiveSeconds,
+				},
+			},
+			want: fiveSeconds,
+		},
+		{
+			name: "Validating value 0 raised to the minimum value",
+			existingConfig: Preference{
+				OdoSettings: odoSettings{
+					WatchPollingInterval: &zeroValue,
+				},
+			},
+			want: minimumDurationValue,
+		},
+		{
+			name: "Validating negative value raised to the minimum value",
+			existingConfig: Preference{
+				OdoSettings: odoSettings{
+					WatchPollingInterval: &negativeValue,
+				},
+			},
+			want: minimumDurationValue,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			ctx = envcontext.WithEnvConfig(ctx, config.Configuration{})
+			cfg, err := newPreferenceInfo(ctx)
+			if err != nil {
+				t.Error(err)
+			}
+			cfg.Preference = tt.existingConfig
+
+			output := cfg.GetWatchPollingInterval()
+			if output != tt.want {
+				t.Errorf("GetWatchPollingInterval returned unexpected value\ngot: %s \nexpected: %s\n", output, tt.want)
+			}
+		})
+	}
+}
+
 func TestGetTimeout(t *testing.T) {
 	zeroValue := 0 * time.Second
 	nonzeroValue := 5 * time.Second
@@ -348,6 +410,66 @@ func TestSetConfiguration(t *testing.T) {
 			wantErr: false,
 			want:    false,
 		},
+		{
+			name:           fmt.Sprintf("set %s to delta", SyncModeSetting),
+			parameter:      SyncModeSetting,
+			value:          "Delta",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           SyncModeDelta,
+		},
+		{
+			name:           fmt.Sprintf("set %s to invalid value", SyncModeSetting),
+			parameter:      SyncModeSetting,
+			value:          "rsync",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s from nil to true", SyncCompressionSetting),
+			parameter:      SyncCompressionSetting,
+			value:          "true",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to non bool value", SyncCompressionSetting),
+			parameter:      SyncCompressionSetting,
+			value:          "gzip",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to polling", WatchModeSetting),
+			parameter:      WatchModeSetting,
+			value:          "Polling",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           WatchModePolling,
+		},
+		{
+			name:           fmt.Sprintf("set %s to invalid value", WatchModeSetting),
+			parameter:      WatchModeSetting,
+			value:          "inotify",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
+		{
+			name:           fmt.Sprintf("set %s to 5s", WatchPollingIntervalSetting),
+			parameter:      WatchPollingIntervalSetting,
+			value:          "5s",
+			existingConfig: Preference{},
+			wantErr:        false,
+			want:           5 * time.Second,
+		},
+		{
+			name:           fmt.Sprintf("set %s to less than the minimum value", WatchPollingIntervalSetting),
+			parameter:      WatchPollingIntervalSetting,
+			value:          "100ms",
+			existingConfig: Preference{},
+			wantErr:        true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -377,6 +499,22 @@ func TestSetConfiguration(t *testing.T) {
 					if *cfg.OdoSettings.RegistryCacheTime != tt.want {
 						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %d\n", *cfg.OdoSettings.RegistryCacheTime, tt.want)
 					}
+				case SyncModeSetting:
+					if *cfg.OdoSettings.SyncMode != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.SyncMode, tt.want)
+					}
+				case SyncCompressionSetting:
+					if *cfg.OdoSettings.SyncCompression != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.SyncCompression, tt.want)
+					}
+				case WatchModeSetting:
+					if *cfg.OdoSettings.WatchMode != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.WatchMode, tt.want)
+					}
+				case WatchPollingIntervalSetting:
+					if *cfg.OdoSettings.WatchPollingInterval != tt.want {
+						t.Errorf("unexpected value after execution of SetConfiguration\ngot: %v \nexpected: %v\n", *cfg.OdoSettings.WatchPollingInterval, tt.want)
+					}
 				}
 			} else if tt.wantErr && err != nil {
 				// negative cases
@@ -67,6 +67,34 @@ func toPreferenceItems(prefInfo preferenceInfo) []PreferenceItem {
 			Type:        getType(prefInfo.GetEphemeral()),
 			Description: EphemeralSettingDescription,
 		},
+		{
+			Name:        SyncModeSetting,
+			Value:       settings.SyncMode,
+			Default:     DefaultSyncModeSetting,
+			Type:        getType(prefInfo.GetSyncMode()),
+			Description: SyncModeSettingDescription,
+		},
+		{
+			Name:        SyncCompressionSetting,
+			Value:       settings.SyncCompression,
+			Default:     DefaultSyncCompressionSetting,
+			Type:        getType(prefInfo.GetSyncCompression()),
+			Description: SyncCompressionSettingDescription,
+		},
+		{
+			Name:        WatchModeSetting,
+			Value:       settings.WatchMode,
+			Default:     DefaultWatchModeSetting,
+			Type:        getType(prefInfo.GetWatchMode()),
+			Description: WatchModeSettingDescription,
+		},
+		{
+			Name:        WatchPollingIntervalSetting,
+			Value:       settings.WatchPollingInterval,
+			Default:     DefaultWatchPollingInterval,
+			Type:        getType(prefInfo.GetWatchPollingInterval()),
+			Description: WatchPollingIntervalSettingDescription,
+		},
 	}
 }
 
@@ -132,6 +132,34 @@ func (mr *MockClientMockRecorder) GetRegistryCacheTime() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRegistryCacheTime", reflect.TypeOf((*MockClient)(nil).GetRegistryCacheTime))
 }
 
+// GetSyncCompression mocks base method.
+func (m *MockClient) GetSyncCompression() bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncCompression")
+	ret0, _ := ret[0].(bool)
+	return ret0
+}
+
+// GetSyncCompression indicates an expected call of GetSyncCompression.
+func (mr *MockClientMockRecorder) GetSyncCompression() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncCompression", reflect.TypeOf((*MockClient)(nil).GetSyncCompression))
+}
+
+// GetSyncMode mocks base method.
+func (m *MockClient) GetSyncMode() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSyncMode")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetSyncMode indicates an expected call of GetSyncMode.
+func (mr *MockClientMockRecorder) GetSyncMode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSyncMode", reflect.TypeOf((*MockClient)(nil).GetSyncMode))
+}
+
 // GetTimeout mocks base method.
 func (m *MockClient) GetTimeout() time.Duration {
 	m.ctrl.T.Helper()
@@ -160,6 +188,34 @@ func (mr *MockClientMockRecorder) GetUpdateNotification() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUpdateNotification", reflect.TypeOf((*MockClient)(nil).GetUpdateNotification))
 }
 
+// GetWatchMode mocks base method.
+func (m *MockClient) GetWatchMode() string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchMode")
+	ret0, _ := ret[0].(string)
+	return ret0
+}
+
+// GetWatchMode indicates an expected call of GetWatchMode.
+func (mr *MockClientMockRecorder) GetWatchMode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchMode", reflect.TypeOf((*MockClient)(nil).GetWatchMode))
+}
+
+// GetWatchPollingInterval mocks base method.
+func (m *MockClient) GetWatchPollingInterval() time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetWatchPollingInterval")
+	ret0, _ := ret[0].(time.Duration)
+	return ret0
+}
+
+// GetWatchPollingInterval indicates an expected call of GetWatchPollingInterval.
+func (mr *MockClientMockRecorder) GetWatchPollingInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetWatchPollingInterval", reflect.TypeOf((*MockClient)(nil).GetWatchPollingInterval))
+}
+
 // IsSet mocks base method.
 func (m *MockClient) IsSet(parameter string) bool {
 	m.ctrl.T.Helper()
@@ -272,6 +328,34 @@ func (mr *MockClientMockRecorder) SetConfiguration(parameter, value interface{})
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetConfiguration", reflect.TypeOf((*MockClient)(nil).SetConfiguration), parameter, value)
 }
 
+// SyncCompression mocks base method.
+func (m *MockClient) SyncCompression() *bool {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncCompression")
+	ret0, _ := ret[0].(*bool)
+	return ret0
+}
+
+// SyncCompression indicates an expected call of SyncCompression.
+func (mr *MockClientMockRecorder) SyncCompression() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncCompression", reflect.TypeOf((*MockClient)(nil).SyncCompression))
+}
+
+// SyncMode mocks base method.
+func (m *MockClient) SyncMode() *string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SyncMode")
+	ret0, _ := ret[0].(*string)
+	return ret0
+}
+
+// SyncMode indicates an expected call of SyncMode.
+func (mr *MockClientMockRecorder) SyncMode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SyncMode", reflect.TypeOf((*MockClient)(nil).SyncMode))
+}
+
 // Timeout mocks base method.
 func (m *MockClient) Timeout() *time.Duration {
 	m.ctrl.T.Helper()
@@ -299,3 +383,31 @@ func (mr *MockClientMockRecorder) UpdateNotification() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UpdateNotification", reflect.TypeOf((*MockClient)(nil).UpdateNotification))
 }
+
+// WatchMode mocks base method.
+func (m *MockClient) WatchMode() *string {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchMode")
+	ret0, _ := ret[0].(*string)
+	return ret0
+}
+
+// WatchMode indicates an expected call of WatchMode.
+func (mr *MockClientMockRecorder) WatchMode() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchMode", reflect.TypeOf((*MockClient)(nil).WatchMode))
+}
+
+// WatchPollingInterval mocks base method.
+func (m *MockClient) WatchPollingInterval() *time.Duration {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "WatchPollingInterval")
+	ret0, _ := ret[0].(*time.Duration)
+	return ret0
+}
+
+// WatchPollingInterval indicates an expected call of WatchPollingInterval.
+func (mr *MockClientMockRecorder) WatchPollingInterval() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "WatchPollingInterval", reflect.TypeOf((*MockClient)(nil).WatchPollingInterval))
+}
@@ -15,6 +15,10 @@ type Client interface {
 	GetEphemeralSourceVolume() bool
 	GetConsentTelemetry() bool
 	GetRegistryCacheTime() time.Duration
+	GetSyncMode() string
+	GetSyncCompression() bool
+	GetWatchMode() string
+	GetWatchPollingInterval() time.Duration
 	RegistryHandler(operation string, registryName string, registryURL string, forceFlag bool, isSecure bool) error
 
 	UpdateNotification() *bool
@@ -23,6 +27,10 @@ type Client interface {
 	RegistryCacheTime() *time.Duration
 	EphemeralSourceVolume() *bool
 	ConsentTelemetry() *bool
+	SyncMode() *string
+	SyncCompression() *bool
+	WatchMode() *string
+	WatchPollingInterval() *time.Duration
 	RegistryList() []Registry
 	RegistryNameExists(name string) bool
 
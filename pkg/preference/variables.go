@@ -61,13 +61,49 @@ const (
 
 	// DefaultConsentTelemetry is a default value for ConsentTelemetry preference
 	DefaultConsentTelemetrySetting = false
+
+	// SyncModeSetting specifies how the changed files are transferred to the containers
+	SyncModeSetting = "SyncMode"
+
+	// SyncModeFull transfers the complete content of the changed files
+	SyncModeFull = "full"
+
+	// SyncModeDelta transfers only the changed blocks of the large changed files
+	SyncModeDelta = "delta"
+
+	// DefaultSyncModeSetting is a default value for SyncMode preference
+	DefaultSyncModeSetting = SyncModeFull
+
+	// SyncCompressionSetting specifies if the files transferred to the containers are compressed
+	SyncCompressionSetting = "SyncCompression"
+
+	// DefaultSyncCompressionSetting is a default value for SyncCompression preference
+	DefaultSyncCompressionSetting = false
+
+	// WatchModeSetting specifies how the changes of the source files are detected
+	WatchModeSetting = "WatchMode"
+
+	// WatchModeAuto uses filesystem notifications, and polls the files when notifications are not available
+	WatchModeAuto = "auto"
+
+	// WatchModePolling always polls the files
+	WatchModePolling = "polling"
+
+	// DefaultWatchModeSetting is a default value for WatchMode preference
+	DefaultWatchModeSetting = WatchModeAuto
+
+	// WatchPollingIntervalSetting is the name of the setting controlling the interval between two polls of the source files
+	WatchPollingIntervalSetting = "WatchPollingInterval"
+
+	// DefaultWatchPollingInterval is a default value for WatchPollingInterval preference
+	DefaultWatchPollingInterval = 1 * time.Second
 )
 
 // TimeoutSettingDescription is human-readable description for the timeout setting
 var TimeoutSettingDescription = fmt.Sprintf("Timeout (in Duration) for cluster server connection check (Default: %s)", DefaultTimeout)
 
 // PushTimeoutSettingDescription adds a description for PushTimeout
-var PushTimeoutSettingDescription = fmt.Sprintf("PushTimeout (in Duration) for waiting for a Pod to come up (Default: %s)", DefaultPushTimeout)
+var PushTimeoutSettingDescription = fmt.Sprintf("PushTimeout (in Duration) for waiting for a Pod to come up, or for a command of odo deploy to complete (Default: %s)", DefaultPushTimeout)
 
 // RegistryCacheTimeSettingDescription adds a description for RegistryCacheTime
 var RegistryCacheTimeSettingDescription = fmt.Sprintf("For how long (in Duration) odo will cache information from the Devfile registry (Default: %s)", DefaultRegistryCacheTime)
@@ -78,6 +114,18 @@ var EphemeralSettingDescription = fmt.Sprintf("If true, odo will create an empty
 // ConsentTelemetrySettingDescription adds a description for TelemetryConsentSetting
 var ConsentTelemetrySettingDescription = fmt.Sprintf("If true, odo will collect telemetry for the user's odo usage (Default: %t)\n\t\t    For more information: https://developers.redhat.com/article/tool-data-collection", DefaultConsentTelemetrySetting)
 
+// SyncModeSettingDescription adds a description for SyncMode
+var SyncModeSettingDescription = fmt.Sprintf("How the changed files are transferred to the containers: %q copies the complete files, %q copies only the changed blocks of large files (Default: %s)", SyncModeFull, SyncModeDelta, DefaultSyncModeSetting)
+
+// SyncCompressionSettingDescription adds a description for SyncCompression
+var SyncCompressionSettingDescription = fmt.Sprintf("If true, odo will compress the files transferred to the containers (Default: %t)", DefaultSyncCompressionSetting)
+
+// WatchModeSettingDescription adds a description for WatchMode
+var WatchModeSettingDescription = fmt.Sprintf("How the changes of the source files are detected: %q uses filesystem notifications, and polls the files if notifications are not available, %q always polls the files (Default: %s)", WatchModeAuto, WatchModePolling, DefaultWatchModeSetting)
+
+// WatchPollingIntervalSettingDescription adds a description for WatchPollingInterval
+var WatchPollingIntervalSettingDescription = fmt.Sprintf("Interval (in Duration) between two polls of the source files, when they are polled for changes (Default: %s)", DefaultWatchPollingInterval)
+
 // This value can be provided to set a seperate directory for users 'homedir' resolution
 // note for mocking purpose ONLY
 var customHomeDir = os.Getenv("CUSTOM_HOMEDIR")
@@ -85,12 +133,16 @@ var customHomeDir = os.Getenv("CUSTOM_HOMEDIR")
 var (
 	// records information on supported parameters
 	supportedParameterDescriptions = map[string]string{
-		UpdateNotificationSetting: UpdateNotificationSettingDescription,
-		TimeoutSetting:            TimeoutSettingDescription,
-		PushTimeoutSetting:        PushTimeoutSettingDescription,
-		RegistryCacheTimeSetting:  RegistryCacheTimeSettingDescription,
-		EphemeralSetting:          EphemeralSettingDescription,
-		ConsentTelemetrySetting:   ConsentTelemetrySettingDescription,
+		UpdateNotificationSetting:   UpdateNotificationSettingDescription,
+		TimeoutSetting:              TimeoutSettingDescription,
+		PushTimeoutSetting:          PushTimeoutSettingDescription,
+		RegistryCacheTimeSetting:    RegistryCacheTimeSettingDescription,
+		EphemeralSetting:            EphemeralSettingDescription,
+		ConsentTelemetrySetting:     ConsentTelemetrySettingDescription,
+		SyncModeSetting:             SyncModeSettingDescription,
+		SyncCompressionSetting:      SyncCompressionSettingDescription,
+		WatchModeSetting:            WatchModeSettingDescription,
+		WatchPollingIntervalSetting: WatchPollingIntervalSettingDescription,
 	}
 
 	// set-like map to quickly check if a parameter is supported
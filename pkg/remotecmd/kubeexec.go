@@ -279,6 +279,7 @@ func (k *kubeExecProcessHandler) getProcessInfoFromPid(
 	if killStatus == 0 {
 		process.Status = Running
 	} else {
+		process.ExitCode = lastKnownExitStatus
 		if lastKnownExitStatus == 0 {
 			process.Status = Stopped
 		} else {
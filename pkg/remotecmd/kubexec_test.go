@@ -115,8 +115,9 @@ func TestKubeExecProcessHandler_GetProcessInfoForCommand(t *testing.T) {
 					})
 			},
 			want: RemoteProcessInfo{
-				Pid:    123,
-				Status: Errored,
+				Pid:      123,
+				Status:   Errored,
+				ExitCode: 1,
 			},
 		},
 		{
@@ -32,6 +32,9 @@ type RemoteProcessInfo struct {
 
 	// Status of the process
 	Status RemoteProcessStatus
+
+	// ExitCode is the exit code of the process, if it is Stopped or Errored
+	ExitCode int
 }
 
 // CommandDefinition represents the structure of any given command that would be handled by implementations of RemoteProcessHandler.
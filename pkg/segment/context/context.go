@@ -10,6 +10,7 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/redhat-developer/odo/pkg/docker"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/platform"
 	"github.com/redhat-developer/odo/pkg/podman"
@@ -108,6 +109,9 @@ func SetPlatform(ctx context.Context, client platform.Client) {
 	switch client := client.(type) {
 	case kclient.ClientInterface:
 		setPlatformCluster(ctx, client)
+	case *docker.DockerCli:
+		// checked before podman.Client, as the Docker client implements the same interface
+		setPlatformDocker(ctx, client)
 	case podman.Client:
 		setPlatformPodman(ctx, client)
 	}
@@ -158,6 +162,16 @@ func setPlatformPodman(ctx context.Context, client podman.Client) {
 	setContextProperty(ctx, PlatformVersion, version.Client.Version)
 }
 
+func setPlatformDocker(ctx context.Context, client docker.Client) {
+	setContextProperty(ctx, Platform, "docker")
+	version, err := client.Version()
+	if err != nil {
+		klog.V(3).Info(fmt.Errorf("unable to get docker version: %w", err))
+		return
+	}
+	setContextProperty(ctx, PlatformVersion, version.Client.Version)
+}
+
 // SetTelemetryStatus sets telemetry status before a command is run
 func SetTelemetryStatus(ctx context.Context, isEnabled bool) {
 	setContextProperty(ctx, TelemetryStatus, isEnabled)
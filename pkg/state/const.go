@@ -1,3 +1,6 @@
 package state
 
 const _filepath = "./.odo/devstate.json"
+
+// _lockFilepath is the file locked by the odo processes while they update the state file
+const _lockFilepath = "./.odo/devstate.lock"
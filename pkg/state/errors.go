@@ -0,0 +1,19 @@
+package state
+
+import (
+	"fmt"
+	"time"
+)
+
+// SessionAlreadyRunningError is returned when an odo dev session is already running on the same platform
+type SessionAlreadyRunningError struct {
+	Session Session
+}
+
+func (e *SessionAlreadyRunningError) Error() string {
+	msg := fmt.Sprintf("an odo dev session is already running on %s", e.Session.Platform)
+	if e.Session.Namespace != "" {
+		msg += fmt.Sprintf(" in namespace %q", e.Session.Namespace)
+	}
+	return msg + fmt.Sprintf(" (PID %d, started at %s)", e.Session.PID, e.Session.StartTime.Format(time.RFC3339))
+}
@@ -3,12 +3,28 @@ package state
 import "github.com/redhat-developer/odo/pkg/api"
 
 type Client interface {
-	// SetForwardedPorts sets the forwarded ports in the state file and saves it to the file, updating the metadata
+	// Init registers the odo dev session of the current process, running on the platform and namespace, in the state file.
+	// The sessions of processes not running anymore are removed from the file.
+	// A SessionAlreadyRunningError is returned if another session is running on the same platform and namespace.
+	Init(platform string, namespace string) error
+
+	// InitPortForward registers the odo port-forward session of the current process, forwarding ports from the namespace, in the state file.
+	// Several odo port-forward sessions can run along with the odo dev sessions.
+	InitPortForward(namespace string) error
+
+	// SetForwardedPorts sets the forwarded ports of the current session in the state file and saves it to the file
 	SetForwardedPorts(fwPorts []api.ForwardedPort) error
 
-	// GetForwardedPorts returns the ports forwarded by the current odo dev session
+	// GetForwardedPorts returns the ports forwarded by the odo dev session of the current process
 	GetForwardedPorts() ([]api.ForwardedPort, error)
 
-	// SaveExit resets the state file to indicate odo is not running
+	// SetAPIServer sets the address of the control API of the current session, and the token authenticating its requests,
+	// in the state file and saves it to the file
+	SetAPIServer(address string, token string) error
+
+	// GetSessions returns the odo dev sessions running from the directory
+	GetSessions() ([]Session, error)
+
+	// SaveExit removes the session of the current process from the state file to indicate it is not running anymore
 	SaveExit() error
 }
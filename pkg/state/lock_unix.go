@@ -0,0 +1,18 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris zos
+
+package state
+
+import (
+	"golang.org/x/sys/unix"
+)
+
+// lockFile takes an exclusive lock on the file with the descriptor fd, waiting for the lock to be released by other processes
+func lockFile(fd uintptr) error {
+	return unix.Flock(int(fd), unix.LOCK_EX)
+}
+
+// unlockFile releases the lock taken with lockFile
+func unlockFile(fd uintptr) error {
+	return unix.Flock(int(fd), unix.LOCK_UN)
+}
@@ -0,0 +1,17 @@
+package state
+
+import (
+	"math"
+
+	"golang.org/x/sys/windows"
+)
+
+// lockFile takes an exclusive lock on the file with the descriptor fd, waiting for the lock to be released by other processes
+func lockFile(fd uintptr) error {
+	return windows.LockFileEx(windows.Handle(fd), windows.LOCKFILE_EXCLUSIVE_LOCK, 0, math.MaxUint32, math.MaxUint32, &windows.Overlapped{})
+}
+
+// unlockFile releases the lock taken with lockFile
+func unlockFile(fd uintptr) error {
+	return windows.UnlockFileEx(windows.Handle(fd), 0, math.MaxUint32, math.MaxUint32, &windows.Overlapped{})
+}
@@ -0,0 +1,16 @@
+package state
+
+import (
+	"time"
+
+	"golang.org/x/sys/unix"
+)
+
+// getProcessStartTime returns the time the process has been started
+func getProcessStartTime(pid int) (time.Time, error) {
+	info, err := unix.SysctlKinfoProc("kern.proc.pid", pid)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(info.Proc.P_starttime.Unix()), nil
+}
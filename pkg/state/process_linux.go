@@ -0,0 +1,69 @@
+package state
+
+import (
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// clockTicks is the number of clock ticks per second used by the kernel to express the start time of the processes.
+// It is the value of USER_HZ, 100 on all supported architectures.
+const clockTicks = 100
+
+// getProcessStartTime returns the time the process has been started, computed from the boot time of the system
+// and from the number of clock ticks between the boot and the start of the process
+func getProcessStartTime(pid int) (time.Time, error) {
+	stat, err := os.ReadFile(fmt.Sprintf("/proc/%d/stat", pid))
+	if err != nil {
+		return time.Time{}, err
+	}
+	// The name of the command, in parentheses, can contain spaces and parentheses
+	end := bytes.LastIndexByte(stat, ')')
+	if end == -1 {
+		return time.Time{}, fmt.Errorf("unable to parse the status of process %d", pid)
+	}
+	// The fields following the command name start with the third field, the start time being the 22nd field
+	fields := strings.Fields(string(stat[end+1:]))
+	if len(fields) < 20 {
+		return time.Time{}, fmt.Errorf("unable to parse the status of process %d", pid)
+	}
+	ticks, err := strconv.ParseInt(fields[19], 10, 64)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unable to parse the start time of process %d: %w", pid, err)
+	}
+	bootTime, err := getBootTime()
+	if err != nil {
+		return time.Time{}, err
+	}
+	return bootTime.Add(time.Duration(ticks) * time.Second / clockTicks), nil
+}
+
+// getBootTime returns the boot time of the system, with a precision of one second
+func getBootTime() (time.Time, error) {
+	f, err := os.Open("/proc/stat")
+	if err != nil {
+		return time.Time{}, err
+	}
+	defer f.Close()
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		line := sc.Text()
+		if !strings.HasPrefix(line, "btime ") {
+			continue
+		}
+		seconds, err := strconv.ParseInt(strings.TrimSpace(strings.TrimPrefix(line, "btime ")), 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("unable to parse the boot time: %w", err)
+		}
+		return time.Unix(seconds, 0), nil
+	}
+	if err = sc.Err(); err != nil {
+		return time.Time{}, err
+	}
+	return time.Time{}, errors.New("boot time not found in /proc/stat")
+}
@@ -0,0 +1,14 @@
+//go:build !linux && !darwin && !windows
+// +build !linux,!darwin,!windows
+
+package state
+
+import (
+	"errors"
+	"time"
+)
+
+// getProcessStartTime is not supported on this system, the sessions being considered running as long as their process ID exists
+func getProcessStartTime(pid int) (time.Time, error) {
+	return time.Time{}, errors.New("the start time of processes is not supported on this system")
+}
@@ -0,0 +1,17 @@
+//go:build aix || darwin || dragonfly || freebsd || linux || netbsd || openbsd || solaris || zos
+// +build aix darwin dragonfly freebsd linux netbsd openbsd solaris zos
+
+package state
+
+import (
+	"errors"
+
+	"golang.org/x/sys/unix"
+)
+
+// isProcessRunning sends the null signal to the process, to check its existence without affecting it
+func isProcessRunning(pid int) bool {
+	err := unix.Kill(pid, 0)
+	// EPERM indicates the process exists, but is owned by another user
+	return err == nil || errors.Is(err, unix.EPERM)
+}
@@ -0,0 +1,38 @@
+package state
+
+import (
+	"time"
+
+	"golang.org/x/sys/windows"
+)
+
+// stillActive is the exit code returned by GetExitCodeProcess for a running process
+const stillActive = 259
+
+func isProcessRunning(pid int) bool {
+	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return false
+	}
+	//nolint:errcheck
+	defer windows.CloseHandle(handle)
+	var exitCode uint32
+	err = windows.GetExitCodeProcess(handle, &exitCode)
+	return err == nil && exitCode == stillActive
+}
+
+// getProcessStartTime returns the time the process has been created
+func getProcessStartTime(pid int) (time.Time, error) {
+	handle, err := windows.OpenProcess(windows.PROCESS_QUERY_LIMITED_INFORMATION, false, uint32(pid))
+	if err != nil {
+		return time.Time{}, err
+	}
+	//nolint:errcheck
+	defer windows.CloseHandle(handle)
+	var creationTime, exitTime, kernelTime, userTime windows.Filetime
+	err = windows.GetProcessTimes(handle, &creationTime, &exitTime, &kernelTime, &userTime)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return time.Unix(0, creationTime.Nanoseconds()), nil
+}
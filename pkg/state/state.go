@@ -3,68 +3,277 @@ package state
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"io/fs"
 	"os"
 	"path/filepath"
+	"time"
+
+	"k8s.io/klog"
 
 	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
+// startTimeTolerance is the precision of the start time of the processes,
+// used to compare the start time of the process of a session with the start time of the session
+const startTimeTolerance = 2 * time.Second
+
 type State struct {
 	content Content
 	fs      filesystem.Filesystem
+	// getpid returns the process ID of the current process
+	getpid func() int
+	// now returns the current time
+	now func() time.Time
+	// isProcessRunning indicates if a process with the given process ID is running
+	isProcessRunning func(pid int) bool
+	// getProcessStartTime returns the time the process with the given process ID has been started
+	getProcessStartTime func(pid int) (time.Time, error)
 }
 
 var _ Client = (*State)(nil)
 
 func NewStateClient(fs filesystem.Filesystem) *State {
 	return &State{
-		fs: fs,
+		fs:                  fs,
+		getpid:              os.Getpid,
+		now:                 time.Now,
+		isProcessRunning:    isProcessRunning,
+		getProcessStartTime: getProcessStartTime,
 	}
 }
 
+func (o *State) Init(platform string, namespace string) error {
+	return o.update(func() error {
+		pid := o.getpid()
+		for _, session := range o.content.Sessions {
+			if session.PID != pid && session.Command == "" && session.Platform == platform && session.Namespace == namespace {
+				return &SessionAlreadyRunningError{Session: session}
+			}
+		}
+		o.removeSession(pid)
+		o.content.Sessions = append(o.content.Sessions, Session{
+			PID:       pid,
+			Platform:  platform,
+			Namespace: namespace,
+			StartTime: o.now(),
+		})
+		return nil
+	})
+}
+
+func (o *State) InitPortForward(namespace string) error {
+	return o.update(func() error {
+		pid := o.getpid()
+		o.removeSession(pid)
+		o.content.Sessions = append(o.content.Sessions, Session{
+			PID:       pid,
+			Command:   PortForwardCommand,
+			Namespace: namespace,
+			StartTime: o.now(),
+		})
+		return nil
+	})
+}
+
 func (o *State) SetForwardedPorts(fwPorts []api.ForwardedPort) error {
-	// TODO(feloy) When other data is persisted into the state file, it will be needed to read the file first
-	o.content.ForwardedPorts = fwPorts
-	return o.save()
+	return o.updateSession(func(session *Session) {
+		session.ForwardedPorts = fwPorts
+	})
 }
 
 func (o *State) GetForwardedPorts() ([]api.ForwardedPort, error) {
 	err := o.read()
 	if err != nil {
-		if errors.Is(err, fs.ErrNotExist) {
-			return nil, nil // if the state file does not exist, no ports are forwarded
-		}
 		return nil, err
 	}
-	return o.content.ForwardedPorts, err
+	session := o.getSession(o.getpid())
+	if session == nil {
+		return nil, nil
+	}
+	return session.ForwardedPorts, nil
+}
+
+func (o *State) SetAPIServer(address string, token string) error {
+	return o.updateSession(func(session *Session) {
+		session.APIServerAddress = address
+		session.APIServerToken = token
+	})
+}
+
+func (o *State) GetSessions() ([]Session, error) {
+	err := o.read()
+	if err != nil {
+		return nil, err
+	}
+	return o.content.Sessions, nil
 }
 
 func (o *State) SaveExit() error {
-	o.content.ForwardedPorts = nil
+	return o.update(func() error {
+		o.removeSession(o.getpid())
+		return nil
+	})
+}
+
+// update reads the state file, modifies its content with the update function, and saves the file if no error is returned.
+// The state file is locked during the update, so that the concurrent odo processes do not lose the changes of each other.
+func (o *State) update(update func() error) error {
+	unlock, err := o.lock()
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	err = o.read()
+	if err != nil {
+		return err
+	}
+	err = update()
+	if err != nil {
+		return err
+	}
 	return o.save()
 }
 
-// save writes the content structure in json format in file
+// updateSession reads the state file, updates the session of the current process with the update function, and saves the file.
+// The session is created if the state file does not contain it yet.
+func (o *State) updateSession(update func(session *Session)) error {
+	return o.update(func() error {
+		pid := o.getpid()
+		session := o.getSession(pid)
+		if session == nil {
+			o.content.Sessions = append(o.content.Sessions, Session{
+				PID:       pid,
+				StartTime: o.now(),
+			})
+			session = &o.content.Sessions[len(o.content.Sessions)-1]
+		}
+		update(session)
+		return nil
+	})
+}
+
+func (o *State) getSession(pid int) *Session {
+	for i := range o.content.Sessions {
+		if o.content.Sessions[i].PID == pid {
+			return &o.content.Sessions[i]
+		}
+	}
+	return nil
+}
+
+func (o *State) removeSession(pid int) {
+	var sessions []Session
+	for _, session := range o.content.Sessions {
+		if session.PID != pid {
+			sessions = append(sessions, session)
+		}
+	}
+	o.content.Sessions = sessions
+}
+
+// save writes the content structure in json format in file.
+// The content is written to a temporary file renamed to the state file, so that the state file is never read partially written.
 func (o *State) save() error {
 	jsonContent, err := json.MarshalIndent(o.content, "", " ")
 	if err != nil {
 		return err
 	}
-	// .odo directory is supposed to exist, don't create it
 	dir := filepath.Dir(_filepath)
-	err = os.MkdirAll(dir, 0750)
+	err = o.fs.MkdirAll(dir, 0750)
 	if err != nil {
 		return err
 	}
-	return o.fs.WriteFile(_filepath, jsonContent, 0644)
+	// The temporary file is only readable by the user, as the content contains the token of the control API
+	tmpFile, err := o.fs.TempFile(dir, filepath.Base(_filepath))
+	if err != nil {
+		return err
+	}
+	_, err = tmpFile.Write(jsonContent)
+	if errClose := tmpFile.Close(); err == nil {
+		err = errClose
+	}
+	if err == nil {
+		err = o.fs.Rename(tmpFile.Name(), _filepath)
+	}
+	if err != nil {
+		_ = o.fs.Remove(tmpFile.Name())
+		return fmt.Errorf("unable to save the state file: %w", err)
+	}
+	return nil
 }
 
+// read reads the content of the state file, if it exists, and removes the stale sessions,
+// whose process is not running anymore (for example if odo has been killed)
 func (o *State) read() error {
+	o.content = Content{}
 	jsonContent, err := o.fs.ReadFile(_filepath)
 	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil // if the state file does not exist, no session is running
+		}
 		return err
 	}
-	return json.Unmarshal(jsonContent, &o.content)
+	// The state file is empty when it has been created by a previous version of odo, or by the user
+	if len(jsonContent) == 0 {
+		return nil
+	}
+	err = json.Unmarshal(jsonContent, &o.content)
+	if err != nil {
+		return err
+	}
+	var sessions []Session
+	for _, session := range o.content.Sessions {
+		if session.PID != o.getpid() && !o.isSessionRunning(session) {
+			klog.V(4).Infof("removing stale odo dev session of process %d from state file", session.PID)
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	o.content.Sessions = sessions
+	return nil
+}
+
+// isSessionRunning returns true if the process of the session is running. The process ID of the session
+// may have been reused by another process, started after the session.
+func (o *State) isSessionRunning(session Session) bool {
+	if !o.isProcessRunning(session.PID) {
+		return false
+	}
+	startTime, err := o.getProcessStartTime(session.PID)
+	if err != nil {
+		klog.V(4).Infof("unable to get the start time of process %d: %v", session.PID, err)
+		return true
+	}
+	return !startTime.After(session.StartTime.Add(startTimeTolerance))
+}
+
+// lock takes an exclusive lock on the lock file of the state file, waiting for other odo processes to release it,
+// and returns the function releasing the lock
+func (o *State) lock() (func(), error) {
+	err := o.fs.MkdirAll(filepath.Dir(_lockFilepath), 0750)
+	if err != nil {
+		return nil, err
+	}
+	f, err := o.fs.OpenFile(_lockFilepath, os.O_CREATE|os.O_RDWR, 0600)
+	if err != nil {
+		return nil, err
+	}
+	lockable, ok := f.(interface{ Fd() uintptr })
+	if !ok {
+		// The file is not a file of the operating system, shared with other processes
+		return func() { f.Close() }, nil
+	}
+	err = lockFile(lockable.Fd())
+	if err != nil {
+		f.Close()
+		return nil, fmt.Errorf("unable to lock %s: %w", _lockFilepath, err)
+	}
+	return func() {
+		if errUnlock := unlockFile(lockable.Fd()); errUnlock != nil {
+			klog.V(4).Infof("unable to unlock %s: %v", _lockFilepath, errUnlock)
+		}
+		f.Close()
+	}, nil
 }
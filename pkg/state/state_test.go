@@ -2,8 +2,12 @@ package state
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"os"
+	"sync"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 
@@ -11,61 +15,267 @@ import (
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 )
 
-func TestState_SetForwardedPorts(t *testing.T) {
-
-	forwardedPort1 := api.ForwardedPort{
+var (
+	forwardedPort1 = api.ForwardedPort{
 		ContainerName: "acontainer",
 		LocalAddress:  "localhost",
 		LocalPort:     20001,
 		ContainerPort: 3000,
 	}
+	startTime = time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)
+)
+
+// newFakeState returns a State for the process 100, considering the processes in runningPIDs as running,
+// and started before the sessions
+func newFakeState(fs filesystem.Filesystem, runningPIDs ...int) *State {
+	return &State{
+		fs: fs,
+		getpid: func() int {
+			return 100
+		},
+		now: func() time.Time {
+			return startTime
+		},
+		isProcessRunning: func(pid int) bool {
+			for _, running := range runningPIDs {
+				if pid == running {
+					return true
+				}
+			}
+			return false
+		},
+		getProcessStartTime: func(pid int) (time.Time, error) {
+			return startTime.Add(-time.Minute), nil
+		},
+	}
+}
+
+func writeContent(t *testing.T, fs filesystem.Filesystem, content Content) {
+	jsonContent, err := json.Marshal(content)
+	if err != nil {
+		t.Fatalf("Error marshaling data: %v", err)
+	}
+	err = fs.WriteFile(_filepath, jsonContent, 0644)
+	if err != nil {
+		t.Fatalf("Error saving content to file: %v", err)
+	}
+}
+
+func readContent(fs filesystem.Filesystem) (Content, error) {
+	var content Content
+	jsonContent, err := fs.ReadFile(_filepath)
+	if err != nil {
+		return content, err
+	}
+	err = json.Unmarshal(jsonContent, &content)
+	return content, err
+}
+
+func TestState_Init(t *testing.T) {
+	tests := []struct {
+		name        string
+		existing    *Content
+		runningPIDs []int
+		// reusedPIDs are the running processes started after the sessions
+		reusedPIDs   []int
+		platform     string
+		namespace    string
+		wantErr      bool
+		wantSessions []Session
+	}{
+		{
+			name:      "no state file",
+			platform:  "cluster",
+			namespace: "ns",
+			wantSessions: []Session{
+				{PID: 100, Platform: "cluster", Namespace: "ns", StartTime: startTime},
+			},
+		},
+		{
+			name: "another session running on another platform",
+			existing: &Content{Sessions: []Session{
+				{PID: 200, Platform: "podman", StartTime: startTime},
+			}},
+			runningPIDs: []int{200},
+			platform:    "cluster",
+			namespace:   "ns",
+			wantSessions: []Session{
+				{PID: 200, Platform: "podman", StartTime: startTime},
+				{PID: 100, Platform: "cluster", Namespace: "ns", StartTime: startTime},
+			},
+		},
+		{
+			name: "another session running in another namespace",
+			existing: &Content{Sessions: []Session{
+				{PID: 200, Platform: "cluster", Namespace: "other", StartTime: startTime},
+			}},
+			runningPIDs: []int{200},
+			platform:    "cluster",
+			namespace:   "ns",
+			wantSessions: []Session{
+				{PID: 200, Platform: "cluster", Namespace: "other", StartTime: startTime},
+				{PID: 100, Platform: "cluster", Namespace: "ns", StartTime: startTime},
+			},
+		},
+		{
+			name: "another session running on the same platform",
+			existing: &Content{Sessions: []Session{
+				{PID: 200, Platform: "podman", StartTime: startTime},
+			}},
+			runningPIDs: []int{200},
+			platform:    "podman",
+			wantErr:     true,
+			wantSessions: []Session{
+				{PID: 200, Platform: "podman", StartTime: startTime},
+			},
+		},
+		{
+			name: "odo port-forward session running in the same namespace",
+			existing: &Content{Sessions: []Session{
+				{PID: 200, Command: PortForwardCommand, Namespace: "ns", StartTime: startTime},
+			}},
+			runningPIDs: []int{200},
+			platform:    "cluster",
+			namespace:   "ns",
+			wantSessions: []Session{
+				{PID: 200, Command: PortForwardCommand, Namespace: "ns", StartTime: startTime},
+				{PID: 100, Platform: "cluster", Namespace: "ns", StartTime: startTime},
+			},
+		},
+		{
+			name: "stale session on the same platform is removed",
+			existing: &Content{Sessions: []Session{
+				{PID: 200, Platform: "podman", StartTime: startTime},
+			}},
+			platform: "podman",
+			wantSessions: []Session{
+				{PID: 100, Platform: "podman", StartTime: startTime},
+			},
+		},
+		{
+			name: "session whose process ID is reused by another process is removed",
+			existing: &Content{Sessions: []Session{
+				{PID: 200, Platform: "podman", StartTime: startTime},
+			}},
+			runningPIDs: []int{200},
+			reusedPIDs:  []int{200},
+			platform:    "podman",
+			wantSessions: []Session{
+				{PID: 100, Platform: "podman", StartTime: startTime},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fs := filesystem.NewFakeFs()
+			if tt.existing != nil {
+				writeContent(t, fs, *tt.existing)
+			}
+			o := newFakeState(fs, tt.runningPIDs...)
+			o.getProcessStartTime = func(pid int) (time.Time, error) {
+				for _, reused := range tt.reusedPIDs {
+					if pid == reused {
+						return startTime.Add(time.Hour), nil
+					}
+				}
+				return startTime.Add(-time.Minute), nil
+			}
+			err := o.Init(tt.platform, tt.namespace)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("State.Init() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				var alreadyRunningErr *SessionAlreadyRunningError
+				if !errors.As(err, &alreadyRunningErr) {
+					t.Errorf("State.Init() error = %v, should be a SessionAlreadyRunningError", err)
+				}
+			}
+			content, err := readContent(fs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if diff := cmp.Diff(tt.wantSessions, content.Sessions); diff != "" {
+				t.Errorf("State.Init() sessions mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
 
-	type fields struct {
-		fs                  func() filesystem.Filesystem
-		getSecondsFromEpoch func() int64
-		getpid              func() int
+func TestState_InitPortForward(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+	writeContent(t, fs, Content{Sessions: []Session{
+		{PID: 200, Platform: "cluster", Namespace: "ns", StartTime: startTime},
+		{PID: 300, Command: PortForwardCommand, Namespace: "ns", StartTime: startTime},
+	}})
+	o := newFakeState(fs, 200, 300)
+	err := o.InitPortForward("ns")
+	if err != nil {
+		t.Fatalf("State.InitPortForward() error = %v", err)
+	}
+	content, err := readContent(fs)
+	if err != nil {
+		t.Fatal(err)
 	}
+	want := []Session{
+		{PID: 200, Platform: "cluster", Namespace: "ns", StartTime: startTime},
+		{PID: 300, Command: PortForwardCommand, Namespace: "ns", StartTime: startTime},
+		{PID: 100, Command: PortForwardCommand, Namespace: "ns", StartTime: startTime},
+	}
+	if diff := cmp.Diff(want, content.Sessions); diff != "" {
+		t.Errorf("State.InitPortForward() sessions mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestState_SetForwardedPorts(t *testing.T) {
 	type args struct {
 		fwPorts []api.ForwardedPort
 	}
 	tests := []struct {
 		name       string
-		fields     fields
+		existing   *Content
 		args       args
 		wantErr    bool
 		checkState func(fs filesystem.Filesystem) error
 	}{
-		// TODO: Add test cases.
 		{
 			name: "set forwarded ports",
-			fields: fields{
-				fs: func() filesystem.Filesystem {
-					return filesystem.NewFakeFs()
-				},
-				getSecondsFromEpoch: func() int64 {
-					return 13000
-				},
-				getpid: func() int {
-					return 100
-				},
-			},
 			args: args{
 				fwPorts: []api.ForwardedPort{forwardedPort1},
 			},
 			wantErr: false,
 			checkState: func(fs filesystem.Filesystem) error {
-				jsonContent, err := fs.ReadFile(_filepath)
+				content, err := readContent(fs)
 				if err != nil {
 					return err
 				}
-				var content Content
-				err = json.Unmarshal(jsonContent, &content)
+				expected := []Session{{PID: 100, StartTime: startTime, ForwardedPorts: []api.ForwardedPort{forwardedPort1}}}
+				if diff := cmp.Diff(expected, content.Sessions); diff != "" {
+					return fmt.Errorf("sessions is %+v, should be %+v, diff: %s", content.Sessions, expected, diff)
+				}
+				return nil
+			},
+		},
+		{
+			name: "set forwarded ports of the current session only",
+			existing: &Content{Sessions: []Session{
+				{PID: 100, Platform: "cluster", StartTime: startTime},
+				{PID: 200, Platform: "podman", StartTime: startTime},
+			}},
+			args: args{
+				fwPorts: []api.ForwardedPort{forwardedPort1},
+			},
+			wantErr: false,
+			checkState: func(fs filesystem.Filesystem) error {
+				content, err := readContent(fs)
 				if err != nil {
 					return err
 				}
-				expected := []api.ForwardedPort{forwardedPort1}
-				if diff := cmp.Diff(expected, content.ForwardedPorts); diff != "" {
-					return fmt.Errorf("forwarded ports is %+v, should be %+v, diff: %s", content.ForwardedPorts, expected, diff)
+				expected := []Session{
+					{PID: 100, Platform: "cluster", StartTime: startTime, ForwardedPorts: []api.ForwardedPort{forwardedPort1}},
+					{PID: 200, Platform: "podman", StartTime: startTime},
+				}
+				if diff := cmp.Diff(expected, content.Sessions); diff != "" {
+					return fmt.Errorf("sessions is %+v, should be %+v, diff: %s", content.Sessions, expected, diff)
 				}
 				return nil
 			},
@@ -73,10 +283,11 @@ func TestState_SetForwardedPorts(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			fs := tt.fields.fs()
-			o := State{
-				fs: fs,
+			fs := filesystem.NewFakeFs()
+			if tt.existing != nil {
+				writeContent(t, fs, *tt.existing)
 			}
+			o := newFakeState(fs, 200)
 			if err := o.SetForwardedPorts(tt.args.fwPorts); (err != nil) != tt.wantErr {
 				t.Errorf("State.SetForwardedPorts() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -88,43 +299,41 @@ func TestState_SetForwardedPorts(t *testing.T) {
 }
 
 func TestState_SaveExit(t *testing.T) {
-	type fields struct {
-		fs                  func() filesystem.Filesystem
-		getSecondsFromEpoch func() int64
-		getpid              func() int
-	}
 	tests := []struct {
 		name       string
-		fields     fields
+		existing   *Content
 		wantErr    bool
 		checkState func(fs filesystem.Filesystem) error
 	}{
 		{
-			name: "save exit",
-			fields: fields{
-				fs: func() filesystem.Filesystem {
-					return filesystem.NewFakeFs()
-				},
-				getSecondsFromEpoch: func() int64 {
-					return 13000
-				},
-				getpid: func() int {
-					return 100
-				},
-			},
+			name:    "save exit",
 			wantErr: false,
 			checkState: func(fs filesystem.Filesystem) error {
-				jsonContent, err := fs.ReadFile(_filepath)
+				content, err := readContent(fs)
 				if err != nil {
 					return err
 				}
-				var content Content
-				err = json.Unmarshal(jsonContent, &content)
+				if len(content.Sessions) != 0 {
+					return fmt.Errorf("Sessions is %+v, should be empty", content.Sessions)
+				}
+				return nil
+			},
+		},
+		{
+			name: "save exit keeps the other sessions",
+			existing: &Content{Sessions: []Session{
+				{PID: 100, Platform: "cluster", StartTime: startTime, ForwardedPorts: []api.ForwardedPort{forwardedPort1}},
+				{PID: 200, Platform: "podman", StartTime: startTime},
+			}},
+			wantErr: false,
+			checkState: func(fs filesystem.Filesystem) error {
+				content, err := readContent(fs)
 				if err != nil {
 					return err
 				}
-				if len(content.ForwardedPorts) != 0 {
-					return fmt.Errorf("Forwarded ports is %+v, should be empty", content.ForwardedPorts)
+				expected := []Session{{PID: 200, Platform: "podman", StartTime: startTime}}
+				if diff := cmp.Diff(expected, content.Sessions); diff != "" {
+					return fmt.Errorf("sessions is %+v, should be %+v, diff: %s", content.Sessions, expected, diff)
 				}
 				return nil
 			},
@@ -132,10 +341,11 @@ func TestState_SaveExit(t *testing.T) {
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			fs := tt.fields.fs()
-			o := State{
-				fs: fs,
+			fs := filesystem.NewFakeFs()
+			if tt.existing != nil {
+				writeContent(t, fs, *tt.existing)
 			}
+			o := newFakeState(fs, 200)
 			if err := o.SaveExit(); (err != nil) != tt.wantErr {
 				t.Errorf("State.SaveExit() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -148,52 +358,56 @@ func TestState_SaveExit(t *testing.T) {
 
 func TestState_GetForwardedPorts(t *testing.T) {
 	content1 := Content{
-		ForwardedPorts: []api.ForwardedPort{
+		Sessions: []Session{
 			{
-				ContainerName: "acontainer",
-				LocalAddress:  "localhost",
-				LocalPort:     20001,
-				ContainerPort: 3000,
+				PID:            100,
+				Platform:       "cluster",
+				StartTime:      startTime,
+				ForwardedPorts: []api.ForwardedPort{forwardedPort1},
+			},
+			{
+				PID:       200,
+				Platform:  "podman",
+				StartTime: startTime,
+				ForwardedPorts: []api.ForwardedPort{
+					{
+						ContainerName: "another",
+						LocalAddress:  "localhost",
+						LocalPort:     20002,
+						ContainerPort: 8080,
+					},
+				},
 			},
 		},
 	}
-	type fields struct {
-		content Content
-		fs      func(t *testing.T) filesystem.Filesystem
-	}
 	tests := []struct {
 		name    string
-		fields  fields
+		fs      func(t *testing.T) filesystem.Filesystem
 		want    []api.ForwardedPort
 		wantErr bool
 	}{
 		{
-			name: "get forwarded ports",
-			fields: fields{
-				content: Content{},
-				fs: func(t *testing.T) filesystem.Filesystem {
-					fs := filesystem.NewFakeFs()
-					jsonContent, err := json.Marshal(content1)
-					if err != nil {
-						t.Errorf("Error marshaling data")
-					}
-					err = fs.WriteFile(_filepath, jsonContent, 0644)
-					if err != nil {
-						t.Errorf("Error saving content to file")
-					}
-					return fs
-				},
+			name: "get forwarded ports of the current session",
+			fs: func(t *testing.T) filesystem.Filesystem {
+				fs := filesystem.NewFakeFs()
+				writeContent(t, fs, content1)
+				return fs
+			},
+			want:    []api.ForwardedPort{forwardedPort1},
+			wantErr: false,
+		},
+		{
+			name: "no state file",
+			fs: func(t *testing.T) filesystem.Filesystem {
+				return filesystem.NewFakeFs()
 			},
-			want:    content1.ForwardedPorts,
+			want:    nil,
 			wantErr: false,
 		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			o := &State{
-				content: tt.fields.content,
-				fs:      tt.fields.fs(t),
-			}
+			o := newFakeState(tt.fs(t), 200)
 			got, err := o.GetForwardedPorts()
 			if (err != nil) != tt.wantErr {
 				t.Errorf("State.GetForwardedPorts() error = %v, wantErr %v", err, tt.wantErr)
@@ -205,3 +419,79 @@ func TestState_GetForwardedPorts(t *testing.T) {
 		})
 	}
 }
+
+func TestState_GetSessions(t *testing.T) {
+	content1 := Content{
+		Sessions: []Session{
+			{PID: 100, Platform: "cluster", Namespace: "ns", StartTime: startTime},
+			{PID: 200, Platform: "podman", StartTime: startTime},
+			{PID: 300, Platform: "docker", StartTime: startTime},
+		},
+	}
+	fs := filesystem.NewFakeFs()
+	writeContent(t, fs, content1)
+	// process 300 is not running anymore
+	o := newFakeState(fs, 200)
+	got, err := o.GetSessions()
+	if err != nil {
+		t.Fatalf("State.GetSessions() unexpected error: %v", err)
+	}
+	want := content1.Sessions[:2]
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("State.GetSessions() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestState_concurrentUpdates(t *testing.T) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = os.Chdir(wd)
+	}()
+
+	const processes = 20
+	fs := filesystem.DefaultFs{}
+	var wg sync.WaitGroup
+	for i := 0; i < processes; i++ {
+		pid := 1000 + i
+		o := newFakeState(fs)
+		o.getpid = func() int {
+			return pid
+		}
+		o.isProcessRunning = func(int) bool {
+			return true
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if errInit := o.InitPortForward("ns"); errInit != nil {
+				t.Errorf("State.InitPortForward() error = %v", errInit)
+			}
+		}()
+	}
+	wg.Wait()
+
+	content, err := readContent(fs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(content.Sessions) != processes {
+		t.Errorf("expected %d sessions, got %d", processes, len(content.Sessions))
+	}
+}
+
+func Test_getProcessStartTime(t *testing.T) {
+	got, err := getProcessStartTime(os.Getpid())
+	if err != nil {
+		t.Skipf("start time of processes not supported: %v", err)
+	}
+	// the test process has just been started
+	if got.After(time.Now().Add(startTimeTolerance)) || time.Since(got) > time.Hour {
+		t.Errorf("unexpected start time of the current process: %v", got)
+	}
+}
@@ -1,10 +1,36 @@
 package state
 
 import (
+	"time"
+
 	"github.com/redhat-developer/odo/pkg/api"
 )
 
 type Content struct {
+	// Sessions are the odo dev sessions started from the directory, one per odo process
+	Sessions []Session `json:"sessions"`
+}
+
+// PortForwardCommand is the command of the sessions started by odo port-forward
+const PortForwardCommand = "port-forward"
+
+// Session is the state of an odo dev or odo port-forward session
+type Session struct {
+	// PID is the process ID of the odo process running the session
+	PID int `json:"pid"`
+	// Command is the odo command running the session, PortForwardCommand for odo port-forward, or empty for odo dev
+	Command string `json:"command,omitempty"`
+	// Platform is the platform the session is running on (cluster, podman or docker)
+	Platform string `json:"platform"`
+	// Namespace is the namespace the session is running in, when running on the cluster
+	Namespace string `json:"namespace,omitempty"`
+	// StartTime is the time the session was started
+	StartTime time.Time `json:"startTime"`
 	// ForwardedPorts are the ports forwarded during odo dev session
 	ForwardedPorts []api.ForwardedPort `json:"forwardedPorts"`
+	// APIServerAddress is the address of the control API of the odo dev session, if enabled.
+	// It is either a loopback address in the form host:port, or the path of a Unix socket prefixed with "unix:"
+	APIServerAddress string `json:"apiServerAddress,omitempty"`
+	// APIServerToken is the token to pass as a Bearer token in the Authorization header of the requests to the control API
+	APIServerToken string `json:"apiServerToken,omitempty"`
 }
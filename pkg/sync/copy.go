@@ -3,6 +3,7 @@ package sync
 import (
 	taro "archive/tar"
 	"bytes"
+	"compress/gzip"
 	"fmt"
 	"io"
 	"os"
@@ -10,12 +11,13 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/redhat-developer/odo/pkg/ignore"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 	"github.com/redhat-developer/odo/pkg/util"
 
 	dfutil "github.com/devfile/library/v2/pkg/util"
-	gitignore "github.com/sabhiram/go-gitignore"
 
 	"k8s.io/klog"
 )
@@ -33,12 +35,45 @@ func (a SyncClient) CopyFile(localPath string, compInfo ComponentInfo, targetPat
 	targetPath = filepath.ToSlash(targetPath)
 
 	klog.V(4).Infof("CopyFile arguments: localPath %s, dest %s, targetPath %s, copyFiles %s, globalExps %s", localPath, dest, targetPath, copyFiles, globExps)
+
+	syncMode := a.prefClient.GetSyncMode()
+	compress := a.prefClient.GetSyncCompression()
+	if compress || syncMode == preference.SyncModeDelta {
+		tools, err := a.getRemoteTools(compInfo)
+		if err != nil {
+			// the files are copied without compression nor delta
+			klog.V(2).Infof("%v", err)
+		}
+		if compress && !tools.gzip {
+			klog.V(2).Infof("gzip is not available in container %s, the files will not be compressed", compInfo.ContainerName)
+			compress = false
+		}
+		if syncMode == preference.SyncModeDelta {
+			if tools.delta {
+				nbFiles := len(copyFiles)
+				copyFiles = a.syncDeltaFiles(localPath, compInfo, targetPath, copyFiles, globExps, ret, compress)
+				if nbFiles > 0 && len(copyFiles) == 0 {
+					return nil
+				}
+			} else {
+				klog.V(2).Infof("dd, sha256sum or mktemp is not available in container %s, the complete files will be copied", compInfo.ContainerName)
+			}
+		}
+	}
+
 	reader, writer := io.Pipe()
 	// inspired from https://github.com/kubernetes/kubernetes/blob/master/pkg/kubectl/cmd/cp.go#L235
 	go func() {
 		defer writer.Close()
 
-		err := makeTar(localPath, dest, writer, copyFiles, globExps, ret, filesystem.DefaultFs{})
+		var tarWriter io.Writer = writer
+		if compress {
+			gzipWriter := gzip.NewWriter(writer)
+			defer gzipWriter.Close()
+			tarWriter = gzipWriter
+		}
+
+		err := makeTar(localPath, dest, tarWriter, copyFiles, globExps, ret, filesystem.DefaultFs{})
 		if err != nil {
 			log.Errorf("Error while creating tar: %#v", err)
 			os.Exit(1)
@@ -46,7 +81,7 @@ func (a SyncClient) CopyFile(localPath string, compInfo ComponentInfo, targetPat
 
 	}()
 
-	err := a.ExtractProjectToComponent(compInfo.ContainerName, compInfo.PodName, targetPath, reader)
+	err := a.ExtractProjectToComponent(compInfo.ContainerName, compInfo.PodName, targetPath, reader, compress)
 	if err != nil {
 		return err
 	}
@@ -54,10 +89,15 @@ func (a SyncClient) CopyFile(localPath string, compInfo ComponentInfo, targetPat
 	return nil
 }
 
-// ExtractProjectToComponent extracts the project archive(tar) to the target path from the reader stdin
-func (a SyncClient) ExtractProjectToComponent(containerName, podName string, targetPath string, stdin io.Reader) error {
+// ExtractProjectToComponent extracts the project archive(tar) to the target path from the reader stdin.
+// If compressed is true, the archive is expected to be compressed with gzip.
+func (a SyncClient) ExtractProjectToComponent(containerName, podName string, targetPath string, stdin io.Reader, compressed bool) error {
+	tarOption := "xf"
+	if compressed {
+		tarOption = "xzf"
+	}
 	// cmdArr will run inside container
-	cmdArr := []string{"tar", "xf", "-", "-C", targetPath, "--no-same-owner"}
+	cmdArr := []string{"tar", tarOption, "-", "-C", targetPath, "--no-same-owner"}
 	var stdout bytes.Buffer
 	var stderr bytes.Buffer
 	klog.V(3).Infof("Executing command %s", strings.Join(cmdArr, " "))
@@ -74,16 +114,9 @@ func (a SyncClient) ExtractProjectToComponent(containerName, podName string, tar
 	return err
 }
 
-// checkFileExist check if given file exists or not
-func checkFileExistWithFS(fileName string, fs filesystem.Filesystem) bool {
-	_, err := fs.Stat(fileName)
-	return !os.IsNotExist(err)
-}
-
 // makeTar function is copied from https://github.com/kubernetes/kubernetes/blob/master/pkg/kubectl/cmd/cp.go#L309
 // srcPath is ignored if files is set
 func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExps []string, ret util.IndexerRet, fs filesystem.Filesystem) error {
-	// TODO: use compression here?
 	tarWriter := taro.NewWriter(writer)
 	defer tarWriter.Close()
 	srcPath = filepath.Clean(srcPath)
@@ -95,7 +128,7 @@ func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExp
 	uniquePaths := make(map[string]bool)
 	klog.V(4).Infof("makeTar arguments: srcPath: %s, destPath: %s, files: %+v", srcPath, destPath, files)
 	if len(files) != 0 {
-		ignoreMatcher := gitignore.CompileIgnoreLines(globExps...)
+		ignoreMatcher := ignore.NewMatcher(globExps...)
 		for _, fileName := range files {
 
 			if _, ok := uniquePaths[fileName]; ok {
@@ -104,14 +137,14 @@ func makeTar(srcPath, destPath string, writer io.Writer, files []string, globExp
 				uniquePaths[fileName] = true
 			}
 
-			if checkFileExistWithFS(fileName, fs) {
+			if stat, err := fs.Stat(fileName); err == nil {
 
 				rel, err := filepath.Rel(srcPath, fileName)
 				if err != nil {
 					return err
 				}
 
-				matched := ignoreMatcher.MatchesPath(rel)
+				matched := ignoreMatcher.Matches(rel, stat.IsDir())
 				if matched {
 					continue
 				}
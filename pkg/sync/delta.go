@@ -0,0 +1,307 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/ignore"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+const (
+	// deltaMinFileSize is the minimal size of a file for its changes to be transferred as a delta
+	deltaMinFileSize = 1024 * 1024
+
+	// deltaBlockSize is the minimal size of the blocks compared between the local and the remote files
+	deltaBlockSize = 128 * 1024
+
+	// deltaMaxBlocks is the maximal number of blocks a file is split into; the block size is increased for larger files.
+	// The checksum of each block is computed by a separate command in the container, so the number of blocks is kept low
+	deltaMaxBlocks = 256
+)
+
+// remoteTools indicates the tools available in a container, used to transfer the files
+type remoteTools struct {
+	// gzip is true if archives can be decompressed in the container
+	gzip bool
+	// delta is true if block checksums can be computed and blocks can be written in the container
+	delta bool
+}
+
+// getRemoteToolsScript prints the names of the tools available in the container
+const getRemoteToolsScript = `for t in gzip dd sha256sum mktemp; do command -v $t >/dev/null 2>&1 && echo $t; done; exit 0`
+
+// getRemoteChecksumsScript prints the size of the file passed as first argument,
+// then the SHA-256 checksum of each of its blocks of the size passed as second argument.
+// The blocks are read one after the other from a single file descriptor, so the file is read only once.
+// It prints "nofile" if the file does not exist.
+const getRemoteChecksumsScript = `f="$1"; bs="$2"
+[ -f "$f" ] || { echo nofile; exit 0; }
+size=$(wc -c < "$f")
+echo "size $size"
+i=0
+while [ $((i * bs)) -lt "$size" ]; do
+  dd bs="$bs" count=1 2>/dev/null | sha256sum
+  i=$((i + 1))
+done < "$f"`
+
+// applyDeltaScript extracts the blocks read from stdin, named after their index, and writes them into the file
+// passed as first argument, truncates the file to the size passed as third argument, sets its mode to the fifth argument,
+// and prints the checksum of the result.
+// The fourth argument is the option passed to tar to extract the blocks.
+const applyDeltaScript = `set -e
+f="$1"; bs="$2"; size="$3"; tmp=$(mktemp -d)
+trap 'rm -rf "$tmp"' EXIT
+tar "$4" - -C "$tmp"
+for b in "$tmp"/*; do
+  [ -f "$b" ] || continue
+  dd if="$b" of="$f" bs="$bs" seek="${b##*/}" conv=notrunc 2>/dev/null
+done
+dd if=/dev/null of="$f" bs=1 seek="$size" 2>/dev/null
+chmod "$5" "$f"
+sha256sum "$f"`
+
+// getRemoteTools returns the tools available in the container to transfer the files
+func (a SyncClient) getRemoteTools(compInfo ComponentInfo) (remoteTools, error) {
+	var stdout, stderr bytes.Buffer
+	cmdArr := []string{"sh", "-c", getRemoteToolsScript}
+	err := a.platformClient.ExecCMDInContainer(compInfo.ContainerName, compInfo.PodName, cmdArr, &stdout, &stderr, nil, false)
+	if err != nil {
+		return remoteTools{}, fmt.Errorf("unable to list the tools available in the container: %w", err)
+	}
+	found := map[string]bool{}
+	for _, line := range strings.Split(stdout.String(), "\n") {
+		found[strings.TrimSpace(line)] = true
+	}
+	return remoteTools{
+		gzip:  found["gzip"],
+		delta: found["dd"] && found["sha256sum"] && found["mktemp"],
+	}, nil
+}
+
+// getDeltaBlockSize returns the size of the blocks to compare for a file of the given size
+func getDeltaBlockSize(size int64) int64 {
+	blockSize := int64(deltaBlockSize)
+	for size/blockSize >= deltaMaxBlocks {
+		blockSize *= 2
+	}
+	return blockSize
+}
+
+// getLocalChecksums returns the SHA-256 checksums of the blocks of the local file, and the checksum of the complete file
+func getLocalChecksums(path string, blockSize int64) ([]string, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, "", err
+	}
+	defer file.Close() // #nosec G307
+
+	var checksums []string
+	fileHash := sha256.New()
+	buf := make([]byte, blockSize)
+	for {
+		n, err := io.ReadFull(file, buf)
+		if n > 0 {
+			blockHash := sha256.Sum256(buf[:n])
+			checksums = append(checksums, hex.EncodeToString(blockHash[:]))
+			fileHash.Write(buf[:n])
+		}
+		if err == io.EOF || err == io.ErrUnexpectedEOF {
+			break
+		}
+		if err != nil {
+			return nil, "", err
+		}
+	}
+	return checksums, hex.EncodeToString(fileHash.Sum(nil)), nil
+}
+
+// getRemoteChecksums returns the size of the remote file and the SHA-256 checksums of its blocks.
+// It returns false if the file does not exist in the container.
+func (a SyncClient) getRemoteChecksums(compInfo ComponentInfo, remotePath string, blockSize int64) (bool, int64, []string, error) {
+	var stdout, stderr bytes.Buffer
+	cmdArr := []string{"sh", "-c", getRemoteChecksumsScript, "sh", remotePath, strconv.FormatInt(blockSize, 10)}
+	err := a.platformClient.ExecCMDInContainer(compInfo.ContainerName, compInfo.PodName, cmdArr, &stdout, &stderr, nil, false)
+	if err != nil {
+		return false, 0, nil, fmt.Errorf("unable to compute the checksums of %s in the container: %w", remotePath, err)
+	}
+	return parseRemoteChecksums(stdout.String())
+}
+
+// parseRemoteChecksums parses the output of getRemoteChecksumsScript
+func parseRemoteChecksums(output string) (bool, int64, []string, error) {
+	var (
+		size      int64
+		checksums []string
+	)
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		switch fields[0] {
+		case "nofile":
+			return false, 0, nil, nil
+		case "size":
+			if len(fields) != 2 {
+				return false, 0, nil, fmt.Errorf("unexpected output %q", scanner.Text())
+			}
+			var err error
+			size, err = strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return false, 0, nil, fmt.Errorf("unexpected output %q: %w", scanner.Text(), err)
+			}
+		default:
+			checksums = append(checksums, fields[0])
+		}
+	}
+	return true, size, checksums, scanner.Err()
+}
+
+// getChangedBlocks returns the indexes of the local blocks which are different from the remote blocks
+func getChangedBlocks(local []string, remote []string) []int64 {
+	var result []int64
+	for i, checksum := range local {
+		if i >= len(remote) || remote[i] != checksum {
+			result = append(result, int64(i))
+		}
+	}
+	return result
+}
+
+// makeDeltaTar writes to writer an archive containing the given blocks of the local file, named after their index
+func makeDeltaTar(localPath string, blockSize int64, blocks []int64, writer io.Writer) error {
+	file, err := os.Open(localPath)
+	if err != nil {
+		return err
+	}
+	defer file.Close() // #nosec G307
+
+	tarWriter := taro.NewWriter(writer)
+	buf := make([]byte, blockSize)
+	for _, block := range blocks {
+		n, err := file.ReadAt(buf, block*blockSize)
+		if err != nil && err != io.EOF {
+			return err
+		}
+		hdr := &taro.Header{
+			Name: strconv.FormatInt(block, 10),
+			Mode: 0600,
+			Size: int64(n),
+		}
+		if err = tarWriter.WriteHeader(hdr); err != nil {
+			return err
+		}
+		if _, err = tarWriter.Write(buf[:n]); err != nil {
+			return err
+		}
+	}
+	return tarWriter.Close()
+}
+
+// syncDelta transfers the blocks of the local file which differ from the remote file.
+// It returns false if the delta could not be applied, and the file needs to be copied completely.
+func (a SyncClient) syncDelta(compInfo ComponentInfo, localPath string, remotePath string, compress bool) (bool, error) {
+	stat, err := os.Stat(localPath)
+	if err != nil {
+		return false, err
+	}
+	blockSize := getDeltaBlockSize(stat.Size())
+
+	exists, _, remoteChecksums, err := a.getRemoteChecksums(compInfo, remotePath, blockSize)
+	if err != nil || !exists {
+		return false, err
+	}
+
+	localChecksums, fileChecksum, err := getLocalChecksums(localPath, blockSize)
+	if err != nil {
+		return false, err
+	}
+	blocks := getChangedBlocks(localChecksums, remoteChecksums)
+	klog.V(4).Infof("Transferring %d/%d blocks of %s", len(blocks), len(localChecksums), localPath)
+
+	tarOption := "xf"
+	if compress {
+		tarOption = "xzf"
+	}
+	reader, writer := io.Pipe()
+	go func() {
+		var w io.WriteCloser = writer
+		if compress {
+			w = gzip.NewWriter(writer)
+		}
+		err := makeDeltaTar(localPath, blockSize, blocks, w)
+		if err == nil && compress {
+			err = w.Close()
+		}
+		_ = writer.CloseWithError(err)
+	}()
+
+	var stdout, stderr bytes.Buffer
+	cmdArr := []string{"sh", "-c", applyDeltaScript, "sh", remotePath,
+		strconv.FormatInt(blockSize, 10), strconv.FormatInt(stat.Size(), 10), tarOption,
+		// the mode is set as it would be when copying the complete file
+		strconv.FormatUint(uint64(stat.Mode().Perm()), 8)}
+	err = a.platformClient.ExecCMDInContainer(compInfo.ContainerName, compInfo.PodName, cmdArr, &stdout, &stderr, reader, false)
+	_ = reader.Close()
+	if err != nil {
+		return false, fmt.Errorf("unable to apply the changes to %s in the container: %w: %s", remotePath, err, stderr.String())
+	}
+
+	// check that the remote file is identical to the local file, or else copy it completely
+	fields := strings.Fields(stdout.String())
+	if len(fields) == 0 || fields[0] != fileChecksum {
+		klog.V(4).Infof("Checksum of %s in the container does not match the local file", remotePath)
+		return false, nil
+	}
+	return true, nil
+}
+
+// syncDeltaFiles transfers the changes of the large files of copyFiles already present in the container as deltas.
+// It returns the files which still need to be copied completely.
+func (a SyncClient) syncDeltaFiles(localPath string, compInfo ComponentInfo, targetPath string, copyFiles []string, globExps []string, ret util.IndexerRet, compress bool) []string {
+	ignoreMatcher := ignore.NewMatcher(globExps...)
+	var remaining []string
+	for _, fileName := range copyFiles {
+		stat, err := os.Stat(fileName)
+		if err != nil || !stat.Mode().IsRegular() || stat.Size() < deltaMinFileSize {
+			remaining = append(remaining, fileName)
+			continue
+		}
+
+		rel, err := filepath.Rel(localPath, fileName)
+		if err != nil || ignoreMatcher.Matches(rel, false) {
+			// the file will be handled (or ignored) when creating the archive
+			remaining = append(remaining, fileName)
+			continue
+		}
+
+		destFile := rel
+		if value, ok := ret.NewFileMap[rel]; ok && value.RemoteAttribute != "" {
+			destFile = value.RemoteAttribute
+		}
+		remotePath := targetPath + "/" + filepath.ToSlash(destFile)
+
+		applied, err := a.syncDelta(compInfo, fileName, remotePath, compress)
+		if err != nil {
+			klog.V(2).Infof("Unable to transfer the changes of %s, copying the complete file: %v", fileName, err)
+		}
+		if !applied {
+			remaining = append(remaining, fileName)
+		}
+	}
+	return remaining
+}
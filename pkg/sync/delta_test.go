@@ -0,0 +1,258 @@
+package sync
+
+import (
+	"bytes"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+)
+
+func Test_getDeltaBlockSize(t *testing.T) {
+	tests := []struct {
+		name string
+		size int64
+		want int64
+	}{
+		{
+			name: "small file",
+			size: 2 * deltaMinFileSize,
+			want: deltaBlockSize,
+		},
+		{
+			name: "file with the maximal number of blocks",
+			size: deltaBlockSize*deltaMaxBlocks - 1,
+			want: deltaBlockSize,
+		},
+		{
+			name: "large file",
+			size: 4 * deltaBlockSize * deltaMaxBlocks,
+			want: 8 * deltaBlockSize,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := getDeltaBlockSize(tt.size); got != tt.want {
+				t.Errorf("getDeltaBlockSize() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func Test_parseRemoteChecksums(t *testing.T) {
+	tests := []struct {
+		name          string
+		output        string
+		wantExists    bool
+		wantSize      int64
+		wantChecksums []string
+		wantErr       bool
+	}{
+		{
+			name:       "file does not exist",
+			output:     "nofile\n",
+			wantExists: false,
+		},
+		{
+			name:       "empty file",
+			output:     "size 0\n",
+			wantExists: true,
+		},
+		{
+			name:          "file with blocks",
+			output:        "size 10\nabcd  -\nef01  -\n",
+			wantExists:    true,
+			wantSize:      10,
+			wantChecksums: []string{"abcd", "ef01"},
+		},
+		{
+			name:    "invalid size",
+			output:  "size ten\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			exists, size, checksums, err := parseRemoteChecksums(tt.output)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("parseRemoteChecksums() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if exists != tt.wantExists {
+				t.Errorf("parseRemoteChecksums() exists = %v, want %v", exists, tt.wantExists)
+			}
+			if size != tt.wantSize {
+				t.Errorf("parseRemoteChecksums() size = %v, want %v", size, tt.wantSize)
+			}
+			if diff := cmp.Diff(tt.wantChecksums, checksums); diff != "" {
+				t.Errorf("parseRemoteChecksums() checksums mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_getChangedBlocks(t *testing.T) {
+	tests := []struct {
+		name   string
+		local  []string
+		remote []string
+		want   []int64
+	}{
+		{
+			name:   "identical files",
+			local:  []string{"a", "b"},
+			remote: []string{"a", "b"},
+		},
+		{
+			name:   "block modified",
+			local:  []string{"a", "c", "d"},
+			remote: []string{"a", "b", "d"},
+			want:   []int64{1},
+		},
+		{
+			name:   "blocks appended",
+			local:  []string{"a", "b", "c"},
+			remote: []string{"a"},
+			want:   []int64{1, 2},
+		},
+		{
+			name:   "file truncated",
+			local:  []string{"a"},
+			remote: []string{"a", "b"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := getChangedBlocks(tt.local, tt.remote)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("getChangedBlocks() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// execLocally runs the commands passed to ExecCMDInContainer on the local machine
+func execLocally(containerName, podName string, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+	c := exec.Command(cmd[0], cmd[1:]...) // #nosec G204
+	c.Stdin = stdin
+	c.Stdout = stdout
+	c.Stderr = stderr
+	return c.Run()
+}
+
+func TestSyncClient_syncDelta(t *testing.T) {
+	for _, tool := range []string{"sh", "dd", "sha256sum", "mktemp", "gzip", "tar"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Skipf("%s is required to run this test", tool)
+		}
+	}
+
+	content := make([]byte, 3*deltaMinFileSize+100)
+	for i := range content {
+		content[i] = byte(i % 251)
+	}
+	modified := func(f func([]byte) []byte) []byte {
+		return f(append([]byte{}, content...))
+	}
+
+	tests := []struct {
+		name          string
+		remoteContent []byte
+		compress      bool
+		wantApplied   bool
+	}{
+		{
+			name:          "identical files",
+			remoteContent: content,
+			wantApplied:   true,
+		},
+		{
+			name: "remote block modified",
+			remoteContent: modified(func(b []byte) []byte {
+				b[deltaBlockSize+10] = 0
+				return b
+			}),
+			wantApplied: true,
+		},
+		{
+			name: "remote block modified with compression",
+			remoteContent: modified(func(b []byte) []byte {
+				b[2*deltaBlockSize+10] = 0
+				return b
+			}),
+			compress:    true,
+			wantApplied: true,
+		},
+		{
+			name: "remote file shorter",
+			remoteContent: modified(func(b []byte) []byte {
+				return b[:deltaMinFileSize+5]
+			}),
+			wantApplied: true,
+		},
+		{
+			name: "remote file longer",
+			remoteContent: modified(func(b []byte) []byte {
+				return append(b, bytes.Repeat([]byte("x"), deltaBlockSize+1)...)
+			}),
+			wantApplied: true,
+		},
+		{
+			name:        "remote file missing",
+			wantApplied: false,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			localPath := filepath.Join(t.TempDir(), "file.bin")
+			if err := os.WriteFile(localPath, content, 0600); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.Chmod(localPath, 0750); err != nil {
+				t.Fatal(err)
+			}
+			remotePath := filepath.Join(t.TempDir(), "file.bin")
+			if tt.remoteContent != nil {
+				if err := os.WriteFile(remotePath, tt.remoteContent, 0600); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			ctrl := gomock.NewController(t)
+			kc := kclient.NewMockClientInterface(ctrl)
+			kc.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				DoAndReturn(execLocally).AnyTimes()
+
+			a := NewSyncClient(kc, nil, nil)
+			applied, err := a.syncDelta(ComponentInfo{}, localPath, remotePath, tt.compress)
+			if err != nil {
+				t.Fatalf("syncDelta() error = %v", err)
+			}
+			if applied != tt.wantApplied {
+				t.Fatalf("syncDelta() = %v, want %v", applied, tt.wantApplied)
+			}
+			if !applied {
+				return
+			}
+			got, err := os.ReadFile(remotePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !bytes.Equal(got, content) {
+				t.Errorf("remote file differs from local file after syncDelta()")
+			}
+			remoteStat, err := os.Stat(remotePath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if remoteStat.Mode().Perm() != 0750 {
+				t.Errorf("remote file mode = %v, want %v", remoteStat.Mode().Perm(), os.FileMode(0750))
+			}
+		})
+	}
+}
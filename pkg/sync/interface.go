@@ -24,6 +24,22 @@ type SyncParameters struct {
 	Files                    map[string]string
 }
 
+// SyncBackParameters is a struct containing the parameters to be used when syncing back files from the container of a devfile component
+type SyncBackParameters struct {
+	Path         string   // Path refers to the parent folder containing the source code of the component
+	Paths        []string // Paths are the files and directories to sync back, relative to Path and to the sync folder of the container
+	IgnoredFiles []string // IgnoredFiles is the list of files not pushed to the component
+	CompInfo     ComponentInfo
+}
+
+// SyncBackResult contains the result of syncing back files from the container of a devfile component
+type SyncBackResult struct {
+	FilesChanged []string // FilesChanged are the files copied from the container, relative to the directory of the component
+	Conflicts    []string // Conflicts are the files changed both locally and in the container, which have not been copied
+}
+
 type Client interface {
 	SyncFiles(syncParameters SyncParameters) (bool, error)
+	// SyncBack copies the files changed in the container back into the local directory
+	SyncBack(syncBackParameters SyncBackParameters) (SyncBackResult, error)
 }
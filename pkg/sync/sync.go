@@ -10,7 +10,10 @@ import (
 	dfutil "github.com/devfile/library/v2/pkg/util"
 
 	"github.com/redhat-developer/odo/pkg/exec"
+	"github.com/redhat-developer/odo/pkg/ignore"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	"github.com/redhat-developer/odo/pkg/platform"
+	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/util"
 
 	"k8s.io/klog"
@@ -20,15 +23,17 @@ import (
 type SyncClient struct {
 	platformClient platform.Client
 	execClient     exec.Client
+	prefClient     preference.Client
 }
 
 var _ Client = (*SyncClient)(nil)
 
 // NewSyncClient instantiates a new SyncClient
-func NewSyncClient(platformClient platform.Client, execClient exec.Client) *SyncClient {
+func NewSyncClient(platformClient platform.Client, execClient exec.Client, prefClient preference.Client) *SyncClient {
 	return &SyncClient{
 		platformClient: platformClient,
 		execClient:     execClient,
+		prefClient:     prefClient,
 	}
 }
 
@@ -72,18 +77,22 @@ func (a SyncClient) SyncFiles(syncParameters SyncParameters) (bool, error) {
 	// changed files into the existing file index, and delete removed files from the index
 	if isWatch && !syncParameters.DevfileScanIndexForWatch {
 
-		err := updateIndexWithWatchChanges(syncParameters)
+		var err error
+		changedFiles, err = updateIndexWithWatchChanges(syncParameters)
 
 		if err != nil {
 			return false, err
 		}
 
-		changedFiles = syncParameters.WatchFiles
 		deletedFiles = syncParameters.WatchDeletedFiles
 		deletedFiles, err = dfutil.RemoveRelativePathFromFiles(deletedFiles, syncParameters.Path)
 		if err != nil {
 			return false, fmt.Errorf("unable to remove relative path from list of changed/deleted files: %w", err)
 		}
+		if len(changedFiles) == 0 && len(deletedFiles) == 0 {
+			klog.V(4).Infof("Content of the changed files is unchanged, no sync required")
+			return false, nil
+		}
 		indexRegeneratedByWatch = true
 
 	}
@@ -92,8 +101,6 @@ func (a SyncClient) SyncFiles(syncParameters SyncParameters) (bool, error) {
 		// Calculate the files to sync
 		// Tries to sync the deltas unless it is a forced push
 		// if it is a forced push (ForcePush) reset the index to do a full sync
-		absIgnoreRules := dfutil.GetAbsGlobExps(syncParameters.Path, syncParameters.IgnoredFiles)
-
 		// Before running the indexer, make sure the .odo folder exists (or else the index file will not get created)
 		odoFolder := filepath.Join(syncParameters.Path, ".odo")
 		if _, err := os.Stat(odoFolder); os.IsNotExist(err) {
@@ -121,13 +128,15 @@ func (a SyncClient) SyncFiles(syncParameters SyncParameters) (bool, error) {
 			return false, fmt.Errorf("unable to run indexer: %w", err)
 		}
 
-		if len(ret.FilesChanged) > 0 || len(ret.FilesDeleted) > 0 {
+		if len(ret.FilesChanged) > 0 || len(ret.FilesDeleted) > 0 || ret.IndexOutdated {
 			forceWrite = true
 		}
 
-		// apply the glob rules from the .gitignore/.odoignore file
+		// apply the rules from the .gitignore/.odoignore files
 		// and ignore the files on which the rules apply and filter them out
-		filesChangedFiltered, filesDeletedFiltered := dfutil.FilterIgnores(ret.FilesChanged, ret.FilesDeleted, absIgnoreRules)
+		ignoreMatcher := ignore.NewMatcher(syncParameters.IgnoredFiles...)
+		filesChangedFiltered := filterIgnores(syncParameters.Path, ret.FilesChanged, ignoreMatcher)
+		filesDeletedFiltered := filterIgnores(syncParameters.Path, ret.FilesDeleted, ignoreMatcher)
 
 		deletedFiles = append(filesDeletedFiltered, ret.RemoteDeleted...)
 		deletedFiles = append(deletedFiles, ret.RemoteDeleted...)
@@ -136,6 +145,14 @@ func (a SyncClient) SyncFiles(syncParameters SyncParameters) (bool, error) {
 		klog.V(4).Infof("List of files changed: +%v", changedFiles)
 
 		if len(filesChangedFiltered) == 0 && len(filesDeletedFiltered) == 0 && !syncParameters.ForcePush {
+			if forceWrite {
+				// Update the index even if no file needs to be synced, for example with the new modification dates
+				// of files whose content did not change
+				err = util.WriteFile(ret.NewFileMap, ret.ResolvedPath)
+				if err != nil {
+					return false, fmt.Errorf("failed to write file: %w", err)
+				}
+			}
 			return false, nil
 		}
 
@@ -162,9 +179,50 @@ func (a SyncClient) SyncFiles(syncParameters SyncParameters) (bool, error) {
 		}
 	}
 
+	machineoutput.NewMachineEventLoggingClient().FilesSynced(
+		getRelativePaths(syncParameters.Path, changedFiles),
+		getRelativePaths(syncParameters.Path, deletedFiles),
+		machineoutput.TimestampNow(),
+	)
+
 	return true, nil
 }
 
+// filterIgnores returns the files, either absolute or relative to the path directory, which are not ignored by the matcher
+func filterIgnores(path string, files []string, ignoreMatcher *ignore.Matcher) []string {
+	var result []string
+	for _, file := range files {
+		rel := file
+		if filepath.IsAbs(file) {
+			var err error
+			rel, err = filepath.Rel(path, file)
+			if err != nil {
+				continue
+			}
+		}
+		stat, err := os.Stat(filepath.Join(path, rel))
+		if ignoreMatcher.Matches(rel, err == nil && stat.IsDir()) {
+			continue
+		}
+		result = append(result, file)
+	}
+	return result
+}
+
+// getRelativePaths returns the paths relative to the path directory, using slashes as separators
+func getRelativePaths(path string, paths []string) []string {
+	result := make([]string, 0, len(paths))
+	for _, p := range paths {
+		if filepath.IsAbs(p) {
+			if rel, err := filepath.Rel(path, p); err == nil {
+				p = rel
+			}
+		}
+		result = append(result, filepath.ToSlash(p))
+	}
+	return result
+}
+
 // pushLocal syncs source code from the user's disk to the component
 func (a SyncClient) pushLocal(path string, files []string, delFiles []string, isForcePush bool, globExps []string, compInfo ComponentInfo, ret util.IndexerRet) error {
 	klog.V(4).Infof("Push: componentName: %s, path: %s, files: %s, delFiles: %s, isForcePush: %+v", compInfo.ComponentName, path, files, delFiles, isForcePush)
@@ -219,11 +277,12 @@ func (a SyncClient) pushLocal(path string, files []string, delFiles []string, is
 
 // updateIndexWithWatchChanges uses the pushParameters.WatchDeletedFiles and pushParamters.WatchFiles to update
 // the existing index file; the index file is required to exist when this function is called.
-func updateIndexWithWatchChanges(syncParameters SyncParameters) error {
+// It returns the files of pushParameters.WatchFiles to sync, excluding the files whose content did not change.
+func updateIndexWithWatchChanges(syncParameters SyncParameters) ([]string, error) {
 	indexFilePath, err := util.ResolveIndexFilePath(syncParameters.Path)
 
 	if err != nil {
-		return fmt.Errorf("unable to resolve path: %s: %w", syncParameters.Path, err)
+		return nil, fmt.Errorf("unable to resolve path: %s: %w", syncParameters.Path, err)
 	}
 
 	// Check that the path exists
@@ -235,13 +294,13 @@ func updateIndexWithWatchChanges(syncParameters SyncParameters) error {
 		//
 		// If you see this error it means somehow watch's SyncFiles was called without the index being first generated (likely because the
 		// above mentioned pushParam wasn't set). See SyncFiles(...) for details.
-		return fmt.Errorf("resolved path doesn't exist: %s: %w", indexFilePath, err)
+		return nil, fmt.Errorf("resolved path doesn't exist: %s: %w", indexFilePath, err)
 	}
 
 	// Parse the existing index
 	fileIndex, err := util.ReadFileIndex(indexFilePath)
 	if err != nil {
-		return fmt.Errorf("unable to read index from path: %s: %w", indexFilePath, err)
+		return nil, fmt.Errorf("unable to read index from path: %s: %w", indexFilePath, err)
 	}
 
 	rootDir := syncParameters.Path
@@ -260,19 +319,26 @@ func updateIndexWithWatchChanges(syncParameters SyncParameters) error {
 	}
 
 	// Add changed files to the existing index
+	var changedFiles []string
 	for _, addedOrModifiedFile := range syncParameters.WatchFiles {
 		relativePath, fileData, err := util.GenerateNewFileDataEntry(addedOrModifiedFile, rootDir)
 
 		if err != nil {
 			klog.V(4).Infof("Error occurred for %s: %v", addedOrModifiedFile, err)
+			changedFiles = append(changedFiles, addedOrModifiedFile)
 			continue
 		}
+		if existing, ok := fileIndex.Files[relativePath]; ok && existing.Hash != "" && existing.Hash == fileData.Hash {
+			klog.V(4).Infof("Content of watched file unchanged: %s", relativePath)
+		} else {
+			changedFiles = append(changedFiles, addedOrModifiedFile)
+		}
 		fileIndex.Files[relativePath] = *fileData
 		klog.V(4).Infof("Added/updated watched file in index: %s", relativePath)
 	}
 
 	// Write the result
-	return util.WriteFile(fileIndex.Files, indexFilePath)
+	return changedFiles, util.WriteFile(fileIndex.Files, indexFilePath)
 
 }
 
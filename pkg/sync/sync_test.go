@@ -15,6 +15,7 @@ import (
 
 	"github.com/redhat-developer/odo/pkg/exec"
 	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/preference"
 	"github.com/redhat-developer/odo/pkg/util"
 	"github.com/redhat-developer/odo/tests/helper"
 )
@@ -96,6 +97,9 @@ func TestSyncFiles(t *testing.T) {
 	kc := kclient.NewMockClientInterface(ctrl)
 	kc.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).AnyTimes()
+	prefClient := preference.NewMockClient(ctrl)
+	prefClient.EXPECT().GetSyncMode().Return(preference.SyncModeFull).AnyTimes()
+	prefClient.EXPECT().GetSyncCompression().Return(false).AnyTimes()
 
 	// Assert that Bar() is invoked.
 	defer ctrl.Finish()
@@ -171,7 +175,7 @@ func TestSyncFiles(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			execClient := exec.NewExecClient(kc)
-			syncAdapter := NewSyncClient(kc, execClient)
+			syncAdapter := NewSyncClient(kc, execClient, prefClient)
 			isPushRequired, err := syncAdapter.SyncFiles(tt.syncParameters)
 			if !tt.wantErr && err != nil {
 				t.Errorf("TestSyncFiles error: unexpected error when syncing files %v", err)
@@ -203,6 +207,9 @@ func TestPushLocal(t *testing.T) {
 	kc := kclient.NewMockClientInterface(ctrl)
 	kc.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 		Return(nil).AnyTimes()
+	prefClient := preference.NewMockClient(ctrl)
+	prefClient.EXPECT().GetSyncMode().Return(preference.SyncModeFull).AnyTimes()
+	prefClient.EXPECT().GetSyncCompression().Return(false).AnyTimes()
 
 	// Assert that Bar() is invoked.
 	defer ctrl.Finish()
@@ -303,7 +310,7 @@ func TestPushLocal(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			execClient := exec.NewExecClient(kc)
-			syncAdapter := NewSyncClient(kc, execClient)
+			syncAdapter := NewSyncClient(kc, execClient, prefClient)
 			err := syncAdapter.pushLocal(tt.path, tt.files, tt.delFiles, tt.isForcePush, []string{}, tt.compInfo, util.IndexerRet{})
 			if !tt.wantErr && err != nil {
 				t.Errorf("TestPushLocal error: error pushing files: %v", err)
@@ -320,7 +327,9 @@ func TestUpdateIndexWithWatchChanges(t *testing.T) {
 		initialFilesToCreate []string
 		watchDeletedFiles    []string
 		watchAddedFiles      []string
+		watchModifiedFiles   []string
 		expectedFilesInIndex []string
+		expectedChangedFiles []string
 	}{
 		{
 			name:                 "Case 1 - Watch file deleted should remove file from index",
@@ -333,12 +342,26 @@ func TestUpdateIndexWithWatchChanges(t *testing.T) {
 			initialFilesToCreate: []string{"file1"},
 			watchAddedFiles:      []string{"file2"},
 			expectedFilesInIndex: []string{"file1", "file2"},
+			expectedChangedFiles: []string{"file2"},
 		},
 		{
 			name:                 "Case 3 - No watch changes should mean no index changes",
 			initialFilesToCreate: []string{"file1"},
 			expectedFilesInIndex: []string{"file1"},
 		},
+		{
+			name:                 "Case 4 - Watch file written with the same content should not be synced",
+			initialFilesToCreate: []string{"file1", "file2"},
+			watchAddedFiles:      []string{"file1"},
+			expectedFilesInIndex: []string{"file1", "file2"},
+		},
+		{
+			name:                 "Case 5 - Watch file with a new content should be synced",
+			initialFilesToCreate: []string{"file1", "file2"},
+			watchModifiedFiles:   []string{"file1"},
+			expectedFilesInIndex: []string{"file1", "file2"},
+			expectedChangedFiles: []string{"file1"},
+		},
 	}
 	for _, tt := range tests {
 
@@ -402,9 +425,27 @@ func TestUpdateIndexWithWatchChanges(t *testing.T) {
 				}
 			}
 
-			if err := updateIndexWithWatchChanges(syncParams); err != nil {
+			// Add modified files to pushParams (also modify the files)
+			for _, modifiedFile := range tt.watchModifiedFiles {
+				modifiedFilePath := filepath.Join(directory, modifiedFile)
+				syncParams.WatchFiles = append(syncParams.WatchFiles, modifiedFilePath)
+
+				if err := ioutil.WriteFile(modifiedFilePath, []byte("modified-content"), 0644); err != nil {
+					t.Fatalf("TestUpdateIndexWithWatchChangesLocal error: unable to write to index file path: %v", err)
+				}
+			}
+
+			changedFiles, err := updateIndexWithWatchChanges(syncParams)
+			if err != nil {
 				t.Fatalf("TestUpdateIndexWithWatchChangesLocal: unexpected error: %v", err)
 			}
+			var changedFileNames []string
+			for _, changedFile := range changedFiles {
+				changedFileNames = append(changedFileNames, filepath.Base(changedFile))
+			}
+			if diff := cmp.Diff(tt.expectedChangedFiles, changedFileNames); diff != "" {
+				t.Errorf("updateIndexWithWatchChanges() changed files mismatch (-want +got):\n%s", diff)
+			}
 
 			postFileIndex, err := util.ReadFileIndex(fileIndexPath)
 			if err != nil || postFileIndex == nil {
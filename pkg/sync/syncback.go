@@ -0,0 +1,215 @@
+package sync
+
+import (
+	taro "archive/tar"
+	"bufio"
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	dfutil "github.com/devfile/library/v2/pkg/util"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/ignore"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+// getRemoteHashesScript prints the SHA-256 checksums of the regular files under the paths passed as arguments,
+// relative to the directory passed as first argument
+const getRemoteHashesScript = `cd "$1" || exit 1
+shift
+command -v sha256sum >/dev/null 2>&1 || { echo "sha256sum is not available in the container" >&2; exit 1; }
+for p in "$@"; do
+  [ -e "$p" ] && find "$p" -type f -exec sha256sum {} +
+done
+exit 0`
+
+// SyncBack copies the files changed in the container under syncBackParameters.Paths back into the local directory,
+// and updates the index of the local directory so that these files are not synced again into the container.
+// A file changed in the container is copied if the local file did not change since it was last indexed, or does not exist
+// and is not indexed; otherwise, it is reported as a conflict and not copied. The ignored files are never synced into
+// the container: they are indexed when they are copied from the container, and they are in conflict if they exist locally
+// and are not indexed, or differ from the indexed version.
+// The files deleted in the container are not deleted locally.
+func (a SyncClient) SyncBack(syncBackParameters SyncBackParameters) (SyncBackResult, error) {
+	var result SyncBackResult
+
+	paths, err := cleanSyncBackPaths(syncBackParameters.Paths)
+	if err != nil {
+		return result, err
+	}
+	if len(paths) == 0 {
+		return result, nil
+	}
+
+	remoteHashes, err := a.getRemoteHashes(syncBackParameters.CompInfo, paths)
+	if err != nil {
+		return result, err
+	}
+
+	indexFilePath, err := util.ResolveIndexFilePath(syncBackParameters.Path)
+	if err != nil {
+		return result, fmt.Errorf("unable to resolve path: %s: %w", syncBackParameters.Path, err)
+	}
+	fileIndex, err := util.ReadFileIndex(indexFilePath)
+	if err != nil {
+		return result, fmt.Errorf("unable to read index from path: %s: %w", indexFilePath, err)
+	}
+
+	ignoreMatcher := ignore.NewMatcher(syncBackParameters.IgnoredFiles...)
+	var toIndex []string
+	for _, relPath := range dfutil.GetSortedKeys(remoteHashes) {
+		remoteHash := remoteHashes[relPath]
+		localHash, err := util.HashFile(filepath.Join(syncBackParameters.Path, filepath.FromSlash(relPath)))
+		if err != nil && !errors.Is(err, os.ErrNotExist) {
+			return result, err
+		}
+		ignored := ignoreMatcher.Matches(relPath, false)
+		indexed, inIndex := fileIndex.Files[filepath.FromSlash(relPath)]
+
+		switch {
+		case localHash == remoteHash:
+			// already identical, make sure the index is up to date
+			if !inIndex || indexed.Hash != remoteHash {
+				toIndex = append(toIndex, relPath)
+			}
+		case !ignored && inIndex && indexed.Hash == remoteHash:
+			// the file changed locally only, it will be synced into the container
+		case inIndex && indexed.Hash == localHash, !inIndex && localHash == "":
+			result.FilesChanged = append(result.FilesChanged, relPath)
+			toIndex = append(toIndex, relPath)
+		default:
+			result.Conflicts = append(result.Conflicts, relPath)
+		}
+	}
+
+	if len(result.FilesChanged) > 0 {
+		klog.V(4).Infof("Copying files %s from the container", strings.Join(result.FilesChanged, " "))
+		err = a.copyFilesFromContainer(syncBackParameters.CompInfo, syncBackParameters.Path, result.FilesChanged)
+		if err != nil {
+			return SyncBackResult{}, err
+		}
+	}
+
+	if len(toIndex) == 0 {
+		return result, nil
+	}
+	for _, relPath := range toIndex {
+		key, fileData, err := util.GenerateNewFileDataEntry(filepath.Join(syncBackParameters.Path, filepath.FromSlash(relPath)), syncBackParameters.Path)
+		if err != nil {
+			return result, err
+		}
+		fileIndex.Files[key] = *fileData
+	}
+	return result, util.WriteFile(fileIndex.Files, indexFilePath)
+}
+
+// cleanSyncBackPaths returns the paths to sync back, relative to the sync folder and using slashes as separators.
+// It returns an error if a path is outside of the sync folder.
+func cleanSyncBackPaths(paths []string) ([]string, error) {
+	var result []string
+	for _, p := range paths {
+		cleaned := path.Clean(filepath.ToSlash(p))
+		if path.IsAbs(cleaned) || cleaned == ".." || strings.HasPrefix(cleaned, "../") {
+			return nil, fmt.Errorf("path %q to sync back must be relative to the directory of the component", p)
+		}
+		result = append(result, cleaned)
+	}
+	return result, nil
+}
+
+// getRemoteHashes returns the SHA-256 checksums of the regular files under the paths in the sync folder of the container,
+// indexed by their path relative to the sync folder
+func (a SyncClient) getRemoteHashes(compInfo ComponentInfo, paths []string) (map[string]string, error) {
+	var stdout, stderr bytes.Buffer
+	cmdArr := append([]string{"sh", "-c", getRemoteHashesScript, "sh", compInfo.SyncFolder}, paths...)
+	err := a.platformClient.ExecCMDInContainer(compInfo.ContainerName, compInfo.PodName, cmdArr, &stdout, &stderr, nil, false)
+	if err != nil {
+		return nil, fmt.Errorf("unable to list the files to sync back from the container: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return parseRemoteHashes(stdout.String()), nil
+}
+
+// parseRemoteHashes parses the output of sha256sum, and returns the checksums indexed by file path
+func parseRemoteHashes(output string) map[string]string {
+	result := map[string]string{}
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		line := scanner.Text()
+		// sha256sum escapes the lines of file names containing special characters with a backslash, they are ignored
+		if strings.HasPrefix(line, "\\") {
+			continue
+		}
+		hash, file, found := strings.Cut(line, "  ")
+		if !found {
+			continue
+		}
+		result[path.Clean(file)] = hash
+	}
+	return result
+}
+
+// copyFilesFromContainer copies the files, relative to the sync folder of the container, into the local directory
+func (a SyncClient) copyFilesFromContainer(compInfo ComponentInfo, localPath string, files []string) error {
+	reader, writer := io.Pipe()
+	var stderr bytes.Buffer
+	go func() {
+		cmdArr := append([]string{"tar", "cf", "-", "-C", compInfo.SyncFolder, "--"}, files...)
+		err := a.platformClient.ExecCMDInContainer(compInfo.ContainerName, compInfo.PodName, cmdArr, writer, &stderr, nil, false)
+		_ = writer.CloseWithError(err)
+	}()
+
+	err := extractFiles(reader, localPath, files)
+	// unblock the command if the extraction stopped early
+	_ = reader.CloseWithError(err)
+	if err != nil {
+		return fmt.Errorf("unable to copy the files from the container: %w: %s", err, strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// extractFiles extracts the regular files of the archive which are part of files into localPath
+func extractFiles(reader io.Reader, localPath string, files []string) error {
+	expected := map[string]bool{}
+	for _, file := range files {
+		expected[file] = true
+	}
+
+	tarReader := taro.NewReader(reader)
+	for {
+		hdr, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		name := path.Clean(hdr.Name)
+		if hdr.Typeflag != taro.TypeReg || !expected[name] {
+			klog.V(4).Infof("Ignoring %s from the archive", hdr.Name)
+			continue
+		}
+
+		target := filepath.Join(localPath, filepath.FromSlash(name))
+		if err = os.MkdirAll(filepath.Dir(target), 0750); err != nil {
+			return err
+		}
+		file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(hdr.Mode).Perm()) // #nosec G304
+		if err != nil {
+			return err
+		}
+		// #nosec G110 -- the files are the ones requested from the container
+		if _, err = io.Copy(file, tarReader); err != nil {
+			_ = file.Close()
+			return err
+		}
+		if err = file.Close(); err != nil {
+			return err
+		}
+	}
+}
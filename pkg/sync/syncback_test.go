@@ -0,0 +1,208 @@
+package sync
+
+import (
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/redhat-developer/odo/pkg/kclient"
+	"github.com/redhat-developer/odo/pkg/util"
+)
+
+func Test_cleanSyncBackPaths(t *testing.T) {
+	tests := []struct {
+		name    string
+		paths   []string
+		want    []string
+		wantErr bool
+	}{
+		{
+			name:  "relative paths",
+			paths: []string{"package-lock.json", "./gen/", "migrations/../db"},
+			want:  []string{"package-lock.json", "gen", "db"},
+		},
+		{
+			name:    "absolute path",
+			paths:   []string{"/etc"},
+			wantErr: true,
+		},
+		{
+			name:    "path outside of the directory",
+			paths:   []string{"gen/../../secret"},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := cleanSyncBackPaths(tt.paths)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("cleanSyncBackPaths() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("cleanSyncBackPaths() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func Test_parseRemoteHashes(t *testing.T) {
+	output := "abcd  package-lock.json\nef01  ./gen/a b.go\n\\0123  gen/new\\nline\n"
+	want := map[string]string{
+		"package-lock.json": "abcd",
+		"gen/a b.go":        "ef01",
+	}
+	if diff := cmp.Diff(want, parseRemoteHashes(output)); diff != "" {
+		t.Errorf("parseRemoteHashes() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func TestSyncClient_SyncBack(t *testing.T) {
+	for _, tool := range []string{"sh", "find", "sha256sum", "tar"} {
+		if _, err := exec.LookPath(tool); err != nil {
+			t.Skipf("%s is required to run this test", tool)
+		}
+	}
+
+	writeFiles := func(t *testing.T, dir string, files map[string]string) {
+		for name, content := range files {
+			p := filepath.Join(dir, filepath.FromSlash(name))
+			if err := os.MkdirAll(filepath.Dir(p), 0750); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(p, []byte(content), 0600); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	localDir := t.TempDir()
+	remoteDir := t.TempDir()
+
+	// state at the time of the last sync
+	synced := map[string]string{
+		"package-lock.json": "v1",
+		"gen/changed.go":    "v1",
+		"gen/conflict.go":   "v1",
+		"gen/local.go":      "v1",
+		"server.js":         "v1",
+	}
+	writeFiles(t, localDir, synced)
+	if err := os.MkdirAll(filepath.Join(localDir, util.DotOdoDirectory), 0750); err != nil {
+		t.Fatal(err)
+	}
+	indexFilePath, err := util.ResolveIndexFilePath(localDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]util.FileData{}
+	for name := range synced {
+		key, fileData, err := util.GenerateNewFileDataEntry(filepath.Join(localDir, filepath.FromSlash(name)), localDir)
+		if err != nil {
+			t.Fatal(err)
+		}
+		files[key] = *fileData
+	}
+	if err = util.WriteFile(files, indexFilePath); err != nil {
+		t.Fatal(err)
+	}
+	writeFiles(t, remoteDir, synced)
+
+	// changes since the last sync
+	writeFiles(t, remoteDir, map[string]string{
+		"package-lock.json": "v2",
+		"gen/changed.go":    "v2",
+		"gen/conflict.go":   "remote",
+		"gen/new.go":        "v2",
+		"node_modules/a.js": "v2",
+		"node_modules/b.js": "remote",
+		"server.js":         "v2",
+	})
+	writeFiles(t, localDir, map[string]string{
+		"gen/conflict.go":   "local",
+		"gen/local.go":      "local",
+		"node_modules/b.js": "local",
+	})
+
+	ctrl := gomock.NewController(t)
+	kc := kclient.NewMockClientInterface(ctrl)
+	kc.EXPECT().ExecCMDInContainer(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(execLocally).AnyTimes()
+
+	a := NewSyncClient(kc, nil, nil)
+	got, err := a.SyncBack(SyncBackParameters{
+		Path:         localDir,
+		Paths:        []string{"package-lock.json", "gen", "node_modules", "missing"},
+		IgnoredFiles: []string{"node_modules"},
+		CompInfo:     ComponentInfo{SyncFolder: remoteDir},
+	})
+	if err != nil {
+		t.Fatalf("SyncBack() error = %v", err)
+	}
+	want := SyncBackResult{
+		FilesChanged: []string{"gen/changed.go", "gen/new.go", "node_modules/a.js", "package-lock.json"},
+		Conflicts:    []string{"gen/conflict.go", "node_modules/b.js"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("SyncBack() mismatch (-want +got):\n%s", diff)
+	}
+
+	wantLocal := map[string]string{
+		"package-lock.json": "v2",
+		"gen/changed.go":    "v2",
+		"gen/conflict.go":   "local",
+		"gen/local.go":      "local",
+		"gen/new.go":        "v2",
+		"node_modules/a.js": "v2",
+		"node_modules/b.js": "local",
+		"server.js":         "v1",
+	}
+	for name, content := range wantLocal {
+		b, err := os.ReadFile(filepath.Join(localDir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(b) != content {
+			t.Errorf("content of %s = %q, want %q", name, string(b), content)
+		}
+	}
+
+	fileIndex, err := util.ReadFileIndex(indexFilePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"package-lock.json", "gen/changed.go", "gen/new.go", "node_modules/a.js"} {
+		wantHash, err := util.HashFile(filepath.Join(localDir, filepath.FromSlash(name)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := fileIndex.Files[filepath.FromSlash(name)].Hash; got != wantHash {
+			t.Errorf("hash of %s in the index = %q, want %q", name, got, wantHash)
+		}
+	}
+	if _, found := fileIndex.Files[filepath.FromSlash("node_modules/b.js")]; found {
+		t.Errorf("ignored file node_modules/b.js in conflict should not be added to the index")
+	}
+
+	// an ignored file copied from the container is copied again if it did not change locally
+	writeFiles(t, remoteDir, map[string]string{"node_modules/a.js": "v3"})
+	got, err = a.SyncBack(SyncBackParameters{
+		Path:         localDir,
+		Paths:        []string{"node_modules"},
+		IgnoredFiles: []string{"node_modules"},
+		CompInfo:     ComponentInfo{SyncFolder: remoteDir},
+	})
+	if err != nil {
+		t.Fatalf("SyncBack() error = %v", err)
+	}
+	want = SyncBackResult{
+		FilesChanged: []string{"node_modules/a.js"},
+		Conflicts:    []string{"node_modules/b.js"},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("second SyncBack() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -179,3 +179,8 @@ func (file *defaultFile) Read(b []byte) (n int, err error) {
 func (file *defaultFile) Chmod(name string, mode os.FileMode) error {
 	return file.file.Chmod(mode)
 }
+
+// Fd via os.File.Fd
+func (file *defaultFile) Fd() uintptr {
+	return file.file.Fd()
+}
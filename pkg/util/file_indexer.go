@@ -1,19 +1,24 @@
 package util
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
+	"sync"
 	"time"
 
 	dfutil "github.com/devfile/library/v2/pkg/util"
 
+	"github.com/redhat-developer/odo/pkg/ignore"
 	"github.com/redhat-developer/odo/pkg/testingutil/filesystem"
 
-	gitignore "github.com/sabhiram/go-gitignore"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog"
 )
@@ -22,10 +27,23 @@ const DotOdoDirectory = ".odo"
 const fileIndexName = "odo-file-index.json"
 const DotGitIgnoreFile = ".gitignore"
 
+const (
+	fileIndexKind = "FileIndex"
+	// fileIndexAPIVersion is the current version of the format of the file index.
+	// v1 indexes only contain the size and modification date of the files, v2 indexes also contain the hash of their content
+	fileIndexAPIVersion = "v2"
+	// fileIndexAPIVersionV1 is the version of the index files written by previous versions of odo,
+	// which are migrated automatically when read
+	fileIndexAPIVersionV1 = "v1"
+)
+
 // FileIndex holds the file index used for storing local file state change
 type FileIndex struct {
 	metav1.TypeMeta
 	Files map[string]FileData
+
+	// migrated indicates that the index has been read from a file in a previous format, and needs to be written again
+	migrated bool
 }
 
 // NewFileIndex returns a fileIndex
@@ -33,8 +51,8 @@ func NewFileIndex() *FileIndex {
 
 	return &FileIndex{
 		TypeMeta: metav1.TypeMeta{
-			Kind:       "FileIndex",
-			APIVersion: "v1",
+			Kind:       fileIndexKind,
+			APIVersion: fileIndexAPIVersion,
 		},
 		Files: make(map[string]FileData),
 	}
@@ -44,6 +62,9 @@ type FileData struct {
 	Size             int64
 	LastModifiedDate time.Time
 	RemoteAttribute  string `json:"RemoteAttribute,omitempty"`
+	// Hash is the SHA-256 hash of the content of a regular file, empty for a directory.
+	// The hash of a file is computed again only when its size or modification date changes.
+	Hash string `json:"Hash,omitempty"`
 }
 
 // ReadFileIndex tries to read the odo index file from the given location and returns the data from the file
@@ -69,9 +90,31 @@ func ReadFileIndex(filePath string) (*FileIndex, error) {
 		// TODO: we need to remove this later
 		return NewFileIndex(), nil
 	}
+	switch fi.APIVersion {
+	case fileIndexAPIVersion:
+	case fileIndexAPIVersionV1, "":
+		// The entries of a v1 index do not contain the hashes of the files, and are compared using their size and modification date.
+		// The hashes are added the next time the indexer runs on the directory
+		klog.V(4).Infof("migrating file index %s from version %q to %q", filePath, fi.APIVersion, fileIndexAPIVersion)
+		fi.Kind = fileIndexKind
+		fi.APIVersion = fileIndexAPIVersion
+		fi.migrated = true
+	default:
+		// The index has been written by a more recent version of odo, reset it
+		klog.V(4).Infof("unsupported version %q of file index %s, resetting it", fi.APIVersion, filePath)
+		return NewFileIndex(), nil
+	}
+	if fi.Files == nil {
+		fi.Files = make(map[string]FileData)
+	}
 	return &fi, nil
 }
 
+// IsMigrated returns true if the index has been read from a file in a previous format, and needs to be written again
+func (o *FileIndex) IsMigrated() bool {
+	return o.migrated
+}
+
 // ResolveIndexFilePath resolves the filepath of the odo index file in the .odo folder
 func ResolveIndexFilePath(directory string) (string, error) {
 	directoryFi, err := os.Stat(filepath.Join(directory))
@@ -153,6 +196,9 @@ type IndexerRet struct {
 	RemoteDeleted []string
 	NewFileMap    map[string]FileData
 	ResolvedPath  string
+	// IndexOutdated indicates that the index file needs to be written even if no file changed,
+	// because it has been migrated, or because the modification dates of files whose content did not change are updated
+	IndexOutdated bool
 }
 
 // CalculateFileDataKeyFromPath converts an absolute path to relative (and converts to OS-specific paths) for use
@@ -182,12 +228,99 @@ func GenerateNewFileDataEntry(absolutePath string, rootDirectory string) (string
 	if err != nil {
 		return "", nil, err
 	}
+	var hash string
+	if fi.Mode().IsRegular() {
+		hash, err = HashFile(absolutePath)
+		if err != nil {
+			return "", nil, err
+		}
+	}
 	return relativeFilename, &FileData{
 		Size:             fi.Size(),
 		LastModifiedDate: fi.ModTime(),
+		Hash:             hash,
 	}, nil
 }
 
+// HashFile returns the SHA-256 hash of the content of the file, encoded in hexadecimal
+func HashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+	h := sha256.New()
+	if _, err = io.Copy(h, f); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// hashFiles sets the hashes of the regular files in newFileMap, whose keys are relative to directory.
+// The hash of a file is reused from existingFileIndex when its size and modification date did not change,
+// and is computed in parallel otherwise.
+// It returns the relative paths of the files whose content did not change since existingFileIndex, although their size
+// or modification date changed.
+func hashFiles(directory string, newFileMap map[string]FileData, existingFileIndex *FileIndex) []string {
+	var toHash []string
+	for relPath, fileData := range newFileMap {
+		existing, ok := existingFileIndex.Files[relPath]
+		if ok && existing.Hash != "" && existing.Size == fileData.Size && existing.LastModifiedDate.Equal(fileData.LastModifiedDate) {
+			fileData.Hash = existing.Hash
+			newFileMap[relPath] = fileData
+			continue
+		}
+		toHash = append(toHash, relPath)
+	}
+
+	type hashResult struct {
+		relPath string
+		hash    string
+	}
+	paths := make(chan string)
+	results := make(chan hashResult)
+	var wg sync.WaitGroup
+	for i := 0; i < runtime.NumCPU(); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for relPath := range paths {
+				absPath := filepath.Join(directory, relPath)
+				stat, err := os.Stat(absPath)
+				if err != nil || !stat.Mode().IsRegular() {
+					continue
+				}
+				hash, err := HashFile(absPath)
+				if err != nil {
+					klog.V(4).Infof("unable to compute the hash of %s: %v", absPath, err)
+					continue
+				}
+				results <- hashResult{relPath: relPath, hash: hash}
+			}
+		}()
+	}
+	go func() {
+		for _, relPath := range toHash {
+			paths <- relPath
+		}
+		close(paths)
+		wg.Wait()
+		close(results)
+	}()
+
+	var unchanged []string
+	for result := range results {
+		fileData := newFileMap[result.relPath]
+		fileData.Hash = result.hash
+		newFileMap[result.relPath] = fileData
+		existing, ok := existingFileIndex.Files[result.relPath]
+		if ok && existing.Hash == result.hash && existing.RemoteAttribute == fileData.RemoteAttribute {
+			unchanged = append(unchanged, result.relPath)
+		}
+	}
+	return unchanged
+}
+
 // write writes the map of walked files and info about them, in a file
 // filePath is the location of the file to which it is supposed to be written
 func write(filePath string, fi *FileIndex) error {
@@ -231,6 +364,9 @@ func RunIndexerWithRemote(directory string, originalIgnoreRules []string, remote
 		return IndexerRet{}, err
 	}
 	returnedIndex.ResolvedPath = ret.ResolvedPath
+	if existingFileIndex.IsMigrated() {
+		returnedIndex.IndexOutdated = true
+	}
 	return returnedIndex, nil
 }
 
@@ -339,6 +475,16 @@ func runIndexerWithExistingFileIndex(directory string, ignoreRules []string, rem
 		}
 	}
 
+	// files whose size or modification date changed, but not their content, don't need to be synced again
+	for _, relPath := range hashFiles(directory, ret.NewFileMap, existingFileIndex) {
+		absPath := filepath.Join(directory, relPath)
+		if fileChanged[absPath] {
+			klog.V(4).Infof("content unchanged: %s", absPath)
+			delete(fileChanged, absPath)
+		}
+		ret.IndexOutdated = true
+	}
+
 	// find files which are deleted/renamed
 	for fileName, value := range existingFileIndex.Files {
 		if _, ok := ret.NewFileMap[fileName]; !ok {
@@ -350,8 +496,8 @@ func runIndexerWithExistingFileIndex(directory string, ignoreRules []string, rem
 					fileRemoteChanged[remote] = true
 				}
 			} else {
-				ignoreMatcher := gitignore.CompileIgnoreLines(ignoreRules...)
-				matched := ignoreMatcher.MatchesPath(fileName)
+				ignoreMatcher := ignore.NewMatcher(ignoreRules...)
+				matched := ignoreMatcher.Matches(fileName, false)
 				if matched {
 					continue
 				}
@@ -427,7 +573,7 @@ func recursiveChecker(pathOptions recursiveCheckerPathOptions, ignoreRules []str
 	fileChanged := make(map[string]bool)
 	fileRemoteChanged := make(map[string]bool)
 
-	ignoreMatcher := gitignore.CompileIgnoreLines(ignoreRules...)
+	ignoreMatcher := ignore.NewMatcher(ignoreRules...)
 
 	for _, matchedPath := range matchedPathsDir {
 		stat, err := os.Stat(matchedPath)
@@ -440,7 +586,7 @@ func recursiveChecker(pathOptions recursiveCheckerPathOptions, ignoreRules []str
 		if err != nil {
 			return IndexerRet{}, err
 		}
-		match := ignoreMatcher.MatchesPath(rel)
+		match := ignoreMatcher.Matches(rel, stat.IsDir())
 		// the folder matches a glob rule and thus should be skipped
 		if match {
 			return IndexerRet{}, nil
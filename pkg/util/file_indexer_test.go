@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
@@ -180,6 +181,57 @@ func TestCalculateFileDataKeyFromPath(t *testing.T) {
 	}
 }
 
+func TestReadFileIndex(t *testing.T) {
+	tests := []struct {
+		name         string
+		content      string
+		wantFiles    map[string]FileData
+		wantMigrated bool
+	}{
+		{
+			name:    "current version",
+			content: `{"kind":"FileIndex","apiVersion":"v2","Files":{"file1":{"Size":1,"LastModifiedDate":"2023-03-01T10:00:00Z","Hash":"abc"}}}`,
+			wantFiles: map[string]FileData{
+				"file1": {Size: 1, LastModifiedDate: time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC), Hash: "abc"},
+			},
+		},
+		{
+			name:    "v1 index is migrated",
+			content: `{"kind":"FileIndex","apiVersion":"v1","Files":{"file1":{"Size":1,"LastModifiedDate":"2023-03-01T10:00:00Z"}}}`,
+			wantFiles: map[string]FileData{
+				"file1": {Size: 1, LastModifiedDate: time.Date(2023, 3, 1, 10, 0, 0, 0, time.UTC)},
+			},
+			wantMigrated: true,
+		},
+		{
+			name:      "unknown version is reset",
+			content:   `{"kind":"FileIndex","apiVersion":"v99","Files":{"file1":{"Size":1}}}`,
+			wantFiles: map[string]FileData{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			indexFile := filepath.Join(t.TempDir(), fileIndexName)
+			if err := ioutil.WriteFile(indexFile, []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+			got, err := ReadFileIndex(indexFile)
+			if err != nil {
+				t.Fatalf("ReadFileIndex() error = %v", err)
+			}
+			if got.APIVersion != fileIndexAPIVersion {
+				t.Errorf("ReadFileIndex() APIVersion = %q, want %q", got.APIVersion, fileIndexAPIVersion)
+			}
+			if got.IsMigrated() != tt.wantMigrated {
+				t.Errorf("ReadFileIndex() IsMigrated() = %v, want %v", got.IsMigrated(), tt.wantMigrated)
+			}
+			if diff := cmp.Diff(tt.wantFiles, got.Files); diff != "" {
+				t.Errorf("ReadFileIndex() Files mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGenerateNewFileDataEntry(t *testing.T) {
 
 	// create a temp dir for the fake component
@@ -241,6 +293,10 @@ func TestGenerateNewFileDataEntry(t *testing.T) {
 				t.Fatalf("Invalid filedata values %v %v", filedata.Size, filedata.LastModifiedDate)
 			}
 
+			if filedata.Hash == "" {
+				t.Fatalf("Filedata should contain the hash of the file")
+			}
+
 		})
 	}
 }
@@ -1099,13 +1155,12 @@ func Test_recursiveChecker(t *testing.T) {
 				},
 			},
 			want: IndexerRet{
-				FilesChanged: []string{readmeFileAbsPath, jsFileAbsPath, viewsFolderPath, targetFolderPath, htmlFileAbsPath, specialCharFolderPath, fileInsideSpecialCharFolderAbsPath},
+				FilesChanged: []string{readmeFileAbsPath, jsFileAbsPath, viewsFolderPath, htmlFileAbsPath, specialCharFolderPath, fileInsideSpecialCharFolderAbsPath},
 				NewFileMap: map[string]FileData{
 					readmeFileName:                     normalFileMap[readmeFileName],
 					jsFileName:                         normalFileMap[jsFileName],
 					viewsFolderName:                    normalFileMap[viewsFolderName],
 					htmlRelFilePath:                    normalFileMap[htmlRelFilePath],
-					targetFolderRelPath:                normalFileMap[targetFolderRelPath],
 					specialCharFolderName:              normalFileMap[specialCharFolderName],
 					fileInsideSpecialCharFolderRelPath: normalFileMap[fileInsideSpecialCharFolderRelPath],
 				},
@@ -1172,6 +1227,9 @@ func Test_recursiveChecker(t *testing.T) {
 	}
 }
 
+// emptyFileHash is the SHA-256 hash of an empty file
+const emptyFileHash = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+
 func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 	fs := filesystem.DefaultFs{}
 
@@ -1243,10 +1301,12 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 		readmeFileName: {
 			Size:             readmeFileStat.Size(),
 			LastModifiedDate: readmeFileStat.ModTime(),
+			Hash:             emptyFileHash,
 		},
 		jsFileName: {
 			Size:             jsFileStat.Size(),
 			LastModifiedDate: jsFileStat.ModTime(),
+			Hash:             emptyFileHash,
 		},
 		viewsFolderName: {
 			Size:             viewsFolderStat.Size(),
@@ -1255,6 +1315,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 		htmlRelFilePath: {
 			Size:             htmlFileStat.Size(),
 			LastModifiedDate: htmlFileStat.ModTime(),
+			Hash:             emptyFileHash,
 		},
 		specialCharFolderName: {
 			Size:             specialCharFolderStat.Size(),
@@ -1263,6 +1324,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 		fileInsideSpecialCharFolderRelPath: {
 			Size:             fileInsideSpecialCharFolderFileStat.Size(),
 			LastModifiedDate: fileInsideSpecialCharFolderFileStat.ModTime(),
+			Hash:             emptyFileHash,
 		},
 	}
 
@@ -1364,6 +1426,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 					htmlRelFilePath: {
 						Size:             htmlFileStat.Size(),
 						LastModifiedDate: htmlFileStat.ModTime(),
+						Hash:             emptyFileHash,
 						RemoteAttribute:  filepath.Join("new", "Folder0", "views.html"),
 					},
 					viewsFolderName: {
@@ -1386,6 +1449,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 						htmlRelFilePath: {
 							Size:             htmlFileStat.Size(),
 							LastModifiedDate: htmlFileStat.ModTime(),
+							Hash:             emptyFileHash,
 							RemoteAttribute:  filepath.Join("new", "Folder0", "views.html"),
 						},
 						viewsFolderName: {
@@ -1401,6 +1465,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 					htmlRelFilePath: {
 						Size:             htmlFileStat.Size(),
 						LastModifiedDate: htmlFileStat.ModTime(),
+						Hash:             emptyFileHash,
 						RemoteAttribute:  filepath.Join("new", "Folder0", "views.html"),
 					},
 					viewsFolderName: {
@@ -1426,6 +1491,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 						htmlRelFilePath: {
 							Size:             htmlFileStat.Size(),
 							LastModifiedDate: htmlFileStat.ModTime(),
+							Hash:             emptyFileHash,
 							RemoteAttribute:  filepath.Join("new", "Folder0", "views.html"),
 						},
 						viewsFolderName: {
@@ -1443,6 +1509,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 					htmlRelFilePath: {
 						Size:             htmlFileStat.Size(),
 						LastModifiedDate: htmlFileStat.ModTime(),
+						Hash:             emptyFileHash,
 						RemoteAttribute:  filepath.Join("new", "Folder0", "views.html"),
 					},
 					viewsFolderName: {
@@ -1468,6 +1535,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 						htmlRelFilePath: {
 							Size:             htmlFileStat.Size(),
 							LastModifiedDate: htmlFileStat.ModTime(),
+							Hash:             emptyFileHash,
 							RemoteAttribute:  filepath.Join("new", "Folder0", "views.html"),
 						},
 						viewsFolderName: {
@@ -1485,6 +1553,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 					htmlRelFilePath: {
 						Size:             htmlFileStat.Size(),
 						LastModifiedDate: htmlFileStat.ModTime(),
+						Hash:             emptyFileHash,
 						RemoteAttribute:  filepath.Join("new", "Folder0", "views.html"),
 					},
 					viewsFolderName: {
@@ -1507,6 +1576,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 						htmlRelFilePath: {
 							Size:             htmlFileStat.Size(),
 							LastModifiedDate: htmlFileStat.ModTime(),
+							Hash:             emptyFileHash,
 							RemoteAttribute:  filepath.Join("new", "Folder0", "views.html"),
 						},
 						viewsFolderName: {
@@ -1523,6 +1593,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 					htmlRelFilePath: {
 						Size:             htmlFileStat.Size(),
 						LastModifiedDate: htmlFileStat.ModTime(),
+						Hash:             emptyFileHash,
 						RemoteAttribute:  filepath.Join("new", "Folder0", "views.html"),
 					},
 				},
@@ -1540,11 +1611,13 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 						readmeFileName: {
 							Size:             readmeFileStat.Size(),
 							LastModifiedDate: readmeFileStat.ModTime(),
+							Hash:             emptyFileHash,
 							RemoteAttribute:  readmeFileStat.Name(),
 						},
 						htmlRelFilePath: {
 							Size:             htmlFileStat.Size(),
 							LastModifiedDate: htmlFileStat.ModTime(),
+							Hash:             emptyFileHash,
 							RemoteAttribute:  filepath.Join("new", "Folder0", "views.html"),
 						},
 						viewsFolderName: {
@@ -1573,6 +1646,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 						htmlRelFilePath: {
 							Size:             htmlFileStat.Size(),
 							LastModifiedDate: htmlFileStat.ModTime(),
+							Hash:             emptyFileHash,
 						},
 					},
 				},
@@ -1582,6 +1656,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 					htmlRelFilePath: {
 						Size:             htmlFileStat.Size(),
 						LastModifiedDate: htmlFileStat.ModTime(),
+						Hash:             emptyFileHash,
 						RemoteAttribute:  filepath.ToSlash(htmlRelFilePath),
 					},
 				},
@@ -1667,7 +1742,90 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 			wantErr: false,
 		},
 		{
-			name: "case 15: local file doesn't exist",
+			name: "case 15: file with a new modification date but the same content",
+			args: args{
+				directory:         tempDirectoryName,
+				ignoreRules:       []string{},
+				remoteDirectories: map[string]string{},
+				existingFileIndex: &FileIndex{
+					Files: map[string]FileData{
+						readmeFileName: {
+							Size:             readmeFileStat.Size(),
+							LastModifiedDate: readmeFileStat.ModTime().Add(100),
+							Hash:             emptyFileHash,
+						},
+						jsFileName:                         normalFileMap[jsFileName],
+						viewsFolderName:                    normalFileMap[viewsFolderName],
+						htmlRelFilePath:                    normalFileMap[htmlRelFilePath],
+						specialCharFolderName:              normalFileMap[specialCharFolderName],
+						fileInsideSpecialCharFolderRelPath: normalFileMap[fileInsideSpecialCharFolderRelPath],
+					},
+				},
+			},
+			wantRet: IndexerRet{
+				NewFileMap:    normalFileMap,
+				IndexOutdated: true,
+			},
+			wantErr: false,
+		},
+		{
+			name: "case 16: file with a new content hash",
+			args: args{
+				directory:         tempDirectoryName,
+				ignoreRules:       []string{},
+				remoteDirectories: map[string]string{},
+				existingFileIndex: &FileIndex{
+					Files: map[string]FileData{
+						readmeFileName: {
+							Size:             readmeFileStat.Size(),
+							LastModifiedDate: readmeFileStat.ModTime().Add(100),
+							Hash:             "previous-hash",
+						},
+						jsFileName:                         normalFileMap[jsFileName],
+						viewsFolderName:                    normalFileMap[viewsFolderName],
+						htmlRelFilePath:                    normalFileMap[htmlRelFilePath],
+						specialCharFolderName:              normalFileMap[specialCharFolderName],
+						fileInsideSpecialCharFolderRelPath: normalFileMap[fileInsideSpecialCharFolderRelPath],
+					},
+				},
+			},
+			wantRet: IndexerRet{
+				FilesChanged: []string{readmeFileAbsPath},
+				NewFileMap:   normalFileMap,
+			},
+			wantErr: false,
+		},
+		{
+			name: "case 17: file without hash in a migrated index, with a new modification date",
+			args: args{
+				directory:         tempDirectoryName,
+				ignoreRules:       []string{},
+				remoteDirectories: map[string]string{},
+				existingFileIndex: &FileIndex{
+					Files: map[string]FileData{
+						readmeFileName: {
+							Size:             readmeFileStat.Size(),
+							LastModifiedDate: readmeFileStat.ModTime().Add(100),
+						},
+						jsFileName: {
+							Size:             jsFileStat.Size(),
+							LastModifiedDate: jsFileStat.ModTime(),
+						},
+						viewsFolderName:                    normalFileMap[viewsFolderName],
+						htmlRelFilePath:                    normalFileMap[htmlRelFilePath],
+						specialCharFolderName:              normalFileMap[specialCharFolderName],
+						fileInsideSpecialCharFolderRelPath: normalFileMap[fileInsideSpecialCharFolderRelPath],
+					},
+				},
+			},
+			wantRet: IndexerRet{
+				FilesChanged: []string{readmeFileAbsPath},
+				NewFileMap:   normalFileMap,
+			},
+			wantErr: false,
+		},
+		{
+			name: "case 18: local file doesn't exist",
 			args: args{
 				directory:         tempDirectoryName,
 				ignoreRules:       []string{},
@@ -1680,7 +1838,7 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 			wantErr: true,
 		},
 		{
-			name: "case 16: local folder doesn't exist",
+			name: "case 19: local folder doesn't exist",
 			args: args{
 				directory:         tempDirectoryName,
 				ignoreRules:       []string{},
@@ -1722,6 +1880,9 @@ func Test_runIndexerWithExistingFileIndex(t *testing.T) {
 			if diff := cmp.Diff(tt.wantRet.RemoteDeleted, gotRet.RemoteDeleted, sortOpt); diff != "" {
 				t.Errorf("runIndexerWithExistingFileIndex() RemoteDeleted mismatch (-want +got):\n%s", diff)
 			}
+			if tt.wantRet.IndexOutdated != gotRet.IndexOutdated {
+				t.Errorf("runIndexerWithExistingFileIndex() IndexOutdated = %v, want %v", gotRet.IndexOutdated, tt.wantRet.IndexOutdated)
+			}
 		})
 	}
 }
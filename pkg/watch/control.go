@@ -0,0 +1,76 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"io"
+)
+
+// ControlAction is an action requested to the Dev session by its control API
+type ControlAction string
+
+const (
+	// ControlActionStatus returns the status of the component, without executing any action
+	ControlActionStatus ControlAction = "status"
+	// ControlActionSync syncs the local changes to the component, as when the user presses the p key
+	ControlActionSync ControlAction = "sync"
+	// ControlActionRerun executes again the run or debug command, as when the user presses the r key
+	ControlActionRerun ControlAction = "rerun"
+	// ControlActionDebug switches to the debug command, if the debug command is not already running
+	ControlActionDebug ControlAction = "debug"
+	// ControlActionRun switches to the run command, if the debug command is running
+	ControlActionRun ControlAction = "run"
+)
+
+// ControlRequest is a request sent to the watch loop by the control API of the Dev session
+type ControlRequest struct {
+	Action ControlAction
+	// Response receives the result of the request, once the action is executed.
+	// The channel must be buffered, so the watch loop is not blocked if the result is not read.
+	Response chan<- ControlResponse
+}
+
+// ControlResponse is the result of a ControlRequest
+type ControlResponse struct {
+	// Status is the status of the component after the action is executed
+	Status ComponentStatus
+	// Debug indicates if the debug command is used instead of the run command
+	Debug bool
+	// Err is the error returned by the action
+	Err error
+}
+
+// respondControlRequests sends the status of the component and the error of the action to each request
+func respondControlRequests(requests []ControlRequest, componentStatus ComponentStatus, debug bool, err error) {
+	for _, request := range requests {
+		request.Response <- ControlResponse{
+			Status: componentStatus,
+			Debug:  debug,
+			Err:    err,
+		}
+	}
+}
+
+// processControlRequest executes the action of the request, except the sync which is handled by the caller,
+// the same way as the action of the associated key.
+// It returns true if the component needs to be pushed again after the action.
+func (o *WatchClient) processControlRequest(
+	ctx context.Context,
+	request ControlRequest,
+	parameters *WatchParameters,
+	out io.Writer,
+	componentStatus *ComponentStatus,
+) (bool, error) {
+	switch request.Action {
+	case ControlActionStatus:
+		return false, nil
+	case ControlActionRerun:
+		return o.executeKey(ctx, keyRerun, parameters, out, componentStatus)
+	case ControlActionDebug, ControlActionRun:
+		if parameters.Debug == (request.Action == ControlActionDebug) {
+			return false, nil
+		}
+		return o.executeKey(ctx, keyToggleDebug, parameters, out, componentStatus)
+	}
+	return false, fmt.Errorf("unsupported action %q", request.Action)
+}
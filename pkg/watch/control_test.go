@@ -0,0 +1,96 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"testing"
+)
+
+func Test_processControlRequest(t *testing.T) {
+	tests := []struct {
+		name       string
+		action     ControlAction
+		state      State
+		debug      bool
+		wantCalled bool
+		wantPush   bool
+		wantDebug  bool
+		wantErr    bool
+	}{
+		{
+			name:   "status does not execute any action",
+			action: ControlActionStatus,
+			state:  StateReady,
+		},
+		{
+			name:       "rerun the run command",
+			action:     ControlActionRerun,
+			state:      StateReady,
+			wantCalled: true,
+		},
+		{
+			name:       "switch to the debug command",
+			action:     ControlActionDebug,
+			state:      StateReady,
+			wantCalled: true,
+			wantDebug:  true,
+		},
+		{
+			name:      "debug command already running",
+			action:    ControlActionDebug,
+			state:     StateReady,
+			debug:     true,
+			wantDebug: true,
+		},
+		{
+			name:       "switch back to the run command",
+			action:     ControlActionRun,
+			state:      StateReady,
+			debug:      true,
+			wantCalled: true,
+		},
+		{
+			name:   "run command already running",
+			action: ControlActionRun,
+			state:  StateReady,
+		},
+		{
+			name:    "component not ready",
+			action:  ControlActionRerun,
+			state:   StateWaitDeployment,
+			wantErr: true,
+		},
+		{
+			name:    "unsupported action",
+			action:  "unknown",
+			state:   StateReady,
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var called bool
+			parameters := WatchParameters{
+				Debug: tt.debug,
+				KeyActionHandler: func(_ context.Context, _ KeyAction, _ WatchParameters, _ *ComponentStatus) error {
+					called = true
+					return nil
+				},
+			}
+			o := WatchClient{}
+			gotPush, err := o.processControlRequest(context.Background(), ControlRequest{Action: tt.action}, &parameters, &bytes.Buffer{}, &ComponentStatus{State: tt.state})
+			if (err != nil) != tt.wantErr {
+				t.Errorf("processControlRequest() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if called != tt.wantCalled {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCalled)
+			}
+			if gotPush != tt.wantPush {
+				t.Errorf("processControlRequest() = %v, want %v", gotPush, tt.wantPush)
+			}
+			if parameters.Debug != tt.wantDebug {
+				t.Errorf("debug = %v, want %v", parameters.Debug, tt.wantDebug)
+			}
+		})
+	}
+}
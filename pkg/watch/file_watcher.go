@@ -1,42 +1,105 @@
 package watch
 
 import (
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
+	"syscall"
 
-	dfutil "github.com/devfile/library/v2/pkg/util"
 	"github.com/fsnotify/fsnotify"
+	"github.com/redhat-developer/odo/pkg/ignore"
 	"github.com/redhat-developer/odo/pkg/util"
-	gitignore "github.com/sabhiram/go-gitignore"
 	"k8s.io/klog"
 )
 
-func getFullSourcesWatcher(path string, fileIgnores []string) (*fsnotify.Watcher, error) {
-	absIgnorePaths := dfutil.GetAbsGlobExps(path, fileIgnores)
+// errWatchLimitReached is returned when a path cannot be watched with filesystem notifications
+// because a limit of the system is reached
+var errWatchLimitReached = errors.New("the limit of the system on the number of watched files is reached")
+
+// fileWatcher reports the changes of the watched files and directories as fsnotify events
+type fileWatcher interface {
+	// Events returns the channel receiving the changes
+	Events() <-chan fsnotify.Event
+	// Errors returns the channel receiving the errors of the watcher
+	Errors() <-chan error
+	// Add starts watching the path
+	Add(path string) error
+	// Remove stops watching the path
+	Remove(path string) error
+	// Close stops watching all the paths and closes the channels
+	Close() error
+}
+
+// notifyWatcher is a fileWatcher using filesystem notifications
+type notifyWatcher struct {
+	watcher *fsnotify.Watcher
+}
 
+var _ fileWatcher = (*notifyWatcher)(nil)
+
+func newNotifyWatcher() (*notifyWatcher, error) {
 	watcher, err := fsnotify.NewWatcher()
 	if err != nil {
-		return nil, fmt.Errorf("error setting up filesystem watcher: %v", err)
+		if isWatchLimitError(err) {
+			err = fmt.Errorf("%w: %v", errWatchLimitReached, err)
+		}
+		return nil, err
+	}
+	return &notifyWatcher{watcher: watcher}, nil
+}
+
+func (o *notifyWatcher) Events() <-chan fsnotify.Event {
+	return o.watcher.Events
+}
+
+func (o *notifyWatcher) Errors() <-chan error {
+	return o.watcher.Errors
+}
+
+func (o *notifyWatcher) Add(path string) error {
+	return o.watcher.Add(path)
+}
+
+func (o *notifyWatcher) Remove(path string) error {
+	return o.watcher.Remove(path)
+}
+
+func (o *notifyWatcher) Close() error {
+	return o.watcher.Close()
+}
+
+func getFullSourcesWatcher(path string, fileIgnores []string) (fileWatcher, error) {
+	watcher, err := newNotifyWatcher()
+	if err != nil {
+		return nil, fmt.Errorf("error setting up filesystem watcher: %w", err)
 	}
 
 	// adding watch on the root folder and the sub folders recursively
 	// so directory and the path in addRecursiveWatch() are the same
-	err = addRecursiveWatch(watcher, path, path, absIgnorePaths)
+	err = addRecursiveWatch(watcher, path, path, fileIgnores)
 	if err != nil {
-		return nil, fmt.Errorf("error watching source path %s: %v", path, err)
+		_ = watcher.Close()
+		return nil, fmt.Errorf("error watching source path %s: %w", path, err)
 	}
 	return watcher, nil
 }
 
+// isWatchLimitError returns true if the error returned when adding a watch indicates that a limit of the system is reached:
+// the maximum number of inotify watches or instances on Linux, or the maximum number of open files on BSD and macOS
+func isWatchLimitError(err error) bool {
+	return errors.Is(err, syscall.ENOSPC) || errors.Is(err, syscall.EMFILE)
+}
+
 // addRecursiveWatch handles adding watches recursively for the path provided
 // and its subdirectories.  If a non-directory is specified, this call is a no-op.
 // Files matching glob pattern defined in ignores will be ignored.
 // Taken from https://github.com/openshift/origin/blob/85eb37b34f0657631592356d020cef5a58470f8e/pkg/util/fsnotification/fsnotification.go
 // rootPath is the root path of the file or directory,
 // path is the recursive path of the file or the directory,
-// ignores contains the glob rules for matching
-func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string, ignores []string) error {
+// ignores contains the rules for matching, relative to rootPath
+// An error wrapping errWatchLimitReached is returned if a watch cannot be added because a limit of the system is reached.
+func addRecursiveWatch(watcher fileWatcher, rootPath string, path string, ignores []string) error {
 
 	file, err := os.Stat(path)
 	if err != nil {
@@ -46,7 +109,7 @@ func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string,
 		return fmt.Errorf("error introspecting path %s: %v", path, err)
 	}
 
-	ignoreMatcher := gitignore.CompileIgnoreLines(ignores...)
+	ignoreMatcher := ignore.NewMatcher(ignores...)
 
 	mode := file.Mode()
 	if mode.IsRegular() {
@@ -55,7 +118,7 @@ func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string,
 		if err != nil {
 			return err
 		}
-		matched := ignoreMatcher.MatchesPath(rel)
+		matched := ignoreMatcher.Matches(rel, false)
 		if !matched {
 			klog.V(4).Infof("adding watch on path %s", path)
 
@@ -67,6 +130,9 @@ func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string,
 			err = watcher.Add(path)
 			if err != nil {
 				klog.V(4).Infof("error adding watcher for path %s: %v", path, err)
+				if isWatchLimitError(err) {
+					return fmt.Errorf("%w: unable to watch %s: %v", errWatchLimitReached, path, err)
+				}
 			}
 			return nil
 		}
@@ -89,7 +155,7 @@ func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string,
 			if err != nil {
 				return err
 			}
-			matched := ignoreMatcher.MatchesPath(rel)
+			matched := ignoreMatcher.Matches(rel, true)
 			if err != nil {
 				return fmt.Errorf("unable to addRecursiveWatch on %s: %w", newPath, err)
 			}
@@ -111,7 +177,7 @@ func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string,
 		if err != nil {
 			return err
 		}
-		matched := ignoreMatcher.MatchesPath(rel)
+		matched := ignoreMatcher.Matches(rel, true)
 
 		if matched {
 			klog.V(4).Infof("ignoring watch for %s", folder)
@@ -131,6 +197,9 @@ func addRecursiveWatch(watcher *fsnotify.Watcher, rootPath string, path string,
 			// BSD / OSX: "too many open files" issues are ussualy resolved via
 			// $ sysctl variables "kern.maxfiles" and "kern.maxfilesperproc",
 			klog.V(4).Infof("error adding watcher for path %s: %v", folder, err)
+			if isWatchLimitError(err) {
+				return fmt.Errorf("%w: unable to watch %s: %v", errWatchLimitReached, folder, err)
+			}
 		}
 	}
 	return nil
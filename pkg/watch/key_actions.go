@@ -0,0 +1,146 @@
+package watch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/fatih/color"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+)
+
+// KeyAction is an action requested by the user by pressing a key during the Dev session,
+// executed by the KeyActionHandler of the watch parameters
+type KeyAction string
+
+const (
+	// KeyActionRerun stops the run and debug commands if they are running, and executes again the run command,
+	// or the debug command in debug mode, without syncing the files
+	KeyActionRerun KeyAction = "rerun"
+	// KeyActionRestart restarts the containers of the component
+	KeyActionRestart KeyAction = "restart"
+	// KeyActionLogs displays the last lines of the logs of each container of the component
+	KeyActionLogs KeyAction = "logs"
+	// KeyActionTest executes the default command of the test group
+	KeyActionTest KeyAction = "test"
+)
+
+// Keys pressed by the user during the Dev session
+const (
+	keyPush        = 'p'
+	keyRerun       = 'r'
+	keyToggleDebug = 'd'
+	keyRestart     = 'c'
+	keyLogs        = 'l'
+	keyStatus      = 's'
+	keyTest        = 't'
+)
+
+var (
+	errNoKeyAction       = errors.New("no action is associated with the key")
+	errComponentNotReady = errors.New("the component is not ready yet, please try again in a few moments")
+)
+
+// processKey executes the action associated with the key pressed by the user, except the manual push
+// which is handled by the caller.
+// It returns true if the component needs to be pushed again after the action.
+func (o *WatchClient) processKey(
+	ctx context.Context,
+	key byte,
+	parameters *WatchParameters,
+	out io.Writer,
+	componentStatus *ComponentStatus,
+) bool {
+	if key == keyStatus {
+		o.printStatus(out, *parameters)
+		return false
+	}
+
+	push, err := o.executeKey(ctx, key, parameters, out, componentStatus)
+	switch {
+	case errors.Is(err, errNoKeyAction):
+		klog.V(4).Infof("no action for key %q", key)
+		return false
+	case errors.Is(err, errComponentNotReady):
+		fmt.Fprintf(out, "The component is not ready yet, please try again in a few moments\n\n")
+		return false
+	case err != nil:
+		fmt.Fprintf(out, "%s - %s\n\n", KeyActionErrorString, err.Error())
+		machineoutput.NewMachineEventLoggingClient().ReportError(err, machineoutput.TimestampNow())
+		return false
+	}
+	return push
+}
+
+// executeKey executes the action associated with the key, with the KeyActionHandler of the parameters.
+// It returns true if the component needs to be pushed again after the action.
+func (o *WatchClient) executeKey(
+	ctx context.Context,
+	key byte,
+	parameters *WatchParameters,
+	out io.Writer,
+	componentStatus *ComponentStatus,
+) (bool, error) {
+	var action KeyAction
+	switch key {
+	case keyRerun, keyToggleDebug:
+		action = KeyActionRerun
+	case keyRestart:
+		action = KeyActionRestart
+	case keyLogs:
+		action = KeyActionLogs
+	case keyTest:
+		action = KeyActionTest
+	default:
+		return false, errNoKeyAction
+	}
+
+	if parameters.KeyActionHandler == nil {
+		klog.V(4).Infof("no handler for action %q", action)
+		return false, errNoKeyAction
+	}
+	if componentStatus.State != StateReady {
+		return false, errComponentNotReady
+	}
+
+	if key == keyToggleDebug {
+		parameters.Debug = !parameters.Debug
+		if parameters.Debug {
+			fmt.Fprintf(out, "Switching to the debug command...\n\n")
+		} else {
+			fmt.Fprintf(out, "Switching to the run command...\n\n")
+		}
+	}
+
+	err := parameters.KeyActionHandler(ctx, action, *parameters, componentStatus)
+	if err != nil {
+		if key == keyToggleDebug {
+			parameters.Debug = !parameters.Debug
+		}
+		return false, err
+	}
+
+	// The containers have been replaced when restarted. When switching between the run and debug commands,
+	// the command has already been executed by the handler in the existing containers, and pushing again
+	// would execute it a second time
+	return action == KeyActionRestart, nil
+}
+
+// printStatus displays the ports forwarded by the Dev session and the keyboard commands
+func (o *WatchClient) printStatus(out io.Writer, parameters WatchParameters) {
+	if o.stateClient != nil {
+		fwPorts, err := o.stateClient.GetForwardedPorts()
+		if err != nil {
+			klog.V(4).Infof("unable to get the forwarded ports: %v", err)
+		}
+		for _, fwPort := range fwPorts {
+			s := fmt.Sprintf("Forwarding from %s:%d -> %d", fwPort.LocalAddress, fwPort.LocalPort, fwPort.ContainerPort)
+			fmt.Fprintf(out, " -  %s\n", log.SboldColor(color.FgGreen, s))
+		}
+	}
+	PrintInfoMessage(out, parameters.Path, parameters.WatchFiles, parameters.PromptMessage)
+}
@@ -0,0 +1,145 @@
+package watch
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func Test_processKey(t *testing.T) {
+	tests := []struct {
+		name       string
+		key        byte
+		state      State
+		debug      bool
+		handlerErr error
+		noHandler  bool
+		wantAction KeyAction
+		wantCalled bool
+		wantPush   bool
+		wantDebug  bool
+		wantOut    string
+	}{
+		{
+			name:       "rerun the run command",
+			key:        keyRerun,
+			state:      StateReady,
+			wantAction: KeyActionRerun,
+			wantCalled: true,
+		},
+		{
+			name:       "switch to the debug command",
+			key:        keyToggleDebug,
+			state:      StateReady,
+			wantAction: KeyActionRerun,
+			wantCalled: true,
+			wantDebug:  true,
+			wantOut:    "Switching to the debug command",
+		},
+		{
+			name:       "switch back to the run command",
+			key:        keyToggleDebug,
+			state:      StateReady,
+			debug:      true,
+			wantAction: KeyActionRerun,
+			wantCalled: true,
+			wantDebug:  false,
+			wantOut:    "Switching to the run command",
+		},
+		{
+			name:       "debug mode is restored on error",
+			key:        keyToggleDebug,
+			state:      StateReady,
+			handlerErr: errors.New("an error"),
+			wantAction: KeyActionRerun,
+			wantCalled: true,
+			wantDebug:  false,
+			wantOut:    KeyActionErrorString + " - an error",
+		},
+		{
+			name:       "restart the containers",
+			key:        keyRestart,
+			state:      StateReady,
+			wantAction: KeyActionRestart,
+			wantCalled: true,
+			wantPush:   true,
+		},
+		{
+			name:       "display the logs",
+			key:        keyLogs,
+			state:      StateReady,
+			wantAction: KeyActionLogs,
+			wantCalled: true,
+		},
+		{
+			name:       "run the tests",
+			key:        keyTest,
+			state:      StateReady,
+			wantAction: KeyActionTest,
+			wantCalled: true,
+		},
+		{
+			name:    "component not ready",
+			key:     keyRerun,
+			state:   StateWaitDeployment,
+			wantOut: "The component is not ready yet",
+		},
+		{
+			name:      "no handler",
+			key:       keyRerun,
+			state:     StateReady,
+			noHandler: true,
+		},
+		{
+			name:  "unknown key",
+			key:   'z',
+			state: StateReady,
+		},
+		{
+			name:    "status is displayed",
+			key:     keyStatus,
+			state:   StateWaitDeployment,
+			wantOut: "Keyboard Commands",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var (
+				called    bool
+				gotAction KeyAction
+			)
+			parameters := WatchParameters{
+				Debug:         tt.debug,
+				PromptMessage: "Keyboard Commands",
+			}
+			if !tt.noHandler {
+				parameters.KeyActionHandler = func(_ context.Context, action KeyAction, _ WatchParameters, _ *ComponentStatus) error {
+					called = true
+					gotAction = action
+					return tt.handlerErr
+				}
+			}
+			out := &bytes.Buffer{}
+			o := WatchClient{}
+			gotPush := o.processKey(context.Background(), tt.key, &parameters, out, &ComponentStatus{State: tt.state})
+
+			if called != tt.wantCalled {
+				t.Errorf("handler called = %v, want %v", called, tt.wantCalled)
+			}
+			if gotAction != tt.wantAction {
+				t.Errorf("action = %q, want %q", gotAction, tt.wantAction)
+			}
+			if gotPush != tt.wantPush {
+				t.Errorf("processKey() = %v, want %v", gotPush, tt.wantPush)
+			}
+			if parameters.Debug != tt.wantDebug {
+				t.Errorf("debug = %v, want %v", parameters.Debug, tt.wantDebug)
+			}
+			if !strings.Contains(out.String(), tt.wantOut) {
+				t.Errorf("output %q does not contain %q", out.String(), tt.wantOut)
+			}
+		})
+	}
+}
@@ -1,6 +1,9 @@
 package watch
 
-import "k8s.io/apimachinery/pkg/watch"
+import (
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/apimachinery/pkg/watch"
+)
 
 type NoOpWatcher struct{}
 
@@ -15,3 +18,28 @@ func (o NoOpWatcher) Stop() {}
 func (o NoOpWatcher) ResultChan() <-chan watch.Event {
 	return make(chan watch.Event)
 }
+
+// noopFileWatcher is a fileWatcher never reporting any change, used when the files are not watched
+type noopFileWatcher struct{}
+
+var _ fileWatcher = noopFileWatcher{}
+
+func (o noopFileWatcher) Events() <-chan fsnotify.Event {
+	return nil
+}
+
+func (o noopFileWatcher) Errors() <-chan error {
+	return nil
+}
+
+func (o noopFileWatcher) Add(path string) error {
+	return nil
+}
+
+func (o noopFileWatcher) Remove(path string) error {
+	return nil
+}
+
+func (o noopFileWatcher) Close() error {
+	return nil
+}
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -71,3 +72,26 @@ func (o PodPhases) Display(out io.Writer) {
 	}
 	log.Fwarning(out, "Pods are "+strings.Join(values, ", "))
 }
+
+// reportPodStatus emits a machine-readable event with the status of the pod and its containers.
+// The phase of a pod being deleted is "Terminating", and "Deleted" once it has been deleted.
+func reportPodStatus(pod *corev1.Pod, deleted bool) {
+	phase := string(pod.Status.Phase)
+	if deleted {
+		phase = "Deleted"
+	} else if pod.GetDeletionTimestamp() != nil {
+		phase = "Terminating"
+	}
+	entry := machineoutput.KubernetesPodStatusEntry{
+		Name:           pod.GetName(),
+		UID:            string(pod.GetUID()),
+		Phase:          phase,
+		Labels:         pod.GetLabels(),
+		Containers:     pod.Status.ContainerStatuses,
+		InitContainers: pod.Status.InitContainerStatuses,
+	}
+	if pod.Status.StartTime != nil {
+		entry.StartTime = machineoutput.FormatTime(pod.Status.StartTime.Time)
+	}
+	machineoutput.NewMachineEventLoggingClient().KubernetesPodStatus([]machineoutput.KubernetesPodStatusEntry{entry}, machineoutput.TimestampNow())
+}
@@ -0,0 +1,205 @@
+package watch
+
+import (
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/ignore"
+)
+
+// pollingWatcher is a fileWatcher listing the watched files and directories at a regular interval, for the filesystems
+// on which notifications are not available (network filesystems, volumes mounted in virtual machines),
+// or when the limits of the system on notifications are reached.
+// A directory is watched recursively, except the files ignored by the rules.
+type pollingWatcher struct {
+	// ignoreRoot is the directory the ignore rules are relative to
+	ignoreRoot    string
+	ignoreMatcher *ignore.Matcher
+	interval      time.Duration
+
+	events chan fsnotify.Event
+	errors chan error
+	done   chan struct{}
+	once   sync.Once
+
+	// mu protects paths and files, accessed by Add and Remove and by the polling goroutine
+	mu sync.Mutex
+	// paths are the watched paths
+	paths map[string]bool
+	// files is the state of the watched files and directories during the last poll, indexed by their path
+	files map[string]fileState
+}
+
+// fileState is the state of a file compared between two polls
+type fileState struct {
+	isDir   bool
+	modTime time.Time
+	size    int64
+	mode    fs.FileMode
+}
+
+var _ fileWatcher = (*pollingWatcher)(nil)
+
+// newPollingWatcher returns a watcher polling the watched paths every interval.
+// The files matching ignores, relative to ignoreRoot, are not watched.
+func newPollingWatcher(ignoreRoot string, ignores []string, interval time.Duration) *pollingWatcher {
+	o := &pollingWatcher{
+		ignoreRoot:    ignoreRoot,
+		ignoreMatcher: ignore.NewMatcher(ignores...),
+		interval:      interval,
+		events:        make(chan fsnotify.Event),
+		errors:        make(chan error),
+		done:          make(chan struct{}),
+		paths:         map[string]bool{},
+		files:         map[string]fileState{},
+	}
+	go o.run()
+	return o
+}
+
+func (o *pollingWatcher) Events() <-chan fsnotify.Event {
+	return o.events
+}
+
+func (o *pollingWatcher) Errors() <-chan error {
+	return o.errors
+}
+
+// Add starts watching the path, if it is not already watched as part of a watched directory
+func (o *pollingWatcher) Add(path string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	for p := range o.paths {
+		if path == p || strings.HasPrefix(path, p+string(filepath.Separator)) {
+			return nil
+		}
+	}
+	o.paths[path] = true
+	for p, state := range o.list(path) {
+		o.files[p] = state
+	}
+	return nil
+}
+
+// Remove stops watching the path, if it has been added with Add
+func (o *pollingWatcher) Remove(path string) error {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	if !o.paths[path] {
+		return nil
+	}
+	delete(o.paths, path)
+	for p := range o.files {
+		if p == path || strings.HasPrefix(p, path+string(filepath.Separator)) {
+			delete(o.files, p)
+		}
+	}
+	return nil
+}
+
+func (o *pollingWatcher) Close() error {
+	o.once.Do(func() {
+		close(o.done)
+	})
+	return nil
+}
+
+func (o *pollingWatcher) run() {
+	ticker := time.NewTicker(o.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-o.done:
+			return
+		case <-ticker.C:
+			for _, event := range o.poll() {
+				select {
+				case o.events <- event:
+				case <-o.done:
+					return
+				}
+			}
+		}
+	}
+}
+
+// poll lists the watched paths, and returns the changes since the previous poll
+func (o *pollingWatcher) poll() []fsnotify.Event {
+	o.mu.Lock()
+	defer o.mu.Unlock()
+	files := map[string]fileState{}
+	for path := range o.paths {
+		for p, state := range o.list(path) {
+			files[p] = state
+		}
+	}
+	events := compareFiles(o.files, files)
+	o.files = files
+	return events
+}
+
+// list returns the state of the path and, if it is a directory, of the files and directories it contains
+// which are not ignored. Nothing is returned if the path does not exist.
+func (o *pollingWatcher) list(path string) map[string]fileState {
+	files := map[string]fileState{}
+	_ = filepath.WalkDir(path, func(p string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// the file may have been deleted during the walk, or be temporarily unavailable on a network filesystem
+			klog.V(4).Infof("unable to poll %s: %v", p, err)
+			return nil
+		}
+		if rel, err := filepath.Rel(o.ignoreRoot, p); err == nil && o.ignoreMatcher.Matches(rel, d.IsDir()) {
+			if d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		info, err := d.Info()
+		if err != nil {
+			klog.V(4).Infof("unable to poll %s: %v", p, err)
+			return nil
+		}
+		files[p] = fileState{
+			isDir:   d.IsDir(),
+			modTime: info.ModTime(),
+			size:    info.Size(),
+			mode:    info.Mode(),
+		}
+		return nil
+	})
+	return files
+}
+
+// compareFiles returns the events for the changes between the previous and the current states of the files,
+// ordered by path. The modifications of the directories are not reported, only their creation and removal.
+func compareFiles(previous map[string]fileState, current map[string]fileState) []fsnotify.Event {
+	var events []fsnotify.Event
+	for p, state := range current {
+		before, found := previous[p]
+		switch {
+		case !found || before.isDir != state.isDir:
+			events = append(events, fsnotify.Event{Name: p, Op: fsnotify.Create})
+		case state.isDir:
+		case !before.modTime.Equal(state.modTime) || before.size != state.size:
+			events = append(events, fsnotify.Event{Name: p, Op: fsnotify.Write})
+		case before.mode != state.mode:
+			events = append(events, fsnotify.Event{Name: p, Op: fsnotify.Chmod})
+		}
+	}
+	for p := range previous {
+		if _, found := current[p]; !found {
+			events = append(events, fsnotify.Event{Name: p, Op: fsnotify.Remove})
+		}
+	}
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].Name < events[j].Name
+	})
+	return events
+}
@@ -0,0 +1,103 @@
+package watch
+
+import (
+	"os"
+	"path/filepath"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/google/go-cmp/cmp"
+)
+
+func Test_compareFiles(t *testing.T) {
+	now := time.Now()
+	previous := map[string]fileState{
+		"dir":            {isDir: true, modTime: now},
+		"dir/unchanged":  {modTime: now, size: 1},
+		"dir/modified":   {modTime: now, size: 1},
+		"dir/resized":    {modTime: now, size: 1},
+		"dir/chmod":      {modTime: now, size: 1, mode: 0600},
+		"dir/deleted":    {modTime: now, size: 1},
+		"dir/now-a-file": {isDir: true, modTime: now},
+	}
+	current := map[string]fileState{
+		"dir":            {isDir: true, modTime: now.Add(time.Second)},
+		"dir/unchanged":  {modTime: now, size: 1},
+		"dir/modified":   {modTime: now.Add(time.Second), size: 1},
+		"dir/resized":    {modTime: now, size: 2},
+		"dir/chmod":      {modTime: now, size: 1, mode: 0700},
+		"dir/created":    {modTime: now, size: 1},
+		"dir/now-a-file": {modTime: now, size: 1},
+	}
+	want := []fsnotify.Event{
+		{Name: "dir/chmod", Op: fsnotify.Chmod},
+		{Name: "dir/created", Op: fsnotify.Create},
+		{Name: "dir/deleted", Op: fsnotify.Remove},
+		{Name: "dir/modified", Op: fsnotify.Write},
+		{Name: "dir/now-a-file", Op: fsnotify.Create},
+		{Name: "dir/resized", Op: fsnotify.Write},
+	}
+	if diff := cmp.Diff(want, compareFiles(previous, current)); diff != "" {
+		t.Errorf("compareFiles() mismatch (-want +got):\n%s", diff)
+	}
+}
+
+func Test_pollingWatcher(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "src"), 0750); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "src", "main.go"), []byte("package main"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	watcher := newPollingWatcher(dir, []string{"*.log"}, 10*time.Millisecond)
+	defer watcher.Close()
+	if err := watcher.Add(dir); err != nil {
+		t.Fatal(err)
+	}
+	// the subdirectories are already watched
+	if err := watcher.Add(filepath.Join(dir, "src")); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := os.WriteFile(filepath.Join(dir, "debug.log"), []byte("ignored"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "src", "util.go"), []byte("package main"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Remove(filepath.Join(dir, "src", "main.go")); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []fsnotify.Event
+	timeout := time.After(5 * time.Second)
+	for len(got) < 2 {
+		select {
+		case event := <-watcher.Events():
+			got = append(got, event)
+		case <-timeout:
+			t.Fatalf("timeout waiting for events, got %v", got)
+		}
+	}
+	// the changes may be detected by different polls
+	sort.Slice(got, func(i, j int) bool {
+		return got[i].Name < got[j].Name
+	})
+	want := []fsnotify.Event{
+		{Name: filepath.Join(dir, "src", "main.go"), Op: fsnotify.Remove},
+		{Name: filepath.Join(dir, "src", "util.go"), Op: fsnotify.Create},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("events mismatch (-want +got):\n%s", diff)
+	}
+
+	select {
+	case event := <-watcher.Events():
+		t.Errorf("unexpected event %v", event)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
@@ -0,0 +1,45 @@
+package watch
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"k8s.io/klog"
+
+	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+)
+
+// ProbeResult is the result of the probe of an endpoint of the application, running in the background.
+// The results are displayed by the watch loop, so that they are not interleaved with its output.
+type ProbeResult struct {
+	// Name is the name of the endpoint
+	Name string
+	// URL is the URL requested for an HTTP endpoint, or the forwarded address for other endpoints
+	URL       string
+	LocalPort int
+	Protocol  string
+	Ready     bool
+	// Duration is the time the endpoint took to be ready, or the timeout if it is not ready
+	Duration time.Duration
+	// DisplayLogs displays the last logs of the container of the endpoint, when it is not ready
+	DisplayLogs func(out io.Writer) error
+}
+
+// displayProbeResult displays the readiness of the endpoint in out, and reports it with a machine-readable event
+func displayProbeResult(out io.Writer, result ProbeResult) {
+	machineoutput.NewMachineEventLoggingClient().URLReachable(result.Name, result.URL, result.LocalPort, false,
+		result.Protocol, result.Ready, machineoutput.TimestampNow())
+
+	if result.Ready {
+		log.Fsuccess(out, fmt.Sprintf("Endpoint %q is ready on %s (%s)", result.Name, result.URL, result.Duration.Round(time.Millisecond)))
+		return
+	}
+	log.Fwarning(out, fmt.Sprintf("Endpoint %q is not ready on %s after %s", result.Name, result.URL, result.Duration))
+	if result.DisplayLogs != nil {
+		if err := result.DisplayLogs(out); err != nil {
+			klog.V(2).Infof("unable to display the logs of the container of endpoint %q: %v", result.Name, err)
+		}
+	}
+}
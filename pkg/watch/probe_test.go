@@ -0,0 +1,53 @@
+package watch
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"testing"
+	"time"
+)
+
+func Test_displayProbeResult(t *testing.T) {
+	tests := []struct {
+		name     string
+		result   ProbeResult
+		wantOut  []string
+		wantLogs bool
+	}{
+		{
+			name:    "ready endpoint",
+			result:  ProbeResult{Name: "http", URL: "http://127.0.0.1:20001/", Ready: true, Duration: 1234567 * time.Microsecond},
+			wantOut: []string{`Endpoint "http" is ready on http://127.0.0.1:20001/ (1.235s)`},
+		},
+		{
+			name:     "endpoint not ready",
+			result:   ProbeResult{Name: "tcp", URL: "127.0.0.1:20002", Duration: time.Minute},
+			wantOut:  []string{`Endpoint "tcp" is not ready on 127.0.0.1:20002 after 1m0s`, "container logs"},
+			wantLogs: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logsDisplayed := false
+			if tt.wantLogs {
+				tt.result.DisplayLogs = func(out io.Writer) error {
+					logsDisplayed = true
+					_, err := fmt.Fprintln(out, "container logs")
+					return err
+				}
+			}
+			out := &bytes.Buffer{}
+			displayProbeResult(out, tt.result)
+			for _, want := range tt.wantOut {
+				if !strings.Contains(out.String(), want) {
+					t.Errorf("output %q does not contain %q", out.String(), want)
+				}
+			}
+			if logsDisplayed != tt.wantLogs {
+				t.Errorf("logs displayed = %v, want %v", logsDisplayed, tt.wantLogs)
+			}
+		})
+	}
+}
@@ -12,14 +12,18 @@ import (
 
 	"github.com/devfile/library/v2/pkg/devfile/parser"
 
+	"github.com/redhat-developer/odo/pkg/api"
 	"github.com/redhat-developer/odo/pkg/devfile/adapters"
+	"github.com/redhat-developer/odo/pkg/ignore"
 	"github.com/redhat-developer/odo/pkg/kclient"
 	"github.com/redhat-developer/odo/pkg/labels"
 	"github.com/redhat-developer/odo/pkg/libdevfile"
 	"github.com/redhat-developer/odo/pkg/log"
+	"github.com/redhat-developer/odo/pkg/machineoutput"
+	"github.com/redhat-developer/odo/pkg/preference"
+	"github.com/redhat-developer/odo/pkg/state"
 
 	"github.com/fsnotify/fsnotify"
-	gitignore "github.com/sabhiram/go-gitignore"
 
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -32,27 +36,47 @@ import (
 const (
 	// PushErrorString is the string that is printed when an error occurs during watch's Push operation
 	PushErrorString = "Error occurred on Push"
+	// KeyActionErrorString is the string that is printed when an error occurs during the action requested by a key
+	KeyActionErrorString = "Error occurred on keyboard command"
+
+	// syncBackInterval is the interval between two checks of the files changed in the container under the paths to sync back
+	syncBackInterval = 5 * time.Second
 )
 
 type WatchClient struct {
-	kubeClient kclient.ClientInterface
+	kubeClient  kclient.ClientInterface
+	prefClient  preference.Client
+	stateClient state.Client
 
-	sourcesWatcher    *fsnotify.Watcher
+	sourcesWatcher    fileWatcher
 	deploymentWatcher watch.Interface
-	devfileWatcher    *fsnotify.Watcher
+	devfileWatcher    fileWatcher
 	podWatcher        watch.Interface
 	warningsWatcher   watch.Interface
 	keyWatcher        <-chan byte
 
 	// true to force sync, used when manual sync
 	forceSync bool
+
+	// syncedBackFiles are the absolute paths of the files copied from the container since the last sync,
+	// whose file events are ignored
+	syncedBackFiles map[string]bool
+	// syncBackConflicts are the files in conflict during the last sync back, reported only once
+	syncBackConflicts map[string]bool
+	// syncBackError is the last error returned when syncing files back, reported only once
+	syncBackError string
+
+	// polling is true when the files are polled for changes instead of being watched with filesystem notifications
+	polling bool
 }
 
 var _ Client = (*WatchClient)(nil)
 
-func NewWatchClient(kubeClient kclient.ClientInterface) *WatchClient {
+func NewWatchClient(kubeClient kclient.ClientInterface, prefClient preference.Client, stateClient state.Client) *WatchClient {
 	return &WatchClient{
-		kubeClient: kubeClient,
+		kubeClient:  kubeClient,
+		prefClient:  prefClient,
+		stateClient: stateClient,
 	}
 }
 
@@ -72,6 +96,18 @@ type WatchParameters struct {
 	// WatchHandler func(kclient.ClientInterface, string, string, string, io.Writer, []string, []string, bool, []string, bool) error
 	// Custom function that can be used to push detected changes to remote devfile pod. For more info about what each of the parameters to this function, please refer, pkg/devfile/adapters/interface.go#PlatformAdapter
 	DevfileWatchHandler func(context.Context, adapters.PushParameters, WatchParameters, *ComponentStatus) error
+	// KeyActionHandler executes the actions requested by the user by pressing keys, other than the manual push
+	KeyActionHandler func(context.Context, KeyAction, WatchParameters, *ComponentStatus) error
+	// SyncBackPaths are the paths, relative to Path, whose changes in the container are copied into the local directory.
+	// The files deleted in the container are not deleted locally.
+	SyncBackPaths []string
+	// SyncBackHandler copies the files changed in the container under SyncBackPaths into the local directory.
+	// It returns the files copied, and the files changed both locally and in the container, which are not copied
+	SyncBackHandler func(context.Context, WatchParameters) ([]string, []string, error)
+	// ControlRequests receives the requests of the control API of the Dev session, if enabled
+	ControlRequests <-chan ControlRequest
+	// ProbeResults receives the results of the probes of the endpoints, running in the background
+	ProbeResults <-chan ProbeResult
 	// Parameter whether or not to show build logs
 	Show bool
 	// DevfileBuildCmd takes the build command through the command line and overwrites devfile build command
@@ -90,6 +126,10 @@ type WatchParameters struct {
 	Variables map[string]string
 	// RandomPorts is true to forward containers ports on local random ports
 	RandomPorts bool
+	// CustomForwardedPorts are the local ports requested by the user for some container ports
+	CustomForwardedPorts []api.ForwardedPort
+	// CustomAddress is the local address to forward the ports on, localhost if empty
+	CustomAddress string
 	// WatchFiles indicates to watch for file changes and sync changes to the container
 	WatchFiles bool
 	// WatchCluster indicates to watch Cluster-related objects (Deployment, Pod, etc)
@@ -105,7 +145,7 @@ type WatchParameters struct {
 // evaluateChangesFunc evaluates any file changes for the events by ignoring the files in fileIgnores slice and removes
 // any deleted paths from the watcher. It returns a slice of changed files (if any) and paths that are deleted (if any)
 // by the events
-type evaluateChangesFunc func(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) (changedFiles, deletedPaths []string)
+type evaluateChangesFunc func(events []fsnotify.Event, path string, fileIgnores []string, watcher fileWatcher) (changedFiles, deletedPaths []string)
 
 // processEventsFunc processes the events received on the watcher. It uses the WatchParameters to trigger watch handler and writes to out
 // It returns a Duration after which to recall in case of error
@@ -116,15 +156,12 @@ func (o *WatchClient) WatchAndPush(out io.Writer, parameters WatchParameters, ct
 
 	var err error
 	if parameters.WatchFiles {
-		o.sourcesWatcher, err = getFullSourcesWatcher(parameters.Path, parameters.FileIgnores)
+		o.sourcesWatcher, err = o.getSourcesWatcher(out, parameters.Path, parameters.FileIgnores)
 		if err != nil {
 			return err
 		}
 	} else {
-		o.sourcesWatcher, err = fsnotify.NewWatcher()
-		if err != nil {
-			return err
-		}
+		o.sourcesWatcher = noopFileWatcher{}
 	}
 	defer o.sourcesWatcher.Close()
 
@@ -144,11 +181,12 @@ func (o *WatchClient) WatchAndPush(out io.Writer, parameters WatchParameters, ct
 		o.podWatcher = NewNoOpWatcher()
 	}
 
-	o.devfileWatcher, err = fsnotify.NewWatcher()
-	if err != nil {
-		return err
-	}
 	if parameters.WatchFiles {
+		o.devfileWatcher, err = o.getDevfileWatcher()
+		if err != nil {
+			return err
+		}
+		defer o.devfileWatcher.Close()
 		var devfileFiles []string
 		devfileFiles, err = libdevfile.GetReferencedLocalFiles(parameters.InitialDevfileObj)
 		if err != nil {
@@ -161,6 +199,8 @@ func (o *WatchClient) WatchAndPush(out io.Writer, parameters WatchParameters, ct
 				klog.V(4).Infof("error adding watcher for path %s: %v", f, err)
 			}
 		}
+	} else {
+		o.devfileWatcher = noopFileWatcher{}
 	}
 
 	if parameters.WatchCluster {
@@ -180,6 +220,55 @@ func (o *WatchClient) WatchAndPush(out io.Writer, parameters WatchParameters, ct
 	return o.eventWatcher(ctx, parameters, out, evaluateFileChanges, o.processEvents, componentStatus)
 }
 
+// getSourcesWatcher returns the watcher of the source files in path, except the ones matching fileIgnores.
+// Filesystem notifications are used, unless the WatchMode preference is set to polling, or a limit of the system
+// on notifications is reached; the files are then polled at the interval defined by the WatchPollingInterval preference.
+func (o *WatchClient) getSourcesWatcher(out io.Writer, path string, fileIgnores []string) (fileWatcher, error) {
+	interval := o.prefClient.GetWatchPollingInterval()
+	if o.prefClient.GetWatchMode() == preference.WatchModePolling {
+		klog.V(2).Infof("polling the source files every %s, as requested by the %s preference", interval, preference.WatchModeSetting)
+		log.Finfof(out, "Polling the source files for changes every %s", interval)
+		return o.newPollingSourcesWatcher(path, fileIgnores, interval), nil
+	}
+
+	watcher, err := getFullSourcesWatcher(path, fileIgnores)
+	if errors.Is(err, errWatchLimitReached) {
+		klog.V(2).Infof("falling back to polling the source files every %s: %v", interval, err)
+		log.Fwarning(out, fmt.Sprintf("Unable to watch the source files with filesystem notifications: %v\n"+
+			"Polling the source files for changes every %s instead. On Linux, the limits can be raised with the "+
+			"fs.inotify.max_user_watches and fs.inotify.max_user_instances kernel parameters", err, interval))
+		return o.newPollingSourcesWatcher(path, fileIgnores, interval), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	klog.V(2).Infof("watching the source files with filesystem notifications")
+	return watcher, nil
+}
+
+func (o *WatchClient) newPollingSourcesWatcher(path string, fileIgnores []string, interval time.Duration) fileWatcher {
+	o.polling = true
+	watcher := newPollingWatcher(path, fileIgnores, interval)
+	_ = watcher.Add(path)
+	return watcher
+}
+
+// getDevfileWatcher returns the watcher of the Devfile and of the files it references,
+// polling them if the source files are polled, or if filesystem notifications are not available
+func (o *WatchClient) getDevfileWatcher() (fileWatcher, error) {
+	if !o.polling {
+		watcher, err := newNotifyWatcher()
+		if err == nil {
+			return watcher, nil
+		}
+		if !errors.Is(err, errWatchLimitReached) {
+			return nil, err
+		}
+		klog.V(2).Infof("falling back to polling the Devfile: %v", err)
+	}
+	return newPollingWatcher("", nil, o.prefClient.GetWatchPollingInterval()), nil
+}
+
 // eventWatcher loops till the context's Done channel indicates it to stop looping, at which point it performs cleanup.
 // While looping, it listens for filesystem events and processes these events using the WatchParameters to push to the remote pod.
 // It outputs any logs to the out io Writer
@@ -219,9 +308,23 @@ func (o *WatchClient) eventWatcher(
 
 	podsPhases := NewPodPhases()
 
+	// syncRequests are the sync requests received from the control API, answered once the files are synced
+	var syncRequests []ControlRequest
+	defer func() {
+		respondControlRequests(syncRequests, componentStatus, parameters.Debug, errors.New("the session is stopped"))
+	}()
+
+	// syncBackTick fires periodically to check the files changed in the container, if paths to sync back are defined
+	var syncBackTick <-chan time.Time
+	if len(parameters.SyncBackPaths) > 0 && parameters.SyncBackHandler != nil {
+		syncBackTicker := time.NewTicker(syncBackInterval)
+		defer syncBackTicker.Stop()
+		syncBackTick = syncBackTicker.C
+	}
+
 	for {
 		select {
-		case event := <-o.sourcesWatcher.Events:
+		case event := <-o.sourcesWatcher.Events():
 			events = append(events, event)
 			// We are waiting for more events in this interval
 			sourcesTimer.Reset(100 * time.Millisecond)
@@ -230,6 +333,9 @@ func (o *WatchClient) eventWatcher(
 			// timer has fired
 			if !componentCanSyncFile(componentStatus.State) {
 				klog.V(4).Infof("State of component is %q, don't sync sources", componentStatus.State)
+				respondControlRequests(syncRequests, componentStatus, parameters.Debug,
+					fmt.Errorf("the files cannot be synced while the component is in state %q", componentStatus.State))
+				syncRequests = nil
 				continue
 			}
 
@@ -237,6 +343,14 @@ func (o *WatchClient) eventWatcher(
 			if !o.forceSync {
 				// first find the files that have changed (also includes the ones newly created) or deleted
 				changedFiles, deletedPaths = evaluateChangesHandler(events, parameters.Path, parameters.FileIgnores, o.sourcesWatcher)
+				// the files copied from the container are already synced
+				if len(o.syncedBackFiles) > 0 {
+					changedFiles = o.removeSyncedBackFiles(changedFiles)
+					if len(changedFiles) == 0 && len(deletedPaths) == 0 {
+						events = []fsnotify.Event{}
+						continue
+					}
+				}
 				// process the changes and sync files with remote pod
 				if len(changedFiles) == 0 && len(deletedPaths) == 0 {
 					continue
@@ -247,6 +361,8 @@ func (o *WatchClient) eventWatcher(
 			fmt.Fprintf(out, "Pushing files...\n\n")
 			retry, err := processEventsHandler(ctx, changedFiles, deletedPaths, parameters, out, &componentStatus, expBackoff)
 			o.forceSync = false
+			respondControlRequests(syncRequests, componentStatus, parameters.Debug, err)
+			syncRequests = nil
 			if err != nil {
 				return err
 			}
@@ -262,14 +378,43 @@ func (o *WatchClient) eventWatcher(
 				<-retryTimer.C
 			}
 
-		case watchErr := <-o.sourcesWatcher.Errors:
+		case watchErr := <-o.sourcesWatcher.Errors():
 			return watchErr
 
+		case <-syncBackTick:
+			if componentStatus.State != StateReady || o.forceSync {
+				klog.V(4).Infof("State of component is %q, don't sync files back", componentStatus.State)
+				continue
+			}
+			o.syncBack(ctx, parameters, out)
+
 		case key := <-o.keyWatcher:
-			if key == 'p' {
+			if key == keyPush {
+				o.forceSync = true
+				sourcesTimer.Reset(100 * time.Millisecond)
+				continue
+			}
+			if o.processKey(ctx, key, &parameters, out, &componentStatus) {
+				// the component is updated the same way as when the deployment changes
+				deployTimer.Reset(time.Millisecond)
+			}
+
+		case result := <-parameters.ProbeResults:
+			displayProbeResult(out, result)
+
+		case request := <-parameters.ControlRequests:
+			if request.Action == ControlActionSync {
+				// the response is sent once the files are synced
+				syncRequests = append(syncRequests, request)
 				o.forceSync = true
 				sourcesTimer.Reset(100 * time.Millisecond)
+				continue
 			}
+			push, err := o.processControlRequest(ctx, request, &parameters, out, &componentStatus)
+			if push {
+				deployTimer.Reset(time.Millisecond)
+			}
+			respondControlRequests([]ControlRequest{request}, componentStatus, parameters.Debug, err)
 
 		case ev := <-o.deploymentWatcher.ResultChan():
 			switch obj := ev.Object.(type) {
@@ -294,7 +439,7 @@ func (o *WatchClient) eventWatcher(
 				<-retryTimer.C
 			}
 
-		case <-o.devfileWatcher.Events:
+		case <-o.devfileWatcher.Events():
 			devfileTimer.Reset(100 * time.Millisecond)
 
 		case <-devfileTimer.C:
@@ -330,12 +475,14 @@ func (o *WatchClient) eventWatcher(
 					return errors.New("unable to decode watch event")
 				}
 				podsPhases.Delete(out, pod)
+				reportPodStatus(pod, true)
 			case watch.Added, watch.Modified:
 				pod, ok := ev.Object.(*corev1.Pod)
 				if !ok {
 					return errors.New("unable to decode watch event")
 				}
 				podsPhases.Add(out, pod.GetCreationTimestamp(), pod)
+				reportPodStatus(pod, false)
 			}
 
 		case ev := <-o.warningsWatcher.ResultChan():
@@ -352,7 +499,7 @@ func (o *WatchClient) eventWatcher(
 				}
 			}
 
-		case watchErr := <-o.devfileWatcher.Errors:
+		case watchErr := <-o.devfileWatcher.Errors():
 			return watchErr
 
 		case <-ctx.Done():
@@ -363,11 +510,11 @@ func (o *WatchClient) eventWatcher(
 
 // evaluateFileChanges evaluates any file changes for the events. It ignores the files in fileIgnores slice related to path, and removes
 // any deleted paths from the watcher
-func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) ([]string, []string) {
+func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []string, watcher fileWatcher) ([]string, []string) {
 	var changedFiles []string
 	var deletedPaths []string
 
-	ignoreMatcher := gitignore.CompileIgnoreLines(fileIgnores...)
+	ignoreMatcher := ignore.NewMatcher(fileIgnores...)
 
 	for _, event := range events {
 		klog.V(4).Infof("filesystem watch event: %s", event)
@@ -392,7 +539,7 @@ func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []str
 		if err != nil {
 			watchError = fmt.Errorf("unable to get relative path of %q on %q", event.Name, path)
 		}
-		matched := ignoreMatcher.MatchesPath(rel)
+		matched := ignoreMatcher.Matches(rel, isDir(event.Name))
 		if !alreadyInChangedFiles && !matched && !isIgnoreEvent {
 			// Append the new file change event to changedFiles if and only if the event is not a file remove event
 			if event.Op&fsnotify.Remove != fsnotify.Remove {
@@ -427,6 +574,58 @@ func evaluateFileChanges(events []fsnotify.Event, path string, fileIgnores []str
 	return changedFiles, deletedPaths
 }
 
+// syncBack copies the files changed in the container under the paths to sync back into the local directory.
+// The conflicts and the errors are reported only when they first occur.
+func (o *WatchClient) syncBack(ctx context.Context, parameters WatchParameters, out io.Writer) {
+	changedFiles, conflicts, err := parameters.SyncBackHandler(ctx, parameters)
+	if err != nil {
+		klog.V(4).Infof("Error from sync back: %v", err)
+		if err.Error() != o.syncBackError {
+			log.Fwarning(out, fmt.Sprintf("Unable to sync files back from the container: %v", err))
+		}
+		o.syncBackError = err.Error()
+		return
+	}
+	o.syncBackError = ""
+
+	if o.syncedBackFiles == nil {
+		o.syncedBackFiles = map[string]bool{}
+	}
+	for _, file := range changedFiles {
+		o.syncedBackFiles[filepath.Join(parameters.Path, filepath.FromSlash(file))] = true
+		fmt.Fprintf(out, "File %s synced back from the container\n", file)
+	}
+
+	var newConflicts []string
+	currentConflicts := map[string]bool{}
+	for _, file := range conflicts {
+		currentConflicts[file] = true
+		if !o.syncBackConflicts[file] {
+			newConflicts = append(newConflicts, file)
+			log.Fwarning(out, fmt.Sprintf("File %s was modified both locally and in the container, the local version is kept", file))
+		}
+	}
+	o.syncBackConflicts = currentConflicts
+
+	if len(changedFiles) > 0 || len(newConflicts) > 0 {
+		machineoutput.NewMachineEventLoggingClient().FilesSyncedBack(changedFiles, newConflicts, machineoutput.TimestampNow())
+	}
+}
+
+// removeSyncedBackFiles removes the files copied from the container from changedFiles,
+// and forgets these files, whose events may be received in several batches
+func (o *WatchClient) removeSyncedBackFiles(changedFiles []string) []string {
+	var result []string
+	for _, file := range changedFiles {
+		if !o.syncedBackFiles[file] {
+			result = append(result, file)
+			continue
+		}
+		delete(o.syncedBackFiles, file)
+	}
+	return result
+}
+
 func (o *WatchClient) processEvents(
 	ctx context.Context,
 	changedFiles, deletedPaths []string,
@@ -454,6 +653,8 @@ func (o *WatchClient) processEvents(
 		DevfileScanIndexForWatch: !hasFirstSuccessfulPushOccurred,
 		Debug:                    parameters.Debug,
 		RandomPorts:              parameters.RandomPorts,
+		CustomForwardedPorts:     parameters.CustomForwardedPorts,
+		CustomAddress:            parameters.CustomAddress,
 		ErrOut:                   parameters.ErrOut,
 	}
 	oldStatus := *componentStatus
@@ -477,6 +678,7 @@ func (o *WatchClient) processEvents(
 		} else {
 			if parameters.WatchFiles {
 				fmt.Fprintf(out, "%s - %s\n\n", PushErrorString, err.Error())
+				machineoutput.NewMachineEventLoggingClient().ReportError(err, machineoutput.TimestampNow())
 			} else {
 				return nil, err
 			}
@@ -521,6 +723,12 @@ func shouldIgnoreEvent(event fsnotify.Event) (ignoreEvent bool) {
 	return ignoreEvent
 }
 
+// isDir returns true if the path is an existing directory
+func isDir(path string) bool {
+	stat, err := os.Stat(path)
+	return err == nil && stat.IsDir()
+}
+
 func removeDuplicates(input []string) []string {
 	valueMap := map[string]string{}
 	for _, str := range input {
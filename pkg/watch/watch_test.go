@@ -3,17 +3,25 @@ package watch
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"path/filepath"
+	"strings"
 	"testing"
 	"time"
 
+	"github.com/golang/mock/gomock"
+	"github.com/google/go-cmp/cmp"
+
 	"k8s.io/apimachinery/pkg/watch"
 
+	"github.com/redhat-developer/odo/pkg/preference"
+
 	"github.com/fsnotify/fsnotify"
 )
 
-func evaluateChangesHandler(events []fsnotify.Event, path string, fileIgnores []string, watcher *fsnotify.Watcher) ([]string, []string) {
+func evaluateChangesHandler(events []fsnotify.Event, path string, fileIgnores []string, watcher fileWatcher) ([]string, []string) {
 	var changedFiles []string
 	var deletedPaths []string
 
@@ -132,11 +140,11 @@ func Test_eventWatcher(t *testing.T) {
 			}
 
 			o := WatchClient{
-				sourcesWatcher:    watcher,
+				sourcesWatcher:    &notifyWatcher{watcher: watcher},
 				deploymentWatcher: fakeWatcher{},
 				podWatcher:        fakeWatcher{},
 				warningsWatcher:   fakeWatcher{},
-				devfileWatcher:    fileWatcher,
+				devfileWatcher:    &notifyWatcher{watcher: fileWatcher},
 				keyWatcher:        make(chan byte),
 			}
 			err := o.eventWatcher(ctx, tt.args.parameters, out, evaluateChangesHandler, processEventsHandler, componentStatus)
@@ -151,3 +159,147 @@ func Test_eventWatcher(t *testing.T) {
 		})
 	}
 }
+
+func Test_eventWatcher_syncRequest(t *testing.T) {
+	watcher, _ := fsnotify.NewWatcher()
+	fileWatcher, _ := fsnotify.NewWatcher()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	synced := make(chan struct{})
+	processEvents := func(ctx context.Context, changedFiles, deletedPaths []string, _ WatchParameters, out io.Writer, componentStatus *ComponentStatus, backo *ExpBackoff) (*time.Duration, error) {
+		componentStatus.State = StateReady
+		close(synced)
+		return nil, nil
+	}
+
+	requests := make(chan ControlRequest)
+	responses := make(chan ControlResponse, 1)
+	go func() {
+		requests <- ControlRequest{Action: ControlActionSync, Response: responses}
+	}()
+
+	o := WatchClient{
+		sourcesWatcher:    &notifyWatcher{watcher: watcher},
+		deploymentWatcher: fakeWatcher{},
+		podWatcher:        fakeWatcher{},
+		warningsWatcher:   fakeWatcher{},
+		devfileWatcher:    &notifyWatcher{watcher: fileWatcher},
+		keyWatcher:        make(chan byte),
+	}
+	done := make(chan error)
+	go func() {
+		done <- o.eventWatcher(ctx, WatchParameters{ControlRequests: requests}, &bytes.Buffer{}, evaluateChangesHandler, processEvents, ComponentStatus{State: StateReady})
+	}()
+
+	select {
+	case response := <-responses:
+		select {
+		case <-synced:
+		default:
+			t.Fatal("the response is sent before the files are synced")
+		}
+		if response.Err != nil {
+			t.Errorf("unexpected error: %v", response.Err)
+		}
+		if response.Status.State != StateReady {
+			t.Errorf("state = %q, want %q", response.Status.State, StateReady)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timeout waiting for the response")
+	}
+	cancel()
+	<-done
+}
+
+func TestWatchClient_syncBack(t *testing.T) {
+	results := []struct {
+		changedFiles []string
+		conflicts    []string
+		err          error
+	}{
+		{changedFiles: []string{"gen/a.go"}, conflicts: []string{"gen/b.go"}},
+		{conflicts: []string{"gen/b.go"}},
+		{err: errors.New("container not running")},
+		{err: errors.New("container not running")},
+	}
+	call := 0
+	parameters := WatchParameters{
+		Path: "/project",
+		SyncBackHandler: func(context.Context, WatchParameters) ([]string, []string, error) {
+			result := results[call]
+			call++
+			return result.changedFiles, result.conflicts, result.err
+		},
+	}
+
+	o := WatchClient{}
+	out := &bytes.Buffer{}
+	for range results {
+		o.syncBack(context.Background(), parameters, out)
+	}
+
+	gotOut := out.String()
+	for _, want := range []string{"File gen/a.go synced back from the container", "File gen/b.go was modified both locally and in the container", "container not running"} {
+		if n := strings.Count(gotOut, want); n != 1 {
+			t.Errorf("%q should be displayed once, found %d times in %q", want, n, gotOut)
+		}
+	}
+
+	o.syncedBackFiles[filepath.Join("/project", "gen", "c.go")] = true
+	changedFiles := o.removeSyncedBackFiles([]string{filepath.Join("/project", "gen", "a.go"), filepath.Join("/project", "main.go")})
+	if diff := cmp.Diff([]string{filepath.Join("/project", "main.go")}, changedFiles); diff != "" {
+		t.Errorf("removeSyncedBackFiles() mismatch (-want +got):\n%s", diff)
+	}
+	// the events of the other synced back files may be received later
+	changedFiles = o.removeSyncedBackFiles([]string{filepath.Join("/project", "gen", "c.go")})
+	if len(changedFiles) != 0 {
+		t.Errorf("removeSyncedBackFiles() = %v, want no file", changedFiles)
+	}
+	if len(o.syncedBackFiles) != 0 {
+		t.Errorf("synced back files should be forgotten, got %v", o.syncedBackFiles)
+	}
+}
+
+func TestWatchClient_getSourcesWatcher(t *testing.T) {
+	tests := []struct {
+		name        string
+		watchMode   string
+		wantPolling bool
+		wantOut     string
+	}{
+		{
+			name:      "filesystem notifications by default",
+			watchMode: preference.WatchModeAuto,
+		},
+		{
+			name:        "polling requested by the preference",
+			watchMode:   preference.WatchModePolling,
+			wantPolling: true,
+			wantOut:     "Polling the source files for changes every 2s",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			prefClient := preference.NewMockClient(ctrl)
+			prefClient.EXPECT().GetWatchMode().Return(tt.watchMode).AnyTimes()
+			prefClient.EXPECT().GetWatchPollingInterval().Return(2 * time.Second).AnyTimes()
+
+			o := NewWatchClient(nil, prefClient, nil)
+			out := &bytes.Buffer{}
+			watcher, err := o.getSourcesWatcher(out, t.TempDir(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer watcher.Close()
+
+			if _, polling := watcher.(*pollingWatcher); polling != tt.wantPolling || o.polling != tt.wantPolling {
+				t.Errorf("getSourcesWatcher() returned %T, want polling: %v", watcher, tt.wantPolling)
+			}
+			if !strings.Contains(out.String(), tt.wantOut) {
+				t.Errorf("getSourcesWatcher() output %q should contain %q", out.String(), tt.wantOut)
+			}
+		})
+	}
+}
@@ -154,3 +154,7 @@ func (cw *CmdWrapper) Out() string {
 func (cw *CmdWrapper) Err() string {
 	return string(cw.session.Wait().Err.Contents())
 }
+
+func (cw *CmdWrapper) ExitCode() int {
+	return cw.session.Wait().ExitCode()
+}
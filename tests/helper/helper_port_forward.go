@@ -0,0 +1,51 @@
+package helper
+
+import (
+	"time"
+
+	"github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+)
+
+type PortForwardSession struct {
+	session *gexec.Session
+	stopped bool
+}
+
+// StartPortForward starts a session with `odo port-forward` and waits for the ports to be forwarded
+// It returns a session structure, and the forwarded ports, by container port
+func StartPortForward(opts ...string) (PortForwardSession, map[string]string, error) {
+	args := []string{"port-forward"}
+	args = append(args, opts...)
+	session := CmdRunner("odo", args...)
+	WaitForOutputToContain("Forwarding from", 180, 10, session)
+	result := PortForwardSession{
+		session: session,
+	}
+	outContents := session.Out.Contents()
+	err := session.Out.Clear()
+	if err != nil {
+		return PortForwardSession{}, nil, err
+	}
+	return result, getPorts(string(outContents)), nil
+}
+
+// WaitRestart waits for the port forwarding to be restarted, and returns the forwarded ports, by container port
+func (o PortForwardSession) WaitRestart() map[string]string {
+	WaitForOutputToContain("Forwarding from", 240, 10, o.session)
+	outContents := o.session.Out.Contents()
+	err := o.session.Out.Clear()
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	return getPorts(string(outContents))
+}
+
+// Stop the `odo port-forward` session cleanly (equivalent as hitting Ctrl-c), and waits for its end
+func (o *PortForwardSession) Stop() {
+	if o.stopped {
+		return
+	}
+	err := terminateProc(o.session)
+	gomega.Expect(err).NotTo(gomega.HaveOccurred())
+	o.session.Wait(1 * time.Minute)
+	o.stopped = true
+}
@@ -85,6 +85,48 @@ var _ = Describe("odo dev command tests", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
+		for _, podman := range []bool{true, false} {
+			podman := podman
+			It("should output machine-readable events with -o json", helper.LabelPodmanIf(podman, func() {
+				args := []string{"dev", "--random-ports", "-o", "json"}
+				if podman {
+					args = append(args, "--platform", "podman")
+					helper.EnableExperimentalMode()
+					defer helper.ResetExperimentalMode()
+				}
+				session := helper.CmdRunner("odo", args...)
+				defer func() {
+					session.Interrupt()
+					session.Wait(3 * time.Minute)
+				}()
+				helper.WaitForOutputToContain(`"portsForwarded"`, 360, 10, session)
+				helper.WaitForOutputToContain(`"reconcileComplete"`, 180, 10, session)
+
+				helper.ReplaceString(filepath.Join(commonVar.Context, "server.js"), "App started", "App is super started")
+				helper.WaitForOutputToContain(`"filesSynced"`, 180, 10, session)
+
+				out := string(session.Out.Contents())
+				Expect(out).ToNot(ContainSubstring("Forwarding from"))
+				for _, line := range strings.Split(strings.TrimSpace(out), "\n") {
+					Expect(helper.IsJSON(line)).To(BeTrue(), "line %q is not valid JSON", line)
+					helper.JsonPathContentIs(line, "schemaVersion", "1")
+				}
+				Expect(out).To(ContainSubstring(`"reconcileStart"`))
+				Expect(out).To(ContainSubstring(`"devFileCommandExecutionBegin"`))
+				Expect(out).To(ContainSubstring(`"devFileCommandExecutionComplete"`))
+				Expect(out).To(ContainSubstring("server.js"))
+			}))
+
+			It("should report the readiness of the endpoints", helper.LabelPodmanIf(podman, func() {
+				err := helper.RunDevMode(helper.DevSessionOpts{
+					RunOnPodman: podman,
+				}, func(session *gexec.Session, outContents, errContents []byte, ports map[string]string) {
+					helper.WaitForOutputToContain(`Endpoint "3000-tcp" is ready on 127.0.0.1:`, 180, 10, session)
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}))
+		}
+
 		for _, podman := range []bool{true, false} {
 			podman := podman
 			It("should use the index information from previous push operation", helper.LabelPodmanIf(podman, func() {
@@ -106,6 +148,31 @@ var _ = Describe("odo dev command tests", func() {
 			}))
 		}
 
+		for _, podman := range []bool{true, false} {
+			podman := podman
+			It("should sync back the files changed in the container under the paths passed with --sync-back", helper.LabelPodmanIf(podman, func() {
+				err := helper.RunDevMode(helper.DevSessionOpts{
+					CmdlineArgs: []string{"--sync-back", "gen"},
+					RunOnPodman: podman,
+				}, func(session *gexec.Session, outContents, errContents []byte, ports map[string]string) {
+					component := helper.NewComponent(cmpName, "app", labels.ComponentDevMode, commonVar.Project, commonVar.CliRunner)
+					component.Exec("runtime", []string{"sh", "-c", "mkdir -p /projects/gen && echo generated > /projects/gen/file.txt"}, pointer.Bool(true))
+
+					Eventually(func(g Gomega) {
+						content, readErr := os.ReadFile(filepath.Join(commonVar.Context, "gen", "file.txt"))
+						g.Expect(readErr).ToNot(HaveOccurred())
+						g.Expect(string(content)).To(Equal("generated\n"))
+						g.Expect(string(session.Out.Contents())).To(ContainSubstring("File gen/file.txt synced back from the container"))
+					}).WithTimeout(time.Minute).WithPolling(2 * time.Second).Should(Succeed())
+
+					indexAfterSyncBack, readErr := util.ReadFileIndex(filepath.Join(commonVar.Context, ".odo", "odo-file-index.json"))
+					Expect(readErr).ToNot(HaveOccurred())
+					Expect(indexAfterSyncBack.Files).To(HaveKey(filepath.Join("gen", "file.txt")))
+				})
+				Expect(err).ToNot(HaveOccurred())
+			}))
+		}
+
 		It("ensure that index information is updated", func() {
 			err := helper.RunDevMode(helper.DevSessionOpts{}, func(session *gexec.Session, outContents, errContents []byte, ports map[string]string) {
 				indexAfterPush, err := util.ReadFileIndex(filepath.Join(commonVar.Context, ".odo", "odo-file-index.json"))
@@ -205,7 +272,7 @@ var _ = Describe("odo dev command tests", func() {
 				stdout := res.Out()
 				stderr := res.Err()
 				Expect(stdout).To(ContainSubstring("Cleaning"))
-				Expect(stderr).To(ContainSubstring("unable to save forwarded ports to state file"))
+				Expect(stderr).To(ContainSubstring("unable to save the session to the state file"))
 			})
 		})
 
@@ -481,6 +548,41 @@ ComponentSettings:
 					})
 				})
 			}))
+
+			When("odo dev is executed with the WatchMode preference set to polling", helper.LabelPodmanIf(podman, func() {
+
+				var devSession helper.DevSession
+
+				BeforeEach(func() {
+					helper.Cmd("odo", "preference", "set", "-f", "WatchMode", "polling").ShouldPass()
+					var err error
+					devSession, _, _, _, err = helper.StartDevMode(helper.DevSessionOpts{
+						RunOnPodman: podman,
+					})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				AfterEach(func() {
+					devSession.Stop()
+					devSession.WaitEnd()
+				})
+
+				When("a file in component directory is modified", func() {
+
+					BeforeEach(func() {
+						helper.ReplaceString(filepath.Join(commonVar.Context, "server.js"), "App started", "App is super started")
+					})
+
+					It("should poll the source files and push the change", func() {
+						stdout, _, _, err := devSession.WaitSync()
+						Expect(err).ToNot(HaveOccurred())
+						Expect(string(stdout)).To(ContainSubstring("Polling the source files for changes every 1s"))
+						component := helper.NewComponent(cmpName, "app", labels.ComponentDevMode, commonVar.Project, commonVar.CliRunner)
+						execResult, _ := component.Exec("runtime", []string{"cat", "/projects/server.js"}, pointer.Bool(true))
+						Expect(execResult).To(ContainSubstring("App is super started"))
+					})
+				})
+			}))
 		}
 
 		When("a delay is necessary for the component to start and running odo dev", func() {
@@ -738,17 +840,18 @@ ComponentSettings:
 							})
 
 							It("should react on the Devfile modification", func() {
+								By("not warning users that odo dev needs to be restarted", func() {
+									warning := "Please restart 'odo dev'"
+									Expect(stdout).ShouldNot(ContainSubstring(warning))
+									Expect(stderr).ShouldNot(ContainSubstring(warning))
+								})
 								if podman {
-									By("warning users that odo dev needs to be restarted", func() {
-										Expect(stdout).To(ContainSubstring(
-											"Detected changes in the Devfile, but this is not supported yet on Podman. Please restart 'odo dev' for such changes to be applied."))
+									By("updating the pod", func() {
+										containerName := fmt.Sprintf("%s-app-runtime", cmpName)
+										out := helper.Cmd("podman", "inspect", containerName, "--format", "{{.HostConfig.Memory}}").ShouldPass().Out()
+										Expect(out).To(ContainSubstring(fmt.Sprint(1023 * 1024 * 1024)))
 									})
 								} else {
-									By("not warning users that odo dev needs to be restarted", func() {
-										warning := "Please restart 'odo dev'"
-										Expect(stdout).ShouldNot(ContainSubstring(warning))
-										Expect(stderr).ShouldNot(ContainSubstring(warning))
-									})
 									By("updating the pod", func() {
 										podName := commonVar.CliRunner.GetRunningPodNameByComponent(cmpName, commonVar.Project)
 										bufferOutput := commonVar.CliRunner.Run("get", "pods", podName, "-o", "jsonpath='{.spec.containers[0].resources.requests.memory}'").Out.Contents()
@@ -850,9 +953,6 @@ ComponentSettings:
 
 							By("not warning users that odo dev needs to be restarted because the Devfile has not changed", func() {
 								warning := "Please restart 'odo dev'"
-								if podman {
-									warning = "Detected changes in the Devfile, but this is not supported yet on Podman. Please restart 'odo dev' for such changes to be applied."
-								}
 								Expect(stdout).ShouldNot(ContainSubstring(warning))
 								Expect(stderr).ShouldNot(ContainSubstring(warning))
 							})
@@ -1943,6 +2043,46 @@ CMD ["npm", "start"]
 				})
 			}))
 
+			When("running odo dev with --auto-restart and a run command exiting with an error - "+devfileHandlerCtx.name, helper.LabelPodmanIf(podman, func() {
+				var session helper.DevSession
+				BeforeEach(func() {
+					devfileCmpName := helper.RandString(6)
+					helper.CopyExampleDevFile(
+						filepath.Join("source", "devfiles", "nodejs", "devfileCompositeRunAndDebug.yaml"),
+						filepath.Join(commonVar.Context, "devfile.yaml"),
+						helper.DevfileMetadataNameSetter(devfileCmpName))
+					helper.CopyExample(filepath.Join("source", "devfiles", "nodejs", "project"), commonVar.Context)
+					if devfileHandlerCtx.sourceHandler != nil {
+						devfileHandlerCtx.sourceHandler(commonVar.Context, devfileCmpName)
+					}
+					var err error
+					session, _, _, _, err = helper.StartDevMode(helper.DevSessionOpts{
+						CmdlineArgs: []string{"--auto-restart"},
+						RunOnPodman: podman,
+					})
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				AfterEach(func() {
+					session.Stop()
+					session.WaitEnd()
+				})
+
+				It("should restart the command with a backoff", func() {
+					var stdout, stderr string
+					Eventually(func(g Gomega) {
+						out, errOut, _, err := session.GetInfo()
+						g.Expect(err).ToNot(HaveOccurred())
+						stdout += string(out)
+						stderr += string(errOut)
+						g.Expect(stderr).To(ContainSubstring("Devfile command \"echo\" exited with code 1"))
+						g.Expect(stdout).To(ContainSubstring("Restarting Devfile command \"echo\""))
+						g.Expect(stderr).To(ContainSubstring("Devfile command \"echo\" crashed 11 times, it will not be restarted until the next change of the source files"))
+					}).WithTimeout(3 * time.Minute).WithPolling(5 * time.Second).Should(Succeed())
+					Expect(stderr).To(ContainSubstring("intentional-error-message"))
+				})
+			}))
+
 			// This test does not pass on podman. There are flaky permissions issues on source volume mounted by both components sleeper-run and runtime
 			When("running build and run commands as composite in different containers and a shared volume - "+devfileHandlerCtx.name, helper.LabelPodmanIf(podman, func() {
 				var session helper.DevSession
@@ -2808,11 +2948,11 @@ CMD ["npm", "start"]
 					devSession.WaitEnd()
 				})
 
-				It("should remove forwarded ports from state file", func() {
+				It("should remove the session from state file", func() {
 					Expect(helper.VerifyFileExists(stateFile)).To(BeTrue())
 					contentJSON, err := ioutil.ReadFile(stateFile)
 					Expect(err).ToNot(HaveOccurred())
-					helper.JsonPathContentIs(string(contentJSON), "forwardedPorts", "")
+					helper.JsonPathContentIs(string(contentJSON), "sessions", "")
 				})
 			})
 		}))
@@ -2844,14 +2984,140 @@ CMD ["npm", "start"]
 				Expect(helper.VerifyFileExists(stateFile)).To(BeTrue())
 				contentJSON, err := ioutil.ReadFile(stateFile)
 				Expect(err).ToNot(HaveOccurred())
-				helper.JsonPathContentIs(string(contentJSON), "forwardedPorts.0.containerName", "runtime")
-				helper.JsonPathContentIs(string(contentJSON), "forwardedPorts.1.containerName", "runtime")
-				helper.JsonPathContentIs(string(contentJSON), "forwardedPorts.0.localAddress", "127.0.0.1")
-				helper.JsonPathContentIs(string(contentJSON), "forwardedPorts.1.localAddress", "127.0.0.1")
-				helper.JsonPathContentIs(string(contentJSON), "forwardedPorts.0.containerPort", "3000")
-				helper.JsonPathContentIs(string(contentJSON), "forwardedPorts.1.containerPort", "4567")
-				helper.JsonPathContentIsValidUserPort(string(contentJSON), "forwardedPorts.0.localPort")
-				helper.JsonPathContentIsValidUserPort(string(contentJSON), "forwardedPorts.1.localPort")
+				helper.JsonPathContentIs(string(contentJSON), "sessions.0.forwardedPorts.0.containerName", "runtime")
+				helper.JsonPathContentIs(string(contentJSON), "sessions.0.forwardedPorts.1.containerName", "runtime")
+				helper.JsonPathContentIs(string(contentJSON), "sessions.0.forwardedPorts.0.localAddress", "127.0.0.1")
+				helper.JsonPathContentIs(string(contentJSON), "sessions.0.forwardedPorts.1.localAddress", "127.0.0.1")
+				helper.JsonPathContentIs(string(contentJSON), "sessions.0.forwardedPorts.0.containerPort", "3000")
+				helper.JsonPathContentIs(string(contentJSON), "sessions.0.forwardedPorts.1.containerPort", "4567")
+				helper.JsonPathContentIsValidUserPort(string(contentJSON), "sessions.0.forwardedPorts.0.localPort")
+				helper.JsonPathContentIsValidUserPort(string(contentJSON), "sessions.0.forwardedPorts.1.localPort")
+				helper.JsonPathContentIs(string(contentJSON), "sessions.#", "1")
+				if podman {
+					helper.JsonPathContentIs(string(contentJSON), "sessions.0.platform", "podman")
+				} else {
+					helper.JsonPathContentIs(string(contentJSON), "sessions.0.platform", "cluster")
+					helper.JsonPathContentIs(string(contentJSON), "sessions.0.namespace", commonVar.Project)
+				}
+			})
+
+			It("should refuse to start another session on the same platform", func() {
+				args := []string{"dev", "--random-ports"}
+				if podman {
+					args = append(args, "--platform", "podman")
+				}
+				cmd := helper.Cmd("odo", args...)
+				if podman {
+					cmd = cmd.AddEnv("ODO_EXPERIMENTAL_MODE=true")
+				}
+				stderr := cmd.ShouldFail().Err()
+				Expect(stderr).To(ContainSubstring("an odo dev session is already running on"))
+			})
+
+			It("should list the session with odo describe component", func() {
+				args := []string{"describe", "component", "-o", "json"}
+				if podman {
+					args = append(args, "--platform", "podman")
+				}
+				cmd := helper.Cmd("odo", args...)
+				if podman {
+					cmd = cmd.AddEnv("ODO_EXPERIMENTAL_MODE=true")
+				}
+				stdout := cmd.ShouldPass().Out()
+				Expect(helper.IsJSON(stdout)).To(BeTrue())
+				helper.JsonPathContentIs(stdout, "devSessions.#", "1")
+				if podman {
+					helper.JsonPathContentIs(stdout, "devSessions.0.platform", "podman")
+				} else {
+					helper.JsonPathContentIs(stdout, "devSessions.0.platform", "cluster")
+				}
+			})
+		}))
+
+		When("a component with multiple endpoints is run with custom port forwarding", helper.LabelPodmanIf(podman, func() {
+			stateFile := ".odo/devstate.json"
+			var devSession helper.DevSession
+			var ports map[string]string
+			var localPort int
+			BeforeEach(func() {
+				helper.CopyExample(filepath.Join("source", "devfiles", "nodejs", "project-with-multiple-endpoints"), commonVar.Context)
+				if !podman {
+					helper.Cmd("odo", "set", "project", commonVar.Project).ShouldPass()
+				}
+				helper.Cmd("odo", "init", "--name", cmpName, "--devfile-path", helper.GetExamplePath("source", "devfiles", "nodejs", "devfile-with-multiple-endpoints.yaml")).ShouldPass()
+				var err error
+				localPort, err = util.NextFreePort(40001, 50000, nil)
+				Expect(err).ToNot(HaveOccurred())
+				devSession, _, _, ports, err = helper.StartDevMode(helper.DevSessionOpts{
+					CmdlineArgs: []string{"--port-forward", fmt.Sprintf("http-4567=%d", localPort)},
+					RunOnPodman: podman,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				devSession.Stop()
+				devSession.WaitEnd()
+			})
+
+			It("should forward the endpoint on the requested local port", func() {
+				Expect(ports["4567"]).To(Equal(fmt.Sprintf("127.0.0.1:%d", localPort)))
+				Expect(ports["3000"]).ToNot(BeEmpty())
+				contentJSON, err := ioutil.ReadFile(stateFile)
+				Expect(err).ToNot(HaveOccurred())
+				helper.JsonPathContentIs(string(contentJSON), "sessions.0.forwardedPorts.#(containerPort==4567).localPort", strconv.Itoa(localPort))
+			})
+		}))
+
+		When("odo dev is run with an invalid custom port forwarding", helper.LabelPodmanIf(podman, func() {
+			BeforeEach(func() {
+				helper.CopyExampleDevFile(
+					filepath.Join("source", "devfiles", "nodejs", "devfile.yaml"),
+					filepath.Join(commonVar.Context, "devfile.yaml"),
+					helper.DevfileMetadataNameSetter(cmpName))
+			})
+
+			It("should fail when the container port is not an endpoint of the Devfile", func() {
+				args := []string{"dev", "--port-forward", "18080:8080"}
+				if podman {
+					args = append(args, "--platform", "podman")
+				}
+				cmd := helper.Cmd("odo", args...)
+				if podman {
+					cmd = cmd.AddEnv("ODO_EXPERIMENTAL_MODE=true")
+				}
+				stderr := cmd.ShouldFail().Err()
+				Expect(stderr).To(ContainSubstring("no endpoint with container port 8080 in the Devfile"))
+			})
+		}))
+
+		When("odo dev is run with --show-ignored and ignore files in subdirectories", helper.LabelPodmanIf(podman, func() {
+			BeforeEach(func() {
+				helper.CopyExample(filepath.Join("source", "nodejs"), commonVar.Context)
+				helper.CopyExampleDevFile(
+					filepath.Join("source", "devfiles", "nodejs", "devfile.yaml"),
+					filepath.Join(commonVar.Context, "devfile.yaml"),
+					helper.DevfileMetadataNameSetter(cmpName))
+				Expect(helper.CreateFileWithContent(filepath.Join(commonVar.Context, ".gitignore"), "*.log\n")).To(Succeed())
+				helper.MakeDir(filepath.Join(commonVar.Context, "logs"))
+				Expect(helper.CreateFileWithContent(filepath.Join(commonVar.Context, "logs", ".gitignore"), "!keep.log\n")).To(Succeed())
+				Expect(helper.CreateFileWithContent(filepath.Join(commonVar.Context, "logs", "debug.log"), "")).To(Succeed())
+				Expect(helper.CreateFileWithContent(filepath.Join(commonVar.Context, "logs", "keep.log"), "")).To(Succeed())
+			})
+
+			It("should display the ignored files with the rules ignoring them, without starting a session", func() {
+				args := []string{"dev", "--show-ignored"}
+				if podman {
+					args = append(args, "--platform", "podman")
+				}
+				cmd := helper.Cmd("odo", args...)
+				if podman {
+					cmd = cmd.AddEnv("ODO_EXPERIMENTAL_MODE=true")
+				}
+				stdout := cmd.ShouldPass().Out()
+				Expect(stdout).To(MatchRegexp(`logs/debug\.log\s+\.gitignore:1\s+\*\.log`))
+				Expect(stdout).ToNot(ContainSubstring("keep.log"))
+				Expect(helper.VerifyFileExists(filepath.Join(commonVar.Context, ".odo", "devstate.json"))).To(BeFalse())
 			})
 		}))
 
@@ -2909,11 +3175,11 @@ CMD ["npm", "start"]
 	}{
 		{
 			title:     "with run command",
-			resources: []string{"deploy-k8s-resource", "deploy-a-third-k8s-resource", "image-build-component"},
+			resources: []string{"deploy-k8s-resource", "deploy-a-third-k8s-resource"},
 		},
 		{
 			title:     "with debug command",
-			resources: []string{"deploy-another-k8s-resource", "deploy-a-third-k8s-resource", "image-build-component"},
+			resources: []string{"deploy-another-k8s-resource", "deploy-a-third-k8s-resource"},
 			args:      []string{"--debug"},
 		},
 	} {
@@ -2921,6 +3187,7 @@ CMD ["npm", "start"]
 		When("using devfile that contains K8s resource to run it on podman", Label(helper.LabelPodman), func() {
 			BeforeEach(func() {
 				helper.CopyExample(filepath.Join("source", "devfiles", "nodejs", "project"), commonVar.Context)
+				helper.CopyExample(filepath.Join("source", "nodejs", "Dockerfile"), filepath.Join(commonVar.Context, "Dockerfile"))
 				helper.CopyExampleDevFile(
 					filepath.Join("source", "devfiles", "nodejs", "devfile-composite-apply-different-commandgk.yaml"),
 					filepath.Join(commonVar.Context, "devfile.yaml"),
@@ -2928,9 +3195,9 @@ CMD ["npm", "start"]
 			})
 			It(fmt.Sprintf("should show warning about being unable to create the resource when running odo dev %s on podman", ctx.title), func() {
 				err := helper.RunDevMode(helper.DevSessionOpts{RunOnPodman: true, CmdlineArgs: ctx.args}, func(session *gexec.Session, outContents, errContents []byte, ports map[string]string) {
-					Expect(string(errContents)).To(ContainSubstring("Kubernetes components are not supported on Podman. Skipping: "))
+					Expect(string(errContents)).To(ContainSubstring(`Kubernetes resources of kind "Deployment" are not supported on Podman. Skipping: `))
 					Expect(string(errContents)).To(ContainSubstring("Apply Kubernetes components are not supported on Podman. Skipping: "))
-					Expect(string(errContents)).To(ContainSubstring("Apply Image commands are not implemented on Podman. Skipping: "))
+					Expect(string(errContents)).ToNot(ContainSubstring("Apply Image commands are not implemented on Podman"))
 					helper.MatchAllInOutput(string(errContents), ctx.resources)
 				})
 				Expect(err).ToNot(HaveOccurred())
@@ -2939,6 +3206,92 @@ CMD ["npm", "start"]
 
 	}
 
+	When("running odo dev on podman", Label(helper.LabelPodman), func() {
+		BeforeEach(func() {
+			helper.CopyExample(filepath.Join("source", "devfiles", "nodejs", "project"), commonVar.Context)
+			helper.CopyExampleDevFile(
+				filepath.Join("source", "devfiles", "nodejs", "devfile.yaml"),
+				filepath.Join(commonVar.Context, "devfile.yaml"),
+				helper.DevfileMetadataNameSetter(cmpName))
+		})
+		It("should label the volumes of the component", func() {
+			err := helper.RunDevMode(helper.DevSessionOpts{RunOnPodman: true}, func(session *gexec.Session, outContents, errContents []byte, ports map[string]string) {
+				out := helper.Cmd("podman", "volume", "ls", "--format", "{{.Name}}",
+					"--filter", "label=app.kubernetes.io/instance="+cmpName,
+					"--filter", "label=app.kubernetes.io/managed-by=odo").ShouldPass().Out()
+				Expect(out).To(ContainSubstring(fmt.Sprintf("odo-projects-%s-app", cmpName)))
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("volumes of the component already exist on podman", Label(helper.LabelPodman), func() {
+		var volumeName string
+		BeforeEach(func() {
+			helper.CopyExample(filepath.Join("source", "devfiles", "nodejs", "project"), commonVar.Context)
+			helper.CopyExampleDevFile(
+				filepath.Join("source", "devfiles", "nodejs", "devfile.yaml"),
+				filepath.Join(commonVar.Context, "devfile.yaml"),
+				helper.DevfileMetadataNameSetter(cmpName))
+			volumeName = fmt.Sprintf("odo-projects-%s-app", cmpName)
+			helper.Cmd("podman", "volume", "create", volumeName).ShouldPass()
+		})
+		AfterEach(func() {
+			helper.Cmd("podman", "volume", "rm", "--force", volumeName).ShouldPass()
+		})
+		It("should reuse the volumes", func() {
+			err := helper.RunDevMode(helper.DevSessionOpts{RunOnPodman: true}, func(session *gexec.Session, outContents, errContents []byte, ports map[string]string) {
+				Expect(string(outContents)).To(ContainSubstring("Reusing the existing volumes: " + volumeName))
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+		It("should delete the volumes with --clean-volumes", func() {
+			err := helper.RunDevMode(helper.DevSessionOpts{RunOnPodman: true, CmdlineArgs: []string{"--clean-volumes"}}, func(session *gexec.Session, outContents, errContents []byte, ports map[string]string) {
+				Expect(string(outContents)).ToNot(ContainSubstring("Reusing the existing volumes"))
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
+	When("a volume of the component is owned by another component on podman", Label(helper.LabelPodman), func() {
+		var volumeName string
+		BeforeEach(func() {
+			helper.CopyExample(filepath.Join("source", "devfiles", "nodejs", "project"), commonVar.Context)
+			helper.CopyExampleDevFile(
+				filepath.Join("source", "devfiles", "nodejs", "devfile.yaml"),
+				filepath.Join(commonVar.Context, "devfile.yaml"),
+				helper.DevfileMetadataNameSetter(cmpName))
+			volumeName = fmt.Sprintf("odo-projects-%s-app", cmpName)
+			helper.Cmd("podman", "volume", "create", "--label", "app.kubernetes.io/instance=another-component", volumeName).ShouldPass()
+		})
+		AfterEach(func() {
+			helper.Cmd("podman", "volume", "rm", "--force", volumeName).ShouldPass()
+		})
+		It("should fail", func() {
+			errOut := helper.Cmd("odo", "dev", "--platform", "podman", "--random-ports").AddEnv("ODO_EXPERIMENTAL_MODE=true").ShouldFail().Err()
+			Expect(errOut).To(ContainSubstring("volumes already exist and are used by another component"))
+		})
+	})
+
+	When("using devfile that contains a ConfigMap mounted as volume to run it on podman", Label(helper.LabelPodman), func() {
+		BeforeEach(func() {
+			helper.CopyExample(filepath.Join("source", "devfiles", "nodejs", "project"), commonVar.Context)
+			helper.CopyExampleDevFile(
+				filepath.Join("source", "devfiles", "nodejs", "devfile-with-k8s-configmap-volume.yaml"),
+				filepath.Join(commonVar.Context, "devfile.yaml"),
+				helper.DevfileMetadataNameSetter(cmpName))
+		})
+		It("should mount the ConfigMap and warn about the unsupported resources", func() {
+			err := helper.RunDevMode(helper.DevSessionOpts{RunOnPodman: true}, func(session *gexec.Session, outContents, errContents []byte, ports map[string]string) {
+				Expect(string(errContents)).To(ContainSubstring(`Kubernetes resources of kind "MyCustomResource" are not supported on Podman. Skipping: my-custom-resource`))
+				component := helper.NewPodmanComponent(cmpName, "app")
+				stdout, _ := component.Exec("runtime", []string{"cat", "/etc/app-config/greeting"}, pointer.Bool(true))
+				Expect(stdout).To(Equal("hello from configmap"))
+			})
+			Expect(err).ToNot(HaveOccurred())
+		})
+	})
+
 	for _, podman := range []bool{true, false} {
 		podman := podman
 		When("a hotReload capable project is used with odo dev", helper.LabelPodmanIf(podman, func() {
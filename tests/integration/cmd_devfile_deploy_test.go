@@ -196,6 +196,34 @@ ComponentSettings:
 		})
 	})
 
+	When("using a devfile.yaml containing exec commands in the deploy command", func() {
+		BeforeEach(func() {
+			helper.CopyExample(filepath.Join("source", "nodejs"), commonVar.Context)
+			helper.CopyExampleDevFile(
+				filepath.Join("source", "devfiles", "nodejs", "devfile-deploy-exec.yaml"),
+				path.Join(commonVar.Context, "devfile.yaml"),
+				helper.DevfileMetadataNameSetter(cmpName))
+		})
+		It("should run the exec command in a Job and display its logs", func() {
+			stdout := helper.Cmd("odo", "deploy").ShouldPass().Out()
+			Expect(stdout).To(ContainSubstring("Hello from the deploy command, world"))
+			By("deleting the Job once terminated", func() {
+				out := commonVar.CliRunner.Run("get", "jobs", "-n", commonVar.Project, "-o", "name").Wait().Out.Contents()
+				Expect(string(out)).ToNot(ContainSubstring("deploy-exec"))
+			})
+		})
+		It("should fail when the exec command fails", func() {
+			helper.ReplaceString(filepath.Join(commonVar.Context, "devfile.yaml"), "- deployk8s\n      - deploy-exec\n", "- deploy-exec-fail\n      - deployk8s\n")
+			stdout, stderr := helper.Cmd("odo", "deploy").ShouldFail().OutAndErr()
+			Expect(stdout).To(ContainSubstring("This command fails"))
+			Expect(stderr).To(ContainSubstring(`command "deploy-exec-fail" failed`))
+			By("not running the commands following the failing command", func() {
+				out := commonVar.CliRunner.Run("get", "deployments", "-n", commonVar.Project, "-o", "name").Wait().Out.Contents()
+				Expect(string(out)).ToNot(ContainSubstring("my-component"))
+			})
+		})
+	})
+
 	When("recording telemetry data", func() {
 		BeforeEach(func() {
 			helper.CopyExample(filepath.Join("source", "nodejs"), commonVar.Context)
@@ -0,0 +1,88 @@
+package integration
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+
+	"github.com/redhat-developer/odo/tests/helper"
+)
+
+var _ = Describe("odo exec command tests", func() {
+	var cmpName string
+	var commonVar helper.CommonVar
+
+	var _ = BeforeEach(func() {
+		commonVar = helper.CommonBeforeEach()
+		cmpName = helper.RandString(6)
+		helper.Chdir(commonVar.Context)
+		Expect(helper.VerifyFileExists(".odo/env/env.yaml")).To(BeFalse())
+	})
+
+	var _ = AfterEach(func() {
+		helper.CommonAfterEach(commonVar)
+	})
+
+	When("a component is initialized", func() {
+		BeforeEach(func() {
+			helper.CopyExample(filepath.Join("source", "nodejs"), commonVar.Context)
+			helper.Cmd("odo", "init", "--name", cmpName, "--devfile-path", helper.GetExamplePath("source", "devfiles", "nodejs", "devfile.yaml")).ShouldPass()
+		})
+
+		It("should fail if the command is not passed after --", func() {
+			errOut := helper.Cmd("odo", "exec", "ls").ShouldFail().Err()
+			Expect(errOut).To(ContainSubstring("the command to execute must be passed after `--`"))
+		})
+
+		It("should fail if the container does not exist", func() {
+			errOut := helper.Cmd("odo", "exec", "--container", "unknown", "--", "ls").ShouldFail().Err()
+			Expect(errOut).To(ContainSubstring(`no container component named "unknown" found in the Devfile`))
+		})
+
+		It("should fail if odo dev is not running", func() {
+			errOut := helper.Cmd("odo", "exec", "--", "ls").ShouldFail().Err()
+			Expect(errOut).To(ContainSubstring("please check that `odo dev` is running"))
+		})
+
+		for _, podman := range []bool{false, true} {
+			podman := podman
+			When("odo dev is running", helper.LabelPodmanIf(podman, func() {
+				var platformArgs []string
+				BeforeEach(func() {
+					if podman {
+						helper.EnableExperimentalMode()
+						platformArgs = []string{"--platform", "podman"}
+					}
+				})
+
+				AfterEach(func() {
+					if podman {
+						helper.ResetExperimentalMode()
+					}
+				})
+
+				It("should execute commands in the container of the component", func() {
+					err := helper.RunDevMode(helper.DevSessionOpts{RunOnPodman: podman}, func(session *gexec.Session, outContents, errContents []byte, ports map[string]string) {
+						By("executing the command from the directory where the sources are synchronized", func() {
+							out := helper.Cmd("odo", append(append([]string{"exec"}, platformArgs...), "--", "pwd")...).ShouldPass().Out()
+							Expect(out).To(ContainSubstring("/projects"))
+						})
+
+						By("passing the arguments to the command", func() {
+							out := helper.Cmd("odo", append(append([]string{"exec", "--container", "runtime"}, platformArgs...), "--", "ls", "-1", "package.json")...).ShouldPass().Out()
+							Expect(out).To(ContainSubstring("package.json"))
+						})
+
+						By("exiting with the exit code of the command", func() {
+							cmd := helper.Cmd("odo", append(append([]string{"exec"}, platformArgs...), "--", "sh", "-c", "exit 4")...).ShouldFail()
+							Expect(cmd.ExitCode()).To(Equal(4))
+						})
+					})
+					Expect(err).ToNot(HaveOccurred())
+				})
+			}))
+		}
+	})
+})
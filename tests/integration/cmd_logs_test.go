@@ -126,6 +126,48 @@ var _ = Describe("odo logs command tests", func() {
 			})
 		})
 
+		When("running in Dev mode on Podman", helper.LabelPodmanIf(true, func() {
+			var devSession helper.DevSession
+			var err error
+
+			BeforeEach(func() {
+				helper.EnableExperimentalMode()
+				devSession, _, _, _, err = helper.StartDevMode(helper.DevSessionOpts{
+					RunOnPodman: true,
+				})
+				Expect(err).ToNot(HaveOccurred())
+			})
+			AfterEach(func() {
+				devSession.Stop()
+				devSession.WaitEnd()
+				helper.ResetExperimentalMode()
+			})
+			It("should successfully show logs of the running component", func() {
+				out := helper.Cmd("odo", "logs", "--platform", "podman").ShouldPass().Out()
+				Expect(out).To(ContainSubstring("runtime:"))
+
+				out = helper.Cmd("odo", "logs", "--platform", "podman", "--deploy").ShouldPass().Out()
+				Expect(out).To(ContainSubstring("no containers running in the specified mode for the component"))
+			})
+			When("--follow flag is specified", func() {
+				var logsSession helper.LogsSession
+				var err error
+
+				BeforeEach(func() {
+					logsSession, _, _, err = helper.StartLogsFollow("--dev", "--platform", "podman")
+					Expect(err).ToNot(HaveOccurred())
+				})
+				AfterEach(func() {
+					logsSession.Kill()
+				})
+				It("should successfully follow logs of running component", func() {
+					Eventually(func() string {
+						return string(logsSession.OutContents())
+					}, 60*time.Second, 5*time.Second).Should(ContainSubstring("runtime:"))
+				})
+			})
+		}))
+
 		When("running in Deploy mode", func() {
 			BeforeEach(func() {
 				helper.Cmd("odo", "deploy").AddEnv("PODMAN_CMD=echo").ShouldPass()
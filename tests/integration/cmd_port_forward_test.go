@@ -0,0 +1,121 @@
+package integration
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/redhat-developer/odo/tests/helper"
+)
+
+var _ = Describe("odo port-forward command tests", func() {
+	var cmpName string
+	var commonVar helper.CommonVar
+
+	var _ = BeforeEach(func() {
+		commonVar = helper.CommonBeforeEach()
+		cmpName = helper.RandString(6)
+		helper.Chdir(commonVar.Context)
+	})
+
+	var _ = AfterEach(func() {
+		helper.CommonAfterEach(commonVar)
+	})
+
+	When("directory is empty", func() {
+		It("should fail without --name", func() {
+			errOut := helper.Cmd("odo", "port-forward").ShouldFail().Err()
+			Expect(errOut).To(ContainSubstring("The current directory does not represent an odo component"))
+		})
+	})
+
+	When("a component is initialized", func() {
+		BeforeEach(func() {
+			helper.CopyExample(filepath.Join("source", "nodejs"), commonVar.Context)
+			helper.Cmd("odo", "init", "--name", cmpName, "--devfile-path", helper.GetExamplePath("source", "devfiles", "nodejs", "devfile.yaml")).ShouldPass()
+		})
+
+		It("should fail with an invalid value for --running-in", func() {
+			errOut := helper.Cmd("odo", "port-forward", "--running-in", "unknown").ShouldFail().Err()
+			Expect(errOut).To(ContainSubstring("invalid value for --running-in"))
+		})
+
+		It("should fail when the component is not running", func() {
+			errOut := helper.Cmd("odo", "port-forward").ShouldFail().Err()
+			Expect(errOut).To(ContainSubstring("no pod running for the component"))
+		})
+
+		When("the component is running in Dev mode", func() {
+			var devSession helper.DevSession
+
+			BeforeEach(func() {
+				var err error
+				devSession, _, _, _, err = helper.StartDevMode(helper.DevSessionOpts{})
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			AfterEach(func() {
+				devSession.Stop()
+				devSession.WaitEnd()
+			})
+
+			It("should fail to forward the ports of the component running in Deploy mode", func() {
+				errOut := helper.Cmd("odo", "port-forward", "--running-in", "deploy").ShouldFail().Err()
+				Expect(errOut).To(ContainSubstring("no pod running for the component"))
+			})
+
+			When("odo port-forward is running", func() {
+				var pfSession helper.PortForwardSession
+				var ports map[string]string
+
+				BeforeEach(func() {
+					var err error
+					pfSession, ports, err = helper.StartPortForward("--running-in", "dev")
+					Expect(err).ToNot(HaveOccurred())
+				})
+
+				AfterEach(func() {
+					pfSession.Stop()
+				})
+
+				It("should forward the endpoints of the Devfile and record them in the state file", func() {
+					Expect(ports).To(HaveKey("3000"))
+					helper.HttpWaitForWithStatus("http://"+ports["3000"], "Hello from Node.js", 10, 5, 200)
+
+					contentJSON, err := helper.ReadFile(filepath.Join(commonVar.Context, ".odo", "devstate.json"))
+					Expect(err).ToNot(HaveOccurred())
+					helper.JsonPathContentIs(contentJSON, "sessions.1.command", "port-forward")
+					helper.JsonPathContentIs(contentJSON, "sessions.1.namespace", commonVar.Project)
+					helper.JsonPathContentIs(contentJSON, "sessions.1.forwardedPorts.0.containerName", "runtime")
+					helper.JsonPathContentIs(contentJSON, "sessions.1.forwardedPorts.0.containerPort", "3000")
+
+					By("not listing the odo port-forward session as a Dev session", func() {
+						out := helper.Cmd("odo", "describe", "component", "-o", "json").ShouldPass().Out()
+						helper.JsonPathContentIs(out, "devSessions.#", "1")
+					})
+				})
+
+				When("the pod of the component is deleted", func() {
+					BeforeEach(func() {
+						podName := commonVar.CliRunner.GetRunningPodNameByComponent(cmpName, commonVar.Project)
+						commonVar.CliRunner.DeletePod(podName, commonVar.Project)
+					})
+
+					It("should forward the ports of the new pod on the same local ports", func() {
+						newPorts := pfSession.WaitRestart()
+						Expect(newPorts).To(Equal(ports))
+						helper.HttpWaitForWithStatus("http://"+newPorts["3000"], "Hello from Node.js", 20, 5, 200)
+					})
+				})
+
+				It("should remove the session from the state file when stopped", func() {
+					pfSession.Stop()
+					contentJSON, err := helper.ReadFile(filepath.Join(commonVar.Context, ".odo", "devstate.json"))
+					Expect(err).ToNot(HaveOccurred())
+					helper.JsonPathDoesNotExist(contentJSON, "sessions.1")
+				})
+			})
+		})
+	})
+})
@@ -66,7 +66,7 @@ var _ = Describe("odo preference and config command tests", func() {
 				})
 				It("should get the default global config keys", func() {
 					configOutput := helper.Cmd("odo", "preference", "view").ShouldPass().Out()
-					preferences := []string{"UpdateNotification", "Timeout", "PushTimeout", "RegistryCacheTime", "Ephemeral", "ConsentTelemetry"}
+					preferences := []string{"UpdateNotification", "Timeout", "PushTimeout", "RegistryCacheTime", "Ephemeral", "ConsentTelemetry", "SyncMode", "SyncCompression", "WatchMode", "WatchPollingInterval"}
 					helper.MatchAllInOutput(configOutput, preferences)
 					for _, key := range preferences {
 						value := helper.GetPreferenceValue(key)
@@ -78,7 +78,7 @@ var _ = Describe("odo preference and config command tests", func() {
 					stdout, stderr := res.Out(), res.Err()
 					Expect(stderr).To(BeEmpty())
 					Expect(helper.IsJSON(stdout)).To(BeTrue())
-					preferences := []string{"UpdateNotification", "Timeout", "PushTimeout", "RegistryCacheTime", "ConsentTelemetry", "Ephemeral"}
+					preferences := []string{"UpdateNotification", "Timeout", "PushTimeout", "RegistryCacheTime", "ConsentTelemetry", "Ephemeral", "SyncMode", "SyncCompression", "WatchMode", "WatchPollingInterval"}
 					for i, pref := range preferences {
 						helper.JsonPathContentIs(stdout, fmt.Sprintf("preferences.%d.name", i), pref)
 					}
@@ -102,6 +102,10 @@ var _ = Describe("odo preference and config command tests", func() {
 					{"PushTimeout", "4s", "6s", "foo", false},
 					{"RegistryCacheTime", "4m", "6m", "foo", false},
 					{"Ephemeral", "false", "true", "foo", true},
+					{"SyncMode", "delta", "full", "foo", false},
+					{"SyncCompression", "true", "false", "foo", false},
+					{"WatchMode", "polling", "auto", "foo", false},
+					{"WatchPollingInterval", "4s", "6s", "foo", false},
 				}
 
 				It("should successfully updated", func() {
@@ -0,0 +1,90 @@
+package integration
+
+import (
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gexec"
+
+	"github.com/redhat-developer/odo/tests/helper"
+)
+
+var _ = Describe("odo run command tests", func() {
+	var cmpName string
+	var commonVar helper.CommonVar
+
+	var _ = BeforeEach(func() {
+		commonVar = helper.CommonBeforeEach()
+		cmpName = helper.RandString(6)
+		helper.Chdir(commonVar.Context)
+		Expect(helper.VerifyFileExists(".odo/env/env.yaml")).To(BeFalse())
+	})
+
+	var _ = AfterEach(func() {
+		helper.CommonAfterEach(commonVar)
+	})
+
+	When("a component is initialized", func() {
+		BeforeEach(func() {
+			helper.CopyExample(filepath.Join("source", "nodejs"), commonVar.Context)
+			helper.Cmd("odo", "init", "--name", cmpName, "--devfile-path", helper.GetExamplePath("source", "devfiles", "nodejs", "devfile-with-commands-to-run.yaml")).ShouldPass()
+		})
+
+		It("should fail if the command does not exist", func() {
+			errOut := helper.Cmd("odo", "run", "unknown").ShouldFail().Err()
+			Expect(errOut).To(ContainSubstring(`no command named "unknown" found in the Devfile`))
+		})
+
+		It("should fail if odo dev is not running", func() {
+			errOut := helper.Cmd("odo", "run", "list-files").ShouldFail().Err()
+			Expect(errOut).To(ContainSubstring("please check that `odo dev` is running"))
+		})
+
+		for _, podman := range []bool{false, true} {
+			podman := podman
+			When("odo dev is running", helper.LabelPodmanIf(podman, func() {
+				var platformArgs []string
+				BeforeEach(func() {
+					if podman {
+						helper.EnableExperimentalMode()
+						platformArgs = []string{"--platform", "podman"}
+					}
+				})
+
+				AfterEach(func() {
+					if podman {
+						helper.ResetExperimentalMode()
+					}
+				})
+
+				It("should run commands in the component", func() {
+					err := helper.RunDevMode(helper.DevSessionOpts{RunOnPodman: podman}, func(session *gexec.Session, outContents, errContents []byte, ports map[string]string) {
+						By("executing an exec command from its working directory", func() {
+							out := helper.Cmd("odo", append([]string{"run", "list-files"}, platformArgs...)...).ShouldPass().Out()
+							Expect(out).To(ContainSubstring("package.json"))
+						})
+
+						By("executing an exec command with its environment variables", func() {
+							out := helper.Cmd("odo", append([]string{"run", "print-env"}, platformArgs...)...).ShouldPass().Out()
+							Expect(out).To(ContainSubstring("MESSAGE=hello"))
+						})
+
+						By("executing a parallel composite command", func() {
+							out := helper.Cmd("odo", append([]string{"run", "all"}, platformArgs...)...).ShouldPass().Out()
+							Expect(out).To(ContainSubstring("package.json"))
+							Expect(out).To(ContainSubstring("MESSAGE=hello"))
+						})
+
+						By("exiting with the exit code of a failing command", func() {
+							cmd := helper.Cmd("odo", append([]string{"run", "fail"}, platformArgs...)...).ShouldFail()
+							Expect(cmd.Err()).To(ContainSubstring("failing command"))
+							Expect(cmd.ExitCode()).To(Equal(3))
+						})
+					})
+					Expect(err).ToNot(HaveOccurred())
+				})
+			}))
+		}
+	})
+})